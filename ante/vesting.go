@@ -14,6 +14,16 @@ import (
 	"github.com/evmos/vesting/x/vesting/types"
 )
 
+// maxNestedMsgs defines a cap for the number of nested messages that are
+// validated, e.g. MsgExec messages wrapping other MsgExec messages
+const maxNestedMsgs = 7
+
+// msgWrapper defines the interface of the messages that wrap other messages,
+// e.g. the x/gov v1 and x/group MsgSubmitProposal
+type msgWrapper interface {
+	GetMsgs() ([]sdk.Msg, error)
+}
+
 // VestingDelegationDecorator validates delegation of vested coins
 type VestingDelegationDecorator struct {
 	ak  types.AccountKeeper
@@ -37,31 +47,45 @@ func NewVestingDelegationDecorator(ak types.AccountKeeper, sk types.StakingKeepe
 // the coins already vested
 func (vdd VestingDelegationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
 	for _, msg := range tx.GetMsgs() {
-		switch msg := msg.(type) {
-		case *authz.MsgExec:
-			// Check for bypassing authorization
-			if err := vdd.validateAuthz(ctx, msg); err != nil {
-				return ctx, err
-			}
-		default:
-			if err := vdd.validateMsg(ctx, msg); err != nil {
-				return ctx, err
-			}
+		if err := vdd.validateNestedMsg(ctx, msg, 0); err != nil {
+			return ctx, err
 		}
 	}
 
 	return next(ctx, tx, simulate)
 }
 
-// validateAuthz validates the authorization internal message
-func (vdd VestingDelegationDecorator) validateAuthz(ctx sdk.Context, execMsg *authz.MsgExec) error {
-	for _, v := range execMsg.Msgs {
-		var innerMsg sdk.Msg
-		if err := vdd.cdc.UnpackAny(v, &innerMsg); err != nil {
-			return errorsmod.Wrap(err, "cannot unmarshal authz exec msgs")
-		}
+// validateNestedMsg validates the given message and, for messages wrapping
+// other messages, all the nested messages up to maxNestedMsgs levels deep.
+func (vdd VestingDelegationDecorator) validateNestedMsg(ctx sdk.Context, msg sdk.Msg, nestedLvl int) error {
+	var (
+		innerMsgs []sdk.Msg
+		err       error
+	)
+
+	switch msg := msg.(type) {
+	case *authz.MsgExec:
+		// Check for bypassing authorization
+		innerMsgs, err = vdd.unpackAuthzMsgs(msg)
+	case msgWrapper:
+		innerMsgs, err = msg.GetMsgs()
+	default:
+		return vdd.validateMsg(ctx, msg)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if nestedLvl >= maxNestedMsgs {
+		return errorsmod.Wrapf(
+			errortypes.ErrUnauthorized,
+			"found more nested msgs than permitted. Limit is : %d", maxNestedMsgs,
+		)
+	}
 
-		if err := vdd.validateMsg(ctx, innerMsg); err != nil {
+	for _, innerMsg := range innerMsgs {
+		if err := vdd.validateNestedMsg(ctx, innerMsg, nestedLvl+1); err != nil {
 			return err
 		}
 	}
@@ -69,16 +93,42 @@ func (vdd VestingDelegationDecorator) validateAuthz(ctx sdk.Context, execMsg *au
 	return nil
 }
 
+// unpackAuthzMsgs unpacks the messages of the given authorization exec message
+func (vdd VestingDelegationDecorator) unpackAuthzMsgs(execMsg *authz.MsgExec) ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, 0, len(execMsg.Msgs))
+	for _, v := range execMsg.Msgs {
+		var innerMsg sdk.Msg
+		if err := vdd.cdc.UnpackAny(v, &innerMsg); err != nil {
+			return nil, errorsmod.Wrap(err, "cannot unmarshal authz exec msgs")
+		}
+		msgs = append(msgs, innerMsg)
+	}
+
+	return msgs, nil
+}
+
 // validateMsg checks that the only vested coins can be delegated
+//
+// NOTE: MsgBeginRedelegate and MsgUndelegate don't bond additional tokens, so
+// they are not subject to the vested coins check.
 func (vdd VestingDelegationDecorator) validateMsg(ctx sdk.Context, msg sdk.Msg) error {
-	var delegationAmt math.Int
-	// need to validate delegation amount in MsgDelegate
-	// and self delegation amount in MsgCreateValidator
+	var (
+		delegationAmt math.Int
+		// fromUnbonding is true if the bonded tokens are taken from the
+		// unbonding delegations instead of the account balance
+		fromUnbonding bool
+	)
+	// need to validate delegation amount in MsgDelegate,
+	// self delegation amount in MsgCreateValidator
+	// and re-bonded amount in MsgCancelUnbondingDelegation
 	switch stkMsg := msg.(type) {
 	case *stakingtypes.MsgDelegate:
 		delegationAmt = stkMsg.Amount.Amount
 	case *stakingtypes.MsgCreateValidator:
 		delegationAmt = stkMsg.Value.Amount
+	case *stakingtypes.MsgCancelUnbondingDelegation:
+		delegationAmt = stkMsg.Amount.Amount
+		fromUnbonding = true
 	default:
 		return nil
 	}
@@ -108,14 +158,20 @@ func (vdd VestingDelegationDecorator) validateMsg(ctx sdk.Context, msg sdk.Msg)
 			)
 		}
 
-		balance := vdd.bk.GetBalance(ctx, addr, bondDenom)
+		balanceAmt := vdd.bk.GetBalance(ctx, addr, bondDenom).Amount
+		if fromUnbonding {
+			// the unbonding tokens are returned to the balance once unbonded,
+			// so they count towards the coins that can be bonded
+			balanceAmt = balanceAmt.Add(vdd.sk.GetDelegatorUnbonding(ctx, addr))
+		}
+
 		unvestedCoins := clawbackAccount.GetVestingCoins(ctx.BlockTime())
 		// Can only delegate bondable coins
 		unvestedBondableAmt := unvestedCoins.AmountOf(bondDenom)
 		// A ClawbackVestingAccount can delegate coins from the vesting schedule
 		// when having vested locked coins or unlocked vested coins.
 		// It CANNOT delegate unvested coins
-		availableAmt := balance.Amount.Sub(unvestedBondableAmt)
+		availableAmt := balanceAmt.Sub(unvestedBondableAmt)
 		if availableAmt.IsNegative() {
 			availableAmt = math.ZeroInt()
 		}
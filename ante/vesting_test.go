@@ -10,7 +10,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/evmos/vesting/testutil"
@@ -57,6 +59,24 @@ func TestVestingDelegationDecorator(t *testing.T) {
 	)
 	require.NoError(t, err)
 
+	// nestedExecMsg wraps the given message in the given number of nested MsgExec messages
+	nestedExecMsg := func(msg sdk.Msg, depth int) sdk.Msg {
+		for i := 0; i < depth; i++ {
+			execMsg := authz.NewMsgExec(addr, []sdk.Msg{msg})
+			msg = &execMsg
+		}
+		return msg
+	}
+
+	cancelUnbondingMsg := stakingtypes.NewMsgCancelUnbondingDelegation(
+		addr, sdk.ValAddress(funderAddr), 1, sdk.NewCoin(testutil.StakeDenom, math.NewInt(70)),
+	)
+
+	proposalMsg, err := govv1.NewMsgSubmitProposal(
+		[]sdk.Msg{nestedExecMsg(delMsg, 1)}, coins, addr.String(), "", "title", "summary",
+	)
+	require.NoError(t, err)
+
 	testCases := []struct {
 		name      string
 		msg       sdk.Msg
@@ -199,6 +219,96 @@ func TestVestingDelegationDecorator(t *testing.T) {
 			},
 			expPass: true,
 		},
+		{
+			name: "MsgExec with MsgDelegate with clawback account without vested coins - should fail",
+			msg:  nestedExecMsg(delMsg, 1),
+			malleate: func(suite *AnteTestSuite) {
+				suite.accountKeeper.EXPECT().GetAccount(suite.ctx, addr).Return(vestAcc)
+			},
+			expPass:   false,
+			expErrMsg: "account has no vested coins",
+		},
+		{
+			name: "MsgExec with nested MsgExec with MsgDelegate with clawback account without vested coins - should fail",
+			msg:  nestedExecMsg(delMsg, 2),
+			malleate: func(suite *AnteTestSuite) {
+				suite.accountKeeper.EXPECT().GetAccount(suite.ctx, addr).Return(vestAcc)
+			},
+			expPass:   false,
+			expErrMsg: "account has no vested coins",
+		},
+		{
+			name: "MsgExec with maximum nested MsgExec with MsgDelegate with clawback account with vested coins < delegation amount - should fail",
+			msg:  nestedExecMsg(delMsg, 7),
+			malleate: func(suite *AnteTestSuite) {
+				suite.ctx = suite.ctx.WithBlockTime(now.Add(12 * time.Hour))
+				suite.accountKeeper.EXPECT().GetAccount(suite.ctx, addr).Return(vestAcc)
+			},
+			expPass:   false,
+			expErrMsg: "cannot delegate unvested coins",
+		},
+		{
+			name: "MsgExec with nested MsgExec with MsgDelegate with clawback account with free coins and vested tokens",
+			msg: nestedExecMsg(&stakingtypes.MsgDelegate{
+				DelegatorAddress: addr.String(),
+				ValidatorAddress: sdk.ValAddress(funderAddr).String(),
+				Amount:           sdk.NewCoin(testutil.StakeDenom, math.NewInt(60)), // 10 free coins + 50 locked vested coins
+			}, 3),
+			malleate: func(suite *AnteTestSuite) {
+				suite.ctx = suite.ctx.WithBlockTime(now.Add(12 * time.Hour))
+				suite.accountKeeper.EXPECT().GetAccount(suite.ctx, addr).Return(vestAcc)
+			},
+			expPass: true,
+		},
+		{
+			name:      "MsgExec with more nested MsgExec than permitted - should fail",
+			msg:       nestedExecMsg(delMsg, 8),
+			expPass:   false,
+			expErrMsg: "found more nested msgs than permitted",
+		},
+		{
+			name:    "MsgExec with nested MsgSend - no-op",
+			msg:     nestedExecMsg(sendMsg, 3),
+			expPass: true,
+		},
+		{
+			name: "MsgCancelUnbondingDelegation with clawback account with vested coins < re-bonded amount - should fail",
+			msg:  cancelUnbondingMsg,
+			malleate: func(suite *AnteTestSuite) {
+				// 50 percent of coins are vested after 1st vesting period,
+				// so 10 free coins + 50 vested coins + 0 unbonding coins can be bonded
+				suite.ctx = suite.ctx.WithBlockTime(now.Add(12 * time.Hour))
+				suite.accountKeeper.EXPECT().GetAccount(suite.ctx, addr).Return(vestAcc)
+				suite.stakingKeeper.EXPECT().GetDelegatorUnbonding(suite.ctx, addr).Return(math.ZeroInt())
+			},
+			expPass:   false,
+			expErrMsg: "cannot delegate unvested coins",
+		},
+		{
+			name: "MsgCancelUnbondingDelegation with clawback account with vested and unbonding coins",
+			msg:  cancelUnbondingMsg,
+			malleate: func(suite *AnteTestSuite) {
+				// 10 free coins + 50 vested coins + 20 unbonding coins can be bonded
+				suite.ctx = suite.ctx.WithBlockTime(now.Add(12 * time.Hour))
+				suite.accountKeeper.EXPECT().GetAccount(suite.ctx, addr).Return(vestAcc)
+				suite.stakingKeeper.EXPECT().GetDelegatorUnbonding(suite.ctx, addr).Return(math.NewInt(20))
+			},
+			expPass: true,
+		},
+		{
+			name:    "MsgUndelegate - no-op",
+			msg:     stakingtypes.NewMsgUndelegate(addr, sdk.ValAddress(funderAddr), coins[0]),
+			expPass: true,
+		},
+		{
+			name: "MsgSubmitProposal with MsgExec with MsgDelegate with clawback account without vested coins - should fail",
+			msg:  proposalMsg,
+			malleate: func(suite *AnteTestSuite) {
+				suite.accountKeeper.EXPECT().GetAccount(suite.ctx, addr).Return(vestAcc)
+			},
+			expPass:   false,
+			expErrMsg: "account has no vested coins",
+		},
 	}
 
 	for _, tc := range testCases {
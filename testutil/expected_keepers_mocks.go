@@ -11,10 +11,12 @@ package testutil
 
 import (
 	reflect "reflect"
+	time "time"
 
 	math "cosmossdk.io/math"
 	types "github.com/cosmos/cosmos-sdk/types"
 	types0 "github.com/cosmos/cosmos-sdk/x/auth/types"
+	types1 "github.com/cosmos/cosmos-sdk/x/staking/types"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -130,6 +132,34 @@ func (mr *MockBankKeeperMockRecorder) BlockedAddr(addr any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockedAddr", reflect.TypeOf((*MockBankKeeper)(nil).BlockedAddr), addr)
 }
 
+// BurnCoins mocks base method.
+func (m *MockBankKeeper) BurnCoins(ctx types.Context, moduleName string, amt types.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BurnCoins", ctx, moduleName, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BurnCoins indicates an expected call of BurnCoins.
+func (mr *MockBankKeeperMockRecorder) BurnCoins(ctx, moduleName, amt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BurnCoins", reflect.TypeOf((*MockBankKeeper)(nil).BurnCoins), ctx, moduleName, amt)
+}
+
+// GetAllBalances mocks base method.
+func (m *MockBankKeeper) GetAllBalances(ctx types.Context, addr types.AccAddress) types.Coins {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllBalances", ctx, addr)
+	ret0, _ := ret[0].(types.Coins)
+	return ret0
+}
+
+// GetAllBalances indicates an expected call of GetAllBalances.
+func (mr *MockBankKeeperMockRecorder) GetAllBalances(ctx, addr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllBalances", reflect.TypeOf((*MockBankKeeper)(nil).GetAllBalances), ctx, addr)
+}
+
 // GetBalance mocks base method.
 func (m *MockBankKeeper) GetBalance(ctx types.Context, addr types.AccAddress, denom string) types.Coin {
 	m.ctrl.T.Helper()
@@ -158,6 +188,62 @@ func (mr *MockBankKeeperMockRecorder) SendCoins(ctx, fromAddr, toAddr, amt any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoins", reflect.TypeOf((*MockBankKeeper)(nil).SendCoins), ctx, fromAddr, toAddr, amt)
 }
 
+// SendCoinsFromAccountToModule mocks base method.
+func (m *MockBankKeeper) SendCoinsFromAccountToModule(ctx types.Context, senderAddr types.AccAddress, recipientModule string, amt types.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendCoinsFromAccountToModule", ctx, senderAddr, recipientModule, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendCoinsFromAccountToModule indicates an expected call of SendCoinsFromAccountToModule.
+func (mr *MockBankKeeperMockRecorder) SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromAccountToModule", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromAccountToModule), ctx, senderAddr, recipientModule, amt)
+}
+
+// SendCoinsFromModuleToAccount mocks base method.
+func (m *MockBankKeeper) SendCoinsFromModuleToAccount(ctx types.Context, senderModule string, recipientAddr types.AccAddress, amt types.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendCoinsFromModuleToAccount", ctx, senderModule, recipientAddr, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendCoinsFromModuleToAccount indicates an expected call of SendCoinsFromModuleToAccount.
+func (mr *MockBankKeeperMockRecorder) SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromModuleToAccount", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromModuleToAccount), ctx, senderModule, recipientAddr, amt)
+}
+
+// SpendableCoin mocks base method.
+func (m *MockBankKeeper) SpendableCoin(ctx types.Context, addr types.AccAddress, denom string) types.Coin {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SpendableCoin", ctx, addr, denom)
+	ret0, _ := ret[0].(types.Coin)
+	return ret0
+}
+
+// SpendableCoin indicates an expected call of SpendableCoin.
+func (mr *MockBankKeeperMockRecorder) SpendableCoin(ctx, addr, denom any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SpendableCoin", reflect.TypeOf((*MockBankKeeper)(nil).SpendableCoin), ctx, addr, denom)
+}
+
+// UndelegateCoinsFromModuleToAccount mocks base method.
+func (m *MockBankKeeper) UndelegateCoinsFromModuleToAccount(ctx types.Context, senderModule string, recipientAddr types.AccAddress, amt types.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UndelegateCoinsFromModuleToAccount", ctx, senderModule, recipientAddr, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UndelegateCoinsFromModuleToAccount indicates an expected call of UndelegateCoinsFromModuleToAccount.
+func (mr *MockBankKeeperMockRecorder) UndelegateCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UndelegateCoinsFromModuleToAccount", reflect.TypeOf((*MockBankKeeper)(nil).UndelegateCoinsFromModuleToAccount), ctx, senderModule, recipientAddr, amt)
+}
+
 // MockStakingKeeper is a mock of StakingKeeper interface.
 type MockStakingKeeper struct {
 	ctrl     *gomock.Controller
@@ -195,6 +281,49 @@ func (mr *MockStakingKeeperMockRecorder) BondDenom(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BondDenom", reflect.TypeOf((*MockStakingKeeper)(nil).BondDenom), ctx)
 }
 
+// Delegate mocks base method.
+func (m *MockStakingKeeper) Delegate(ctx types.Context, delAddr types.AccAddress, bondAmt math.Int, tokenSrc types1.BondStatus, validator types1.Validator, subtractAccount bool) (types.Dec, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delegate", ctx, delAddr, bondAmt, tokenSrc, validator, subtractAccount)
+	ret0, _ := ret[0].(types.Dec)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delegate indicates an expected call of Delegate.
+func (mr *MockStakingKeeperMockRecorder) Delegate(ctx, delAddr, bondAmt, tokenSrc, validator, subtractAccount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delegate", reflect.TypeOf((*MockStakingKeeper)(nil).Delegate), ctx, delAddr, bondAmt, tokenSrc, validator, subtractAccount)
+}
+
+// GetAllDelegatorDelegations mocks base method.
+func (m *MockStakingKeeper) GetAllDelegatorDelegations(ctx types.Context, delegator types.AccAddress) []types1.Delegation {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllDelegatorDelegations", ctx, delegator)
+	ret0, _ := ret[0].([]types1.Delegation)
+	return ret0
+}
+
+// GetAllDelegatorDelegations indicates an expected call of GetAllDelegatorDelegations.
+func (mr *MockStakingKeeperMockRecorder) GetAllDelegatorDelegations(ctx, delegator any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllDelegatorDelegations", reflect.TypeOf((*MockStakingKeeper)(nil).GetAllDelegatorDelegations), ctx, delegator)
+}
+
+// GetAllUnbondingDelegations mocks base method.
+func (m *MockStakingKeeper) GetAllUnbondingDelegations(ctx types.Context, delegator types.AccAddress) []types1.UnbondingDelegation {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllUnbondingDelegations", ctx, delegator)
+	ret0, _ := ret[0].([]types1.UnbondingDelegation)
+	return ret0
+}
+
+// GetAllUnbondingDelegations indicates an expected call of GetAllUnbondingDelegations.
+func (mr *MockStakingKeeperMockRecorder) GetAllUnbondingDelegations(ctx, delegator any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllUnbondingDelegations", reflect.TypeOf((*MockStakingKeeper)(nil).GetAllUnbondingDelegations), ctx, delegator)
+}
+
 // GetDelegatorBonded mocks base method.
 func (m *MockStakingKeeper) GetDelegatorBonded(ctx types.Context, delegator types.AccAddress) math.Int {
 	m.ctrl.T.Helper()
@@ -223,6 +352,89 @@ func (mr *MockStakingKeeperMockRecorder) GetDelegatorUnbonding(ctx, delegator an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDelegatorUnbonding", reflect.TypeOf((*MockStakingKeeper)(nil).GetDelegatorUnbonding), ctx, delegator)
 }
 
+// GetValidator mocks base method.
+func (m *MockStakingKeeper) GetValidator(ctx types.Context, addr types.ValAddress) (types1.Validator, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValidator", ctx, addr)
+	ret0, _ := ret[0].(types1.Validator)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetValidator indicates an expected call of GetValidator.
+func (mr *MockStakingKeeperMockRecorder) GetValidator(ctx, addr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidator", reflect.TypeOf((*MockStakingKeeper)(nil).GetValidator), ctx, addr)
+}
+
+// InsertUBDQueue mocks base method.
+func (m *MockStakingKeeper) InsertUBDQueue(ctx types.Context, ubd types1.UnbondingDelegation, completionTime time.Time) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "InsertUBDQueue", ctx, ubd, completionTime)
+}
+
+// InsertUBDQueue indicates an expected call of InsertUBDQueue.
+func (mr *MockStakingKeeperMockRecorder) InsertUBDQueue(ctx, ubd, completionTime any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertUBDQueue", reflect.TypeOf((*MockStakingKeeper)(nil).InsertUBDQueue), ctx, ubd, completionTime)
+}
+
+// SetUnbondingDelegation mocks base method.
+func (m *MockStakingKeeper) SetUnbondingDelegation(ctx types.Context, ubd types1.UnbondingDelegation) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetUnbondingDelegation", ctx, ubd)
+}
+
+// SetUnbondingDelegation indicates an expected call of SetUnbondingDelegation.
+func (mr *MockStakingKeeperMockRecorder) SetUnbondingDelegation(ctx, ubd any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUnbondingDelegation", reflect.TypeOf((*MockStakingKeeper)(nil).SetUnbondingDelegation), ctx, ubd)
+}
+
+// SetUnbondingDelegationEntry mocks base method.
+func (m *MockStakingKeeper) SetUnbondingDelegationEntry(ctx types.Context, delegatorAddr types.AccAddress, validatorAddr types.ValAddress, creationHeight int64, minTime time.Time, balance math.Int) types1.UnbondingDelegation {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUnbondingDelegationEntry", ctx, delegatorAddr, validatorAddr, creationHeight, minTime, balance)
+	ret0, _ := ret[0].(types1.UnbondingDelegation)
+	return ret0
+}
+
+// SetUnbondingDelegationEntry indicates an expected call of SetUnbondingDelegationEntry.
+func (mr *MockStakingKeeperMockRecorder) SetUnbondingDelegationEntry(ctx, delegatorAddr, validatorAddr, creationHeight, minTime, balance any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUnbondingDelegationEntry", reflect.TypeOf((*MockStakingKeeper)(nil).SetUnbondingDelegationEntry), ctx, delegatorAddr, validatorAddr, creationHeight, minTime, balance)
+}
+
+// Unbond mocks base method.
+func (m *MockStakingKeeper) Unbond(ctx types.Context, delAddr types.AccAddress, valAddr types.ValAddress, shares types.Dec) (math.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unbond", ctx, delAddr, valAddr, shares)
+	ret0, _ := ret[0].(math.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Unbond indicates an expected call of Unbond.
+func (mr *MockStakingKeeperMockRecorder) Unbond(ctx, delAddr, valAddr, shares any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unbond", reflect.TypeOf((*MockStakingKeeper)(nil).Unbond), ctx, delAddr, valAddr, shares)
+}
+
+// ValidateUnbondAmount mocks base method.
+func (m *MockStakingKeeper) ValidateUnbondAmount(ctx types.Context, delAddr types.AccAddress, valAddr types.ValAddress, amt math.Int) (types.Dec, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateUnbondAmount", ctx, delAddr, valAddr, amt)
+	ret0, _ := ret[0].(types.Dec)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateUnbondAmount indicates an expected call of ValidateUnbondAmount.
+func (mr *MockStakingKeeperMockRecorder) ValidateUnbondAmount(ctx, delAddr, valAddr, amt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateUnbondAmount", reflect.TypeOf((*MockStakingKeeper)(nil).ValidateUnbondAmount), ctx, delAddr, valAddr, amt)
+}
+
 // MockDistributionKeeper is a mock of DistributionKeeper interface.
 type MockDistributionKeeper struct {
 	ctrl     *gomock.Controller
@@ -246,6 +458,20 @@ func (m *MockDistributionKeeper) EXPECT() *MockDistributionKeeperMockRecorder {
 	return m.recorder
 }
 
+// DistributeFromFeePool mocks base method.
+func (m *MockDistributionKeeper) DistributeFromFeePool(ctx types.Context, amount types.Coins, receiveAddr types.AccAddress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DistributeFromFeePool", ctx, amount, receiveAddr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeFromFeePool indicates an expected call of DistributeFromFeePool.
+func (mr *MockDistributionKeeperMockRecorder) DistributeFromFeePool(ctx, amount, receiveAddr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeFromFeePool", reflect.TypeOf((*MockDistributionKeeper)(nil).DistributeFromFeePool), ctx, amount, receiveAddr)
+}
+
 // FundCommunityPool mocks base method.
 func (m *MockDistributionKeeper) FundCommunityPool(ctx types.Context, amount types.Coins, sender types.AccAddress) error {
 	m.ctrl.T.Helper()
@@ -259,3 +485,148 @@ func (mr *MockDistributionKeeperMockRecorder) FundCommunityPool(ctx, amount, sen
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FundCommunityPool", reflect.TypeOf((*MockDistributionKeeper)(nil).FundCommunityPool), ctx, amount, sender)
 }
+
+// MockAccountConverter is a mock of AccountConverter interface.
+type MockAccountConverter struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccountConverterMockRecorder
+}
+
+// MockAccountConverterMockRecorder is the mock recorder for MockAccountConverter.
+type MockAccountConverterMockRecorder struct {
+	mock *MockAccountConverter
+}
+
+// NewMockAccountConverter creates a new mock instance.
+func NewMockAccountConverter(ctrl *gomock.Controller) *MockAccountConverter {
+	mock := &MockAccountConverter{ctrl: ctrl}
+	mock.recorder = &MockAccountConverterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccountConverter) EXPECT() *MockAccountConverterMockRecorder {
+	return m.recorder
+}
+
+// FromBaseAccount mocks base method.
+func (m *MockAccountConverter) FromBaseAccount(baseAcc *types0.BaseAccount, original types0.AccountI) types0.AccountI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FromBaseAccount", baseAcc, original)
+	ret0, _ := ret[0].(types0.AccountI)
+	return ret0
+}
+
+// FromBaseAccount indicates an expected call of FromBaseAccount.
+func (mr *MockAccountConverterMockRecorder) FromBaseAccount(baseAcc, original any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FromBaseAccount", reflect.TypeOf((*MockAccountConverter)(nil).FromBaseAccount), baseAcc, original)
+}
+
+// ToBaseAccount mocks base method.
+func (m *MockAccountConverter) ToBaseAccount(acc types0.AccountI) (*types0.BaseAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToBaseAccount", acc)
+	ret0, _ := ret[0].(*types0.BaseAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ToBaseAccount indicates an expected call of ToBaseAccount.
+func (mr *MockAccountConverterMockRecorder) ToBaseAccount(acc any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToBaseAccount", reflect.TypeOf((*MockAccountConverter)(nil).ToBaseAccount), acc)
+}
+
+// MockVestingHooks is a mock of VestingHooks interface.
+type MockVestingHooks struct {
+	ctrl     *gomock.Controller
+	recorder *MockVestingHooksMockRecorder
+}
+
+// MockVestingHooksMockRecorder is the mock recorder for MockVestingHooks.
+type MockVestingHooksMockRecorder struct {
+	mock *MockVestingHooks
+}
+
+// NewMockVestingHooks creates a new mock instance.
+func NewMockVestingHooks(ctrl *gomock.Controller) *MockVestingHooks {
+	mock := &MockVestingHooks{ctrl: ctrl}
+	mock.recorder = &MockVestingHooksMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVestingHooks) EXPECT() *MockVestingHooksMockRecorder {
+	return m.recorder
+}
+
+// AfterClawback mocks base method.
+func (m *MockVestingHooks) AfterClawback(ctx types.Context, vestingAddr, destAddr types.AccAddress, clawedBack types.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AfterClawback", ctx, vestingAddr, destAddr, clawedBack)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AfterClawback indicates an expected call of AfterClawback.
+func (mr *MockVestingHooksMockRecorder) AfterClawback(ctx, vestingAddr, destAddr, clawedBack any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterClawback", reflect.TypeOf((*MockVestingHooks)(nil).AfterClawback), ctx, vestingAddr, destAddr, clawedBack)
+}
+
+// AfterFunderUpdated mocks base method.
+func (m *MockVestingHooks) AfterFunderUpdated(ctx types.Context, vestingAddr, oldFunderAddr, newFunderAddr types.AccAddress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AfterFunderUpdated", ctx, vestingAddr, oldFunderAddr, newFunderAddr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AfterFunderUpdated indicates an expected call of AfterFunderUpdated.
+func (mr *MockVestingHooksMockRecorder) AfterFunderUpdated(ctx, vestingAddr, oldFunderAddr, newFunderAddr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterFunderUpdated", reflect.TypeOf((*MockVestingHooks)(nil).AfterFunderUpdated), ctx, vestingAddr, oldFunderAddr, newFunderAddr)
+}
+
+// AfterVestingAccountConverted mocks base method.
+func (m *MockVestingHooks) AfterVestingAccountConverted(ctx types.Context, vestingAddr types.AccAddress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AfterVestingAccountConverted", ctx, vestingAddr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AfterVestingAccountConverted indicates an expected call of AfterVestingAccountConverted.
+func (mr *MockVestingHooksMockRecorder) AfterVestingAccountConverted(ctx, vestingAddr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterVestingAccountConverted", reflect.TypeOf((*MockVestingHooks)(nil).AfterVestingAccountConverted), ctx, vestingAddr)
+}
+
+// AfterVestingAccountCreated mocks base method.
+func (m *MockVestingHooks) AfterVestingAccountCreated(ctx types.Context, vestingAddr, funderAddr types.AccAddress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AfterVestingAccountCreated", ctx, vestingAddr, funderAddr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AfterVestingAccountCreated indicates an expected call of AfterVestingAccountCreated.
+func (mr *MockVestingHooksMockRecorder) AfterVestingAccountCreated(ctx, vestingAddr, funderAddr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterVestingAccountCreated", reflect.TypeOf((*MockVestingHooks)(nil).AfterVestingAccountCreated), ctx, vestingAddr, funderAddr)
+}
+
+// AfterVestingAccountFunded mocks base method.
+func (m *MockVestingHooks) AfterVestingAccountFunded(ctx types.Context, vestingAddr, funderAddr types.AccAddress, coins types.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AfterVestingAccountFunded", ctx, vestingAddr, funderAddr, coins)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AfterVestingAccountFunded indicates an expected call of AfterVestingAccountFunded.
+func (mr *MockVestingHooksMockRecorder) AfterVestingAccountFunded(ctx, vestingAddr, funderAddr, coins any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterVestingAccountFunded", reflect.TypeOf((*MockVestingHooks)(nil).AfterVestingAccountFunded), ctx, vestingAddr, funderAddr, coins)
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/evmos/vesting/x/vesting/types"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -25,6 +26,16 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetBalancesCmd(),
+		GetParamsCmd(),
+		GetPausedVestingAccountsCmd(),
+		GetScheduleAmendmentCmd(),
+		GetFundingInstructionCmd(),
+		GetFundingInstructionsCmd(),
+		GetEscrowGrantCmd(),
+		GetEscrowGrantsCmd(),
+		GetFunderCommitteeCmd(),
+		GetCommitteeProposalCmd(),
+		GetCommitteeProposalsCmd(),
 	)
 	return cmd
 }
@@ -61,3 +72,342 @@ func GetBalancesCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetParamsCmd queries the vesting module parameters.
+func GetParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Gets the vesting module parameters",
+		Long:  "Gets the vesting module parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetPausedVestingAccountsCmd queries the vesting accounts whose vesting is paused.
+func GetPausedVestingAccountsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "paused-accounts",
+		Short: "Gets the vesting accounts whose vesting is paused",
+		Long:  "Gets the vesting accounts whose vesting is paused, with their pause time",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PausedVestingAccounts(context.Background(), &types.QueryPausedVestingAccountsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "paused-accounts")
+	return cmd
+}
+
+// GetScheduleAmendmentCmd queries the pending schedule amendment of a vesting account.
+func GetScheduleAmendmentCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule-amendment ADDRESS",
+		Short: "Gets the pending schedule amendment of a vesting account",
+		Long:  "Gets the lockup and vesting periods proposed by the funder of a vesting account and not accepted yet",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ScheduleAmendment(context.Background(), &types.QueryScheduleAmendmentRequest{
+				Address: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Amendment)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetFundingInstructionCmd queries a recurring funding instruction by id.
+func GetFundingInstructionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "funding-instruction ID",
+		Short: "Gets a recurring funding instruction",
+		Long:  "Gets a recurring funding instruction, with its remaining grants and the due time of the next one",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FundingInstruction(context.Background(), &types.QueryFundingInstructionRequest{
+				Id: id,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Instruction)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetFundingInstructionsCmd queries all recurring funding instructions.
+func GetFundingInstructionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "funding-instructions",
+		Short: "Gets all recurring funding instructions",
+		Long:  "Gets all recurring funding instructions",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FundingInstructions(context.Background(), &types.QueryFundingInstructionsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "funding-instructions")
+	return cmd
+}
+
+// GetEscrowGrantCmd queries an escrow grant by id.
+func GetEscrowGrantCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "escrow-grant ID",
+		Short: "Gets an escrow grant",
+		Long:  "Gets an escrow grant held by the vesting module, with its currently claimable coins",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EscrowGrant(context.Background(), &types.QueryEscrowGrantRequest{
+				Id: id,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetEscrowGrantsCmd queries the escrow grants of a recipient.
+func GetEscrowGrantsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "escrow-grants ADDRESS",
+		Short: "Gets the escrow grants of a recipient",
+		Long:  "Gets the escrow grants held by the vesting module for a recipient",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EscrowGrants(context.Background(), &types.QueryEscrowGrantsRequest{
+				Address:    args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "escrow-grants")
+	return cmd
+}
+
+// GetFunderCommitteeCmd queries a funder committee by address.
+func GetFunderCommitteeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "funder-committee ADDRESS",
+		Short: "Gets a funder committee",
+		Long:  "Gets the members, threshold and proposal lifetime of a funder committee",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FunderCommittee(context.Background(), &types.QueryFunderCommitteeRequest{
+				Address: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Committee)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCommitteeProposalCmd queries a funder committee proposal by id.
+func GetCommitteeProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "committee-proposal ID",
+		Short: "Gets a funder committee proposal",
+		Long:  "Gets a pending funder committee proposal, with its approvals and expiry time",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CommitteeProposal(context.Background(), &types.QueryCommitteeProposalRequest{
+				Id: id,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Proposal)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCommitteeProposalsCmd queries the pending proposals of a funder committee.
+func GetCommitteeProposalsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "committee-proposals ADDRESS",
+		Short: "Gets the pending proposals of a funder committee",
+		Long:  "Gets the pending proposals of a funder committee",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CommitteeProposals(context.Background(), &types.QueryCommitteeProposalsRequest{
+				Address:    args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "committee-proposals")
+	return cmd
+}
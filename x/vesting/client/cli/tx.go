@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"github.com/evmos/vesting/x/vesting/types"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -16,18 +17,29 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 )
 
 // Transaction command flags
 const (
-	FlagDest     = "dest"
-	FlagLockup   = "lockup"
-	FlagVesting  = "vesting"
-	FlagClawback = "clawback"
-	FlagFunder   = "funder"
+	FlagDest         = "dest"
+	FlagLockup       = "lockup"
+	FlagVesting      = "vesting"
+	FlagClawback     = "clawback"
+	FlagFunder       = "funder"
+	FlagBestEffort   = "best-effort"
+	FlagBurn         = "burn"
+	FlagDestinations = "destinations"
+	FlagGovClawback  = "gov-clawback"
+	FlagInterval     = "interval"
+	FlagCount        = "count"
+	FlagNewFunder    = "new-funder"
+	FlagLifetime     = "proposal-lifetime"
 )
 
 // NewTxCmd returns a root CLI command handler for vesting
@@ -46,7 +58,28 @@ func NewTxCmd() *cobra.Command {
 		NewMsgFundVestingAccountCmd(),
 		NewMsgClawbackCmd(),
 		NewMsgUpdateVestingFunderCmd(),
+		NewMsgSetVestingManagerCmd(),
+		NewMsgRenounceClawbackCmd(),
+		NewMsgProposeScheduleAmendmentCmd(),
+		NewMsgAcceptScheduleAmendmentCmd(),
+		NewMsgSelfLockupCmd(),
+		NewMsgCreateFundingInstructionCmd(),
+		NewMsgCancelFundingInstructionCmd(),
+		NewMsgCreateEscrowGrantCmd(),
+		NewMsgClaimEscrowGrantCmd(),
+		NewMsgClawbackEscrowGrantCmd(),
+		NewMsgCreateFunderCommitteeCmd(),
+		NewMsgSubmitCommitteeProposalCmd(),
+		NewMsgApproveCommitteeProposalCmd(),
+		NewMsgMergeVestingAccountsCmd(),
 		NewMsgConvertVestingAccountCmd(),
+		NewGovClawbackProposalCmd(),
+		NewGovBatchClawbackProposalCmd(),
+		NewGovPauseVestingProposalCmd(),
+		NewGovResumeVestingProposalCmd(),
+		NewGovUnlockVestingProposalCmd(),
+		NewGovFundVestingAccountProposalCmd(),
+		NewGovRecoverVestingAccountProposalCmd(),
 	)
 
 	return txCmd
@@ -177,6 +210,8 @@ func NewMsgClawbackCmd() *cobra.Command {
 		Short: "Transfer unvested amount out of a ClawbackVestingAccount.",
 		Long: `Must be requested by the original funder address (--from).
 		May provide a destination address (--dest), otherwise the coins return to the funder.
+		The coins can be burned instead (--burn), or split between multiple weighted destinations
+		(--destinations=<address_1>:<weight_1>,<address_2>:<weight_2>), with the remainder going to the first one.
 		Delegated or undelegating staking tokens will be transferred in the delegated (undelegating) state.
 		The recipient is vulnerable to slashing, and must act to unbond the tokens if desired.`,
 		Args: cobra.ExactArgs(1),
@@ -200,7 +235,638 @@ func NewMsgClawbackCmd() *cobra.Command {
 				}
 			}
 
+			burn, err := cmd.Flags().GetBool(FlagBurn)
+			if err != nil {
+				return err
+			}
+
+			destinationsStr, err := cmd.Flags().GetString(FlagDestinations)
+			if err != nil {
+				return err
+			}
+
+			destinations, err := ParseClawbackDestinations(destinationsStr)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgClawback(clientCtx.GetFromAddress(), addr, dest)
+			msg.Burn = burn
+			msg.Destinations = destinations
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagDest, "", "address of destination (defaults to funder)")
+	cmd.Flags().Bool(FlagBurn, false, "burn the clawed back coins instead of transferring them")
+	cmd.Flags().String(FlagDestinations, "", "comma separated list of weighted destinations in the ADDRESS:WEIGHT format")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewMsgUpdateVestingFunderCmd returns a CLI command handler for updating
+// the funder of a ClawbackVestingAccount.
+func NewMsgUpdateVestingFunderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-vesting-funder VESTING_ACCOUNT_ADDRESS NEW_FUNDER_ADDRESS",
+		Short: "Update the funder account of an existing ClawbackVestingAccount.",
+		Long: `Must be requested by the original funder address (--from).
+		Need to provide the target VESTING_ACCOUNT_ADDRESS to update and the NEW_FUNDER_ADDRESS.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			vestingAcc, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			newFunder, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateVestingFunder(clientCtx.GetFromAddress(), newFunder, vestingAcc)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewMsgSetVestingManagerCmd returns a CLI command handler for setting or
+// clearing the manager of a clawback vesting account.
+func NewMsgSetVestingManagerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-vesting-manager VESTING_ACCOUNT_ADDRESS [MANAGER_ADDRESS]",
+		Short: "Set or clear the manager of an existing ClawbackVestingAccount.",
+		Long: `Must be requested by the funder address (--from).
+		The manager can fund and clawback the vesting account on behalf of the funder.
+		If MANAGER_ADDRESS is omitted, the current manager is cleared.`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			vestingAcc, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var manager sdk.AccAddress
+			if len(args) == 2 {
+				manager, err = sdk.AccAddressFromBech32(args[1])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgSetVestingManager(clientCtx.GetFromAddress(), vestingAcc, manager)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewMsgRenounceClawbackCmd returns a CLI command handler for renouncing the
+// clawback of a clawback vesting account.
+func NewMsgRenounceClawbackCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "renounce-clawback VESTING_ACCOUNT_ADDRESS",
+		Short: "Permanently renounce the clawback of an existing ClawbackVestingAccount.",
+		Long: `Must be requested by the funder address (--from).
+		Once renounced, neither the funder nor the manager can clawback the vesting account.
+		Governance clawback can also be renounced (--gov-clawback).`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			vestingAcc, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			govClawback, err := cmd.Flags().GetBool(FlagGovClawback)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRenounceClawback(clientCtx.GetFromAddress(), vestingAcc, govClawback)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagGovClawback, false, "also renounce the governance clawback of the account")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewMsgProposeScheduleAmendmentCmd returns a CLI command handler for proposing
+// an amendment of the schedules of a clawback vesting account.
+func NewMsgProposeScheduleAmendmentCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-schedule-amendment VESTING_ACCOUNT_ADDRESS",
+		Short: "Propose new future lockup and/or vesting periods for an existing ClawbackVestingAccount.",
+		Long: `Must be requested by the funder address (--from).
+Must provide a lockup periods file (--lockup), a vesting periods file (--vesting), or both.
+The periods are relative to the time at which the vesting account accepts the amendment
+and must describe the coins that are still locked up or unvested at that time.
+The start time of the periods files is ignored.
+The amendment is applied once accepted by the vesting account with the accept-schedule-amendment command.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var lockupPeriods, vestingPeriods sdkvesting.Periods
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			vestingAcc, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			lockupFile, _ := cmd.Flags().GetString(FlagLockup)
+			vestingFile, _ := cmd.Flags().GetString(FlagVesting)
+			if lockupFile == "" && vestingFile == "" {
+				return fmt.Errorf("must specify at least one of %s or %s", FlagLockup, FlagVesting)
+			}
+			if lockupFile != "" {
+				_, lockupPeriods, err = ReadScheduleFile(lockupFile)
+				if err != nil {
+					return err
+				}
+			}
+			if vestingFile != "" {
+				_, vestingPeriods, err = ReadScheduleFile(vestingFile)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgProposeScheduleAmendment(clientCtx.GetFromAddress(), vestingAcc, lockupPeriods, vestingPeriods)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagLockup, "", "path to file containing the future unlocking periods")
+	cmd.Flags().String(FlagVesting, "", "path to file containing the future vesting periods")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewMsgAcceptScheduleAmendmentCmd returns a CLI command handler for accepting
+// the pending schedule amendment of a clawback vesting account.
+func NewMsgAcceptScheduleAmendmentCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-schedule-amendment",
+		Short: "Accept the schedule amendment proposed by the funder of a ClawbackVestingAccount.",
+		Long:  "Must be requested by the vesting account address (--from).",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAcceptScheduleAmendment(clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewMsgSelfLockupCmd returns a CLI command handler for locking up a portion of
+// the balance of the sender account.
+func NewMsgSelfLockupCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "self-lockup",
+		Short: "Lock up a portion of the balance of the sender account under a lockup schedule.",
+		Long: `Must provide a lockup periods file (--lockup).
+The lockup schedule starts at the block time of the transaction; the start time of the periods file is ignored.
+The locked up coins cannot be transferred until unlocked, and cannot be clawed back by anyone.
+An account that is not a clawback vesting account is converted into one, with itself as funder.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			lockupFile, _ := cmd.Flags().GetString(FlagLockup)
+			if lockupFile == "" {
+				return fmt.Errorf("must specify %s", FlagLockup)
+			}
+
+			_, lockupPeriods, err := ReadScheduleFile(lockupFile)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSelfLockup(clientCtx.GetFromAddress(), lockupPeriods)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagLockup, "", "path to file containing unlocking periods")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewMsgCreateFundingInstructionCmd returns a CLI command handler for
+// registering a recurring funding of a clawback vesting account.
+func NewMsgCreateFundingInstructionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-funding-instruction VESTING_ADDRESS",
+		Short: "Register a recurring funding of a vesting account.",
+		Long: `Must provide a lockup periods file (--lockup), the interval in seconds between grants (--interval) and the number of grants (--count).
+The first grant is due at the start time of the periods file, and each following grant one interval later.
+Each grant transfers the total amount of the lockup periods from the --from address to the vesting account,
+locked up according to the lockup periods relative to its due time. The granted coins vest immediately.
+A grant that cannot be executed, e.g. because the funder lacks balance, is skipped.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			vestingAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			lockupFile, _ := cmd.Flags().GetString(FlagLockup)
+			if lockupFile == "" {
+				return fmt.Errorf("must specify %s", FlagLockup)
+			}
+
+			startTime, lockupPeriods, err := ReadScheduleFile(lockupFile)
+			if err != nil {
+				return err
+			}
+
+			interval, err := cmd.Flags().GetInt64(FlagInterval)
+			if err != nil {
+				return err
+			}
+
+			count, err := cmd.Flags().GetUint64(FlagCount)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateFundingInstruction(clientCtx.GetFromAddress(), vestingAddr, time.Unix(startTime, 0), interval, count, lockupPeriods)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagLockup, "", "path to file containing the unlocking periods of each grant")
+	cmd.Flags().Int64(FlagInterval, 0, "interval in seconds between grants")
+	cmd.Flags().Uint64(FlagCount, 0, "number of grants")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewMsgCancelFundingInstructionCmd returns a CLI command handler for
+// cancelling a recurring funding instruction.
+func NewMsgCancelFundingInstructionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-funding-instruction ID",
+		Short: "Cancel a recurring funding instruction created by the sender.",
+		Long:  "Cancel a recurring funding instruction created by the sender. The grants that have already been executed are not modified.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelFundingInstruction(clientCtx.GetFromAddress(), id)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewMsgCreateEscrowGrantCmd returns a CLI command handler for funding a
+// vesting grant held in escrow by the vesting module account.
+func NewMsgCreateEscrowGrantCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-escrow-grant RECIPIENT_ADDRESS",
+		Short: "Fund a vesting grant held in escrow by the vesting module.",
+		Long: `Must provide a lockup periods file (--lockup), a vesting periods file (--vesting), or both.
+If both files are given, they must describe schedules for the same total amount.
+If one file is omitted, it will default to a schedule that immediately unlocks or vests the entire amount.
+The described amount of coins will be transferred from the --from address to the vesting module account,
+and released to the recipient with the claim-escrow-grant command as they vest and unlock.
+Unvested coins may be "clawed back" by the funder with the clawback-escrow-grant command.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var (
+				lockupStart, vestingStart     int64
+				lockupPeriods, vestingPeriods sdkvesting.Periods
+			)
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			recipient, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			lockupFile, _ := cmd.Flags().GetString(FlagLockup)
+			vestingFile, _ := cmd.Flags().GetString(FlagVesting)
+			if lockupFile == "" && vestingFile == "" {
+				return fmt.Errorf("must specify at least one of %s or %s", FlagLockup, FlagVesting)
+			}
+			if lockupFile != "" {
+				lockupStart, lockupPeriods, err = ReadScheduleFile(lockupFile)
+				if err != nil {
+					return err
+				}
+			}
+			if vestingFile != "" {
+				vestingStart, vestingPeriods, err = ReadScheduleFile(vestingFile)
+				if err != nil {
+					return err
+				}
+			}
+
+			commonStart, _ := types.AlignSchedules(lockupStart, vestingStart, lockupPeriods, vestingPeriods)
+
+			msg := types.NewMsgCreateEscrowGrant(clientCtx.GetFromAddress(), recipient, time.Unix(commonStart, 0), lockupPeriods, vestingPeriods)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagLockup, "", "path to file containing unlocking periods")
+	cmd.Flags().String(FlagVesting, "", "path to file containing vesting periods")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewMsgClaimEscrowGrantCmd returns a CLI command handler for claiming the
+// vested and unlocked coins of an escrow grant.
+func NewMsgClaimEscrowGrantCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-escrow-grant ID",
+		Short: "Claim the vested and unlocked coins of an escrow grant.",
+		Long:  "Claim the vested and unlocked coins of an escrow grant that have not been claimed yet. Must be requested by the recipient of the grant (--from).",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgClaimEscrowGrant(clientCtx.GetFromAddress(), id)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewMsgClawbackEscrowGrantCmd returns a CLI command handler for clawing back
+// the unvested coins of an escrow grant.
+func NewMsgClawbackEscrowGrantCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clawback-escrow-grant ID",
+		Short: "Transfer the unvested coins of an escrow grant out of escrow.",
+		Long: `Must be requested by the funder of the grant (--from).
+May provide a destination address (--dest), otherwise the coins return to the funder.
+The vested coins remain in escrow and can still be claimed by the recipient as they unlock.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			var dest sdk.AccAddress
+			destString, _ := cmd.Flags().GetString(FlagDest)
+			if destString != "" {
+				dest, err = sdk.AccAddressFromBech32(destString)
+				if err != nil {
+					return fmt.Errorf("bad dest address: %w", err)
+				}
+			}
+
+			msg := types.NewMsgClawbackEscrowGrant(clientCtx.GetFromAddress(), id, dest)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagDest, "", "address of destination (defaults to funder)")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewMsgCreateFunderCommitteeCmd returns a CLI command handler for creating a
+// funder committee.
+func NewMsgCreateFunderCommitteeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-funder-committee MEMBERS THRESHOLD",
+		Short: "Create a funder committee whose proposals execute once approved by the threshold of its members.",
+		Long: `Create a funder committee with the given comma separated list of member addresses.
+The committee address, returned in the response, can be set as the funder of clawback vesting accounts
+with the update-vesting-funder command. The clawback, funder update and vesting acceleration of these accounts
+are then proposed by a member with submit-committee-proposal and executed once THRESHOLD members approved them.
+Proposals that are not executed within the --proposal-lifetime (in seconds) expire.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			members := strings.Split(args[0], ",")
+
+			threshold, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			proposalLifetime, err := cmd.Flags().GetInt64(FlagLifetime)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateFunderCommittee(clientCtx.GetFromAddress(), members, uint32(threshold), proposalLifetime)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Int64(FlagLifetime, 7*24*60*60, "duration in seconds after which a proposal that has not been executed expires")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewMsgSubmitCommitteeProposalCmd returns a CLI command handler for proposing
+// an action on a vesting account funded by a funder committee.
+func NewMsgSubmitCommitteeProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-committee-proposal COMMITTEE_ADDRESS ACTION VESTING_ADDRESS",
+		Short: "Propose an action on a vesting account funded by a funder committee.",
+		Long: `Must be submitted by a member of the committee (--from), whose approval is counted.
+ACTION is one of:
+  clawback       claw back the unvested coins to the destination address (--dest, required)
+  update-funder  update the funder of the account to the new funder address (--new-funder, required)
+  accelerate     vest all the unvested coins of the account immediately
+The proposal is executed once the threshold of the committee is reached.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			committee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var action types.CommitteeAction
+			switch args[1] {
+			case "clawback":
+				action = types.COMMITTEE_ACTION_CLAWBACK
+			case "update-funder":
+				action = types.COMMITTEE_ACTION_UPDATE_FUNDER
+			case "accelerate":
+				action = types.COMMITTEE_ACTION_ACCELERATE
+			default:
+				return fmt.Errorf("invalid action %s, expected clawback, update-funder or accelerate", args[1])
+			}
+
+			vestingAddr, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return err
+			}
+
+			dest, _ := cmd.Flags().GetString(FlagDest)
+			newFunder, _ := cmd.Flags().GetString(FlagNewFunder)
+
+			msg := types.NewMsgSubmitCommitteeProposal(clientCtx.GetFromAddress(), committee, vestingAddr, action, dest, newFunder)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagDest, "", "address of destination of a clawback")
+	cmd.Flags().String(FlagNewFunder, "", "address of the new funder of a funder update")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewMsgApproveCommitteeProposalCmd returns a CLI command handler for
+// approving a funder committee proposal.
+func NewMsgApproveCommitteeProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve-committee-proposal ID",
+		Short: "Approve a funder committee proposal.",
+		Long:  "Approve a funder committee proposal as a member of the committee (--from). The proposal is executed once the threshold of the committee is reached.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgApproveCommitteeProposal(clientCtx.GetFromAddress(), id)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -209,19 +875,19 @@ func NewMsgClawbackCmd() *cobra.Command {
 		},
 	}
 
-	cmd.Flags().String(FlagDest, "", "address of destination (defaults to funder)")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
 
-// NewMsgUpdateVestingFunderCmd returns a CLI command handler for updating
-// the funder of a ClawbackVestingAccount.
-func NewMsgUpdateVestingFunderCmd() *cobra.Command {
+// NewMsgMergeVestingAccountsCmd returns a CLI command handler for merging two
+// clawback vesting accounts.
+func NewMsgMergeVestingAccountsCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "update-vesting-funder VESTING_ACCOUNT_ADDRESS NEW_FUNDER_ADDRESS",
-		Short: "Update the funder account of an existing ClawbackVestingAccount.",
-		Long: `Must be requested by the original funder address (--from).
-		Need to provide the target VESTING_ACCOUNT_ADDRESS to update and the NEW_FUNDER_ADDRESS.`,
+		Use:   "merge-vesting-accounts SOURCE_ADDRESS TARGET_ADDRESS",
+		Short: "Merge a clawback vesting account into another one with the same funder.",
+		Long: `Merge the schedule, balance and delegations of the source clawback vesting account into the target one, as their funder (--from).
+The source account is converted into a normal account.
+The transaction must be signed by the funder and both accounts, e.g. by generating it with --generate-only and signing it with each key.`,
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -229,17 +895,17 @@ func NewMsgUpdateVestingFunderCmd() *cobra.Command {
 				return err
 			}
 
-			vestingAcc, err := sdk.AccAddressFromBech32(args[0])
+			source, err := sdk.AccAddressFromBech32(args[0])
 			if err != nil {
 				return err
 			}
 
-			newFunder, err := sdk.AccAddressFromBech32(args[1])
+			target, err := sdk.AccAddressFromBech32(args[1])
 			if err != nil {
 				return err
 			}
 
-			msg := types.NewMsgUpdateVestingFunder(clientCtx.GetFromAddress(), newFunder, vestingAcc)
+			msg := types.NewMsgMergeVestingAccounts(clientCtx.GetFromAddress(), source, target)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -247,6 +913,7 @@ func NewMsgUpdateVestingFunderCmd() *cobra.Command {
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -288,6 +955,8 @@ func NewMsgConvertVestingAccountCmd() *cobra.Command {
 // a proposal to clawback funds from a specified vesting account,
 // that has this functionality enabled.
 //
+// Deprecated: use NewGovClawbackProposalCmd, which submits a gov v1 proposal.
+//
 //nolint:staticcheck
 func NewClawbackProposalCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -369,3 +1038,414 @@ func NewClawbackProposalCmd() *cobra.Command {
 	}
 	return cmd
 }
+
+// NewGovClawbackProposalCmd implements the command to submit a gov v1
+// proposal executing a MsgClawback with the governance module account as
+// signer, to clawback funds from a vesting account that has this functionality
+// enabled.
+func NewGovClawbackProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gov-clawback ADDRESS [DEST_ADDRESS]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Submit a governance proposal to clawback funds from a ClawbackVestingAccount",
+		Long:  "Submit a governance proposal executing a MsgClawback on a ClawbackVestingAccount that has this functionality enabled.",
+		Example: fmt.Sprintf(
+			`$ %s tx vesting gov-clawback <address> \
+--from=<key_or_address> \
+--title=<proposal_title> \
+--summary=<proposal_summary> \
+--deposit=<deposit>`,
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var destinationAddr string
+			if len(args) == 2 {
+				destinationAddr = args[1]
+			}
+
+			burn, err := cmd.Flags().GetBool(FlagBurn)
+			if err != nil {
+				return err
+			}
+
+			destinationsStr, err := cmd.Flags().GetString(FlagDestinations)
+			if err != nil {
+				return err
+			}
+
+			destinations, err := ParseClawbackDestinations(destinationsStr)
+			if err != nil {
+				return err
+			}
+
+			clawbackMsg := &types.MsgClawback{
+				FunderAddress:  authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				AccountAddress: args[0],
+				DestAddress:    destinationAddr,
+				Burn:           burn,
+				Destinations:   destinations,
+			}
+
+			return submitGovProposal(clientCtx, cmd, clawbackMsg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagBurn, false, "burn the clawed back coins instead of transferring them")
+	cmd.Flags().String(FlagDestinations, "", "comma separated list of weighted destinations in the ADDRESS:WEIGHT format")
+	addGovProposalFlags(cmd)
+	return cmd
+}
+
+// NewGovBatchClawbackProposalCmd implements the command to submit a gov v1
+// proposal executing a MsgBatchClawback, to clawback funds from multiple vesting
+// accounts that have this functionality enabled.
+func NewGovBatchClawbackProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gov-batch-clawback ADDRESS...",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Submit a governance proposal to clawback funds from multiple ClawbackVestingAccounts",
+		Long: `Submit a governance proposal executing a MsgBatchClawback on ClawbackVestingAccounts that have this functionality enabled.
+		The clawed back funds are sent to the community pool unless a destination is provided with the --dest flag.
+		With the --best-effort flag, the accounts whose clawback fails are skipped instead of failing the whole proposal.`,
+		Example: fmt.Sprintf(
+			`$ %s tx vesting gov-batch-clawback <address_1> <address_2> \
+--best-effort \
+--from=<key_or_address> \
+--title=<proposal_title> \
+--summary=<proposal_summary> \
+--deposit=<deposit>`,
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			destinationAddr, err := cmd.Flags().GetString(FlagDest)
+			if err != nil {
+				return err
+			}
+
+			bestEffort, err := cmd.Flags().GetBool(FlagBestEffort)
+			if err != nil {
+				return err
+			}
+
+			burn, err := cmd.Flags().GetBool(FlagBurn)
+			if err != nil {
+				return err
+			}
+
+			batchClawbackMsg := &types.MsgBatchClawback{
+				Authority:        authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				AccountAddresses: args,
+				DestAddress:      destinationAddr,
+				BestEffort:       bestEffort,
+				Burn:             burn,
+			}
+
+			return submitGovProposal(clientCtx, cmd, batchClawbackMsg)
+		},
+	}
+
+	cmd.Flags().String(FlagDest, "", "address of the destination of the clawed back funds (defaults to the community pool)")
+	cmd.Flags().Bool(FlagBestEffort, false, "skip the accounts whose clawback fails instead of failing the proposal")
+	cmd.Flags().Bool(FlagBurn, false, "burn the clawed back coins instead of transferring them")
+	addGovProposalFlags(cmd)
+	return cmd
+}
+
+// NewGovPauseVestingProposalCmd returns a CLI command handler for submitting a
+// governance proposal to pause the vesting of a ClawbackVestingAccount.
+func NewGovPauseVestingProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gov-pause-vesting ADDRESS",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a governance proposal to pause the vesting of a ClawbackVestingAccount",
+		Long: `Submit a governance proposal executing a MsgPauseVesting on a ClawbackVestingAccount.
+		No coins vest while the vesting is paused. The lockup schedule is not affected.`,
+		Example: fmt.Sprintf(
+			`$ %s tx vesting gov-pause-vesting <address> \
+--from=<key_or_address> \
+--title=<proposal_title> \
+--summary=<proposal_summary> \
+--deposit=<deposit>`,
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pauseMsg := &types.MsgPauseVesting{
+				Authority:      authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				VestingAddress: args[0],
+			}
+
+			return submitGovProposal(clientCtx, cmd, pauseMsg)
+		},
+	}
+
+	addGovProposalFlags(cmd)
+	return cmd
+}
+
+// NewGovResumeVestingProposalCmd returns a CLI command handler for submitting a
+// governance proposal to resume the paused vesting of a ClawbackVestingAccount.
+func NewGovResumeVestingProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gov-resume-vesting ADDRESS",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a governance proposal to resume the paused vesting of a ClawbackVestingAccount",
+		Long: `Submit a governance proposal executing a MsgResumeVesting on a ClawbackVestingAccount.
+		The upcoming vesting periods are shifted by the pause duration.`,
+		Example: fmt.Sprintf(
+			`$ %s tx vesting gov-resume-vesting <address> \
+--from=<key_or_address> \
+--title=<proposal_title> \
+--summary=<proposal_summary> \
+--deposit=<deposit>`,
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			resumeMsg := &types.MsgResumeVesting{
+				Authority:      authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				VestingAddress: args[0],
+			}
+
+			return submitGovProposal(clientCtx, cmd, resumeMsg)
+		},
+	}
+
+	addGovProposalFlags(cmd)
+	return cmd
+}
+
+// NewGovUnlockVestingProposalCmd returns a CLI command handler for submitting a
+// governance proposal to unlock all the lockup periods of ClawbackVestingAccounts.
+func NewGovUnlockVestingProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gov-unlock-vesting ADDRESS...",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Submit a governance proposal to unlock the locked up coins of ClawbackVestingAccounts",
+		Long: `Submit a governance proposal executing a MsgUnlockVesting on the given ClawbackVestingAccounts.
+		All the lockup periods of the accounts are unlocked. The vesting schedules are not affected.`,
+		Example: fmt.Sprintf(
+			`$ %s tx vesting gov-unlock-vesting <address_1> <address_2> \
+--from=<key_or_address> \
+--title=<proposal_title> \
+--summary=<proposal_summary> \
+--deposit=<deposit>`,
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			unlockMsg := &types.MsgUnlockVesting{
+				Authority:        authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				VestingAddresses: args,
+			}
+
+			return submitGovProposal(clientCtx, cmd, unlockMsg)
+		},
+	}
+
+	addGovProposalFlags(cmd)
+	return cmd
+}
+
+// NewGovFundVestingAccountProposalCmd returns a CLI command handler for
+// submitting a governance proposal to fund a ClawbackVestingAccount from the
+// community pool.
+func NewGovFundVestingAccountProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gov-fund-vesting-account ADDRESS",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a governance proposal to fund a ClawbackVestingAccount from the community pool",
+		Long: `Submit a governance proposal executing a MsgFundVestingAccountFromCommunityPool, with governance as the funder.
+		Must provide a lockup periods file (--lockup), a vesting periods file (--vesting), or both.
+		An account that is not a clawback vesting account is converted into one.
+		The unvested coins can be returned to the community pool with a governance clawback.`,
+		Example: fmt.Sprintf(
+			`$ %s tx vesting gov-fund-vesting-account <address> \
+--lockup=<lockup_periods_file> \
+--vesting=<vesting_periods_file> \
+--from=<key_or_address> \
+--title=<proposal_title> \
+--summary=<proposal_summary> \
+--deposit=<deposit>`,
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var (
+				lockupStart, vestingStart     int64
+				lockupPeriods, vestingPeriods sdkvesting.Periods
+			)
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			lockupFile, _ := cmd.Flags().GetString(FlagLockup)
+			vestingFile, _ := cmd.Flags().GetString(FlagVesting)
+			if lockupFile == "" && vestingFile == "" {
+				return fmt.Errorf("must specify at least one of %s or %s", FlagLockup, FlagVesting)
+			}
+			if lockupFile != "" {
+				lockupStart, lockupPeriods, err = ReadScheduleFile(lockupFile)
+				if err != nil {
+					return err
+				}
+			}
+			if vestingFile != "" {
+				vestingStart, vestingPeriods, err = ReadScheduleFile(vestingFile)
+				if err != nil {
+					return err
+				}
+			}
+
+			commonStart, _ := types.AlignSchedules(lockupStart, vestingStart, lockupPeriods, vestingPeriods)
+
+			fundMsg := &types.MsgFundVestingAccountFromCommunityPool{
+				Authority:      authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				VestingAddress: args[0],
+				StartTime:      time.Unix(commonStart, 0),
+				LockupPeriods:  lockupPeriods,
+				VestingPeriods: vestingPeriods,
+			}
+
+			return submitGovProposal(clientCtx, cmd, fundMsg)
+		},
+	}
+
+	cmd.Flags().String(FlagLockup, "", "path to file containing unlocking periods")
+	cmd.Flags().String(FlagVesting, "", "path to file containing vesting periods")
+	addGovProposalFlags(cmd)
+	return cmd
+}
+
+// NewGovRecoverVestingAccountProposalCmd returns a CLI command handler for
+// submitting a governance proposal to migrate a ClawbackVestingAccount to a new
+// address.
+func NewGovRecoverVestingAccountProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gov-recover-vesting-account VESTING_ADDRESS NEW_ADDRESS",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a governance proposal to migrate a ClawbackVestingAccount to a new address",
+		Long: `Submit a governance proposal executing a MsgRecoverVestingAccount.
+		The balance, delegations and full schedule of the account are migrated to the existing account at the new address.
+		The unvested and locked coins remain so, and the old account is converted into a normal account.`,
+		Example: fmt.Sprintf(
+			`$ %s tx vesting gov-recover-vesting-account <vesting_address> <new_address> \
+--from=<key_or_address> \
+--title=<proposal_title> \
+--summary=<proposal_summary> \
+--deposit=<deposit>`,
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			recoverMsg := &types.MsgRecoverVestingAccount{
+				Authority:      authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				VestingAddress: args[0],
+				NewAddress:     args[1],
+			}
+
+			return submitGovProposal(clientCtx, cmd, recoverMsg)
+		},
+	}
+
+	addGovProposalFlags(cmd)
+	return cmd
+}
+
+// addGovProposalFlags adds the flags of a gov v1 proposal and the transaction
+// flags to the given command.
+func addGovProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(cli.FlagSummary, "", "summary of proposal")
+	cmd.Flags().String(cli.FlagMetadata, "", "metadata of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "", "deposit of proposal")
+	flags.AddTxFlagsToCmd(cmd)
+
+	if err := cmd.MarkFlagRequired(cli.FlagTitle); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagSummary); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDeposit); err != nil {
+		panic(err)
+	}
+}
+
+// submitGovProposal validates the given message and generates or broadcasts a
+// gov v1 MsgSubmitProposal executing it, using the proposal flags of the command.
+func submitGovProposal(clientCtx client.Context, cmd *cobra.Command, proposalMsg sdk.Msg) error {
+	if err := proposalMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	title, err := cmd.Flags().GetString(cli.FlagTitle)
+	if err != nil {
+		return err
+	}
+
+	summary, err := cmd.Flags().GetString(cli.FlagSummary)
+	if err != nil {
+		return err
+	}
+
+	metadata, err := cmd.Flags().GetString(cli.FlagMetadata)
+	if err != nil {
+		return err
+	}
+
+	depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+	if err != nil {
+		return err
+	}
+
+	deposit, err := sdk.ParseCoinsNormalized(depositStr)
+	if err != nil {
+		return err
+	}
+
+	msg, err := govv1.NewMsgSubmitProposal(
+		[]sdk.Msg{proposalMsg},
+		deposit,
+		clientCtx.GetFromAddress().String(),
+		metadata,
+		title,
+		summary,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
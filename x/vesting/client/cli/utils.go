@@ -8,9 +8,13 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+
+	"github.com/evmos/vesting/x/vesting/types"
 )
 
 type VestingData struct {
@@ -56,3 +60,30 @@ func ReadScheduleFile(path string) (int64, sdkvesting.Periods, error) {
 
 	return startTime, periods, nil
 }
+
+// ParseClawbackDestinations parses a comma separated list of clawback
+// destinations in the ADDRESS:WEIGHT format.
+func ParseClawbackDestinations(destinationsStr string) ([]types.ClawbackDestination, error) {
+	if destinationsStr == "" {
+		return nil, nil
+	}
+
+	entries := strings.Split(destinationsStr, ",")
+	destinations := make([]types.ClawbackDestination, 0, len(entries))
+
+	for _, entry := range entries {
+		address, weightStr, found := strings.Cut(strings.TrimSpace(entry), ":")
+		if !found {
+			return nil, fmt.Errorf("invalid destination %s, expected ADDRESS:WEIGHT", entry)
+		}
+
+		weight, err := strconv.ParseUint(weightStr, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid weight of destination %s: %w", address, err)
+		}
+
+		destinations = append(destinations, types.ClawbackDestination{Address: address, Weight: weight})
+	}
+
+	return destinations, nil
+}
@@ -8,4 +8,8 @@ import (
 	"github.com/evmos/vesting/x/vesting/client/cli"
 )
 
+// RegisterClawbackProposalHandler registers the legacy clawback proposal command.
+//
+// Deprecated: use the gov-clawback command of the vesting module, which submits
+// a gov v1 proposal.
 var RegisterClawbackProposalHandler = govclient.NewProposalHandler(cli.NewClawbackProposalCmd)
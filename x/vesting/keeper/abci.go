@@ -0,0 +1,312 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/evmos/vesting/x/vesting/types"
+)
+
+// maxExpiredCommitteeProposalsPerBlock is the maximum number of expired funder
+// committee proposals removed in a block.
+const maxExpiredCommitteeProposalsPerBlock = 100
+
+// EndBlocker executes the recurring funding instructions that are due, removes
+// the expired funder committee proposals, emits the events of the lockup and
+// vesting periods that have been reached and converts the clawback vesting
+// accounts whose vesting and lockup schedules have ended, if the automatic
+// conversion is enabled.
+func (k Keeper) EndBlocker(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+
+	k.executeFundingInstructions(ctx, params.MaxFundingInstructionsPerBlock)
+	k.pruneExpiredCommitteeProposals(ctx, maxExpiredCommitteeProposalsPerBlock)
+	k.emitScheduleEvents(ctx, params.MaxScheduleEventsPerBlock)
+
+	if params.EnableAutoConvert {
+		k.convertCompletedVestingAccounts(ctx, params.MaxConversionsPerBlock)
+	}
+}
+
+// executeFundingInstructions executes the grants of the recurring funding
+// instructions that are due at or before the current block time. At most
+// maxInstructions grants are executed in a block, starting with the earliest
+// ones. An instruction whose vesting account is no longer a clawback vesting
+// account, or whose funder is no longer the funder or manager of the account,
+// is removed. A grant that fails for any other reason, e.g. because the funder
+// lacks balance, is postponed by one interval without consuming the remaining
+// grants. A failure event is emitted in both cases.
+func (k Keeper) executeFundingInstructions(ctx sdk.Context, maxInstructions uint32) {
+	if maxInstructions == 0 {
+		return
+	}
+
+	type queueEntry struct {
+		dueTime int64
+		id      uint64
+	}
+
+	// NOTE: collect the entries first to avoid writing to the store while
+	// iterating over it
+	var entries []queueEntry
+	k.IterateFundingInstructionQueue(ctx, ctx.BlockTime().Unix(), func(dueTime int64, id uint64) bool {
+		entries = append(entries, queueEntry{dueTime: dueTime, id: id})
+		return uint32(len(entries)) >= maxInstructions
+	})
+
+	for _, entry := range entries {
+		instruction, found := k.GetFundingInstruction(ctx, entry.id)
+		if !found || instruction.NextTime != entry.dueTime {
+			// skip stale entries
+			ctx.KVStore(k.storeKey).Delete(types.FundingInstructionQueueKey(entry.dueTime, entry.id))
+			continue
+		}
+
+		k.DeleteFundingInstruction(ctx, instruction)
+
+		va, err := k.getFundedAccount(ctx, instruction)
+		if err != nil {
+			// the instruction can no longer be executed, so it is not rescheduled
+			instruction.RemainingCount = 0
+			k.emitFundingInstructionFailed(ctx, instruction, err)
+			continue
+		}
+
+		// NOTE: use a cached context so that the grant is reverted if it fails
+		cacheCtx, writeCache := ctx.CacheContext()
+		coins, err := k.executeFundingInstruction(cacheCtx, instruction, va)
+		if err != nil {
+			// postpone the grant without consuming it
+			instruction.NextTime += instruction.Interval
+			k.SetFundingInstruction(ctx, instruction)
+			k.emitFundingInstructionFailed(ctx, instruction, err)
+			continue
+		}
+
+		writeCache()
+
+		// schedule the next grant, if any
+		instruction.RemainingCount--
+		if instruction.RemainingCount > 0 {
+			instruction.NextTime += instruction.Interval
+			k.SetFundingInstruction(ctx, instruction)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeExecuteFundingInstruction,
+				sdk.NewAttribute(types.AttributeKeyID, strconv.FormatUint(instruction.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyAccount, instruction.VestingAddress),
+				sdk.NewAttribute(types.AttributeKeyCoins, coins.String()),
+				sdk.NewAttribute(types.AttributeKeyRemaining, strconv.FormatUint(instruction.RemainingCount, 10)),
+			),
+		)
+	}
+}
+
+// emitFundingInstructionFailed logs the error of a failed grant of the given
+// funding instruction and emits a failure event.
+func (k Keeper) emitFundingInstructionFailed(ctx sdk.Context, instruction types.FundingInstruction, err error) {
+	k.Logger(ctx).Error("failed to execute funding instruction", "id", instruction.Id, "error", err)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFundingInstructionFailed,
+			sdk.NewAttribute(types.AttributeKeyID, strconv.FormatUint(instruction.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyAccount, instruction.VestingAddress),
+			sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+			sdk.NewAttribute(types.AttributeKeyRemaining, strconv.FormatUint(instruction.RemainingCount, 10)),
+		),
+	)
+}
+
+// getFundedAccount returns the clawback vesting account funded by the given
+// funding instruction. It returns an error if the account is not a clawback
+// vesting account or if the funder of the instruction is no longer its funder
+// or manager, in which case the instruction can no longer be executed.
+func (k Keeper) getFundedAccount(ctx sdk.Context, instruction types.FundingInstruction) (*types.ClawbackVestingAccount, error) {
+	va, err := k.GetClawbackVestingAccount(ctx, sdk.MustAccAddressFromBech32(instruction.VestingAddress))
+	if err != nil {
+		return nil, err
+	}
+
+	if !va.IsFunderOrManager(instruction.FunderAddress) {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized, "%s is no longer the funder or manager of account %s", instruction.FunderAddress, instruction.VestingAddress)
+	}
+
+	return va, nil
+}
+
+// executeFundingInstruction adds the grant of the given funding instruction,
+// due at its next time, to the vesting account and transfers the coins from
+// the funder. It returns the granted coins.
+func (k Keeper) executeFundingInstruction(ctx sdk.Context, instruction types.FundingInstruction, va *types.ClawbackVestingAccount) (sdk.Coins, error) {
+	funderAddr := sdk.MustAccAddressFromBech32(instruction.FunderAddress)
+	vestingAddr := va.GetAddress()
+
+	if va.IsVestingPaused() {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "vesting of account %s is paused", instruction.VestingAddress)
+	}
+
+	coins := instruction.LockupPeriods.TotalAmount()
+	vestingPeriods := sdkvesting.Periods{{Length: 0, Amount: coins}}
+
+	// the schedule of the account changes with the new grant
+	k.deleteVestingIndexes(ctx, va)
+	if err := k.addGrant(ctx, va, instruction.NextTime, instruction.LockupPeriods, vestingPeriods, coins); err != nil {
+		return nil, err
+	}
+	k.accountKeeper.SetAccount(ctx, va)
+	k.setVestingIndexes(ctx, va)
+
+	if err := k.bankKeeper.SendCoins(ctx, funderAddr, vestingAddr, coins); err != nil {
+		return nil, err
+	}
+
+	if err := k.Hooks().AfterVestingAccountFunded(ctx, vestingAddr, funderAddr, coins); err != nil {
+		return nil, err
+	}
+
+	return coins, nil
+}
+
+// pruneExpiredCommitteeProposals removes up to maxProposals funder committee
+// proposals that expired at or before the current block time, oldest first.
+func (k Keeper) pruneExpiredCommitteeProposals(ctx sdk.Context, maxProposals int) {
+	type expiryEntry struct {
+		expiryTime int64
+		id         uint64
+	}
+
+	// NOTE: collect the entries first to avoid writing to the store while
+	// iterating over it
+	var entries []expiryEntry
+	k.IterateExpiredCommitteeProposals(ctx, ctx.BlockTime().Unix(), func(expiryTime int64, id uint64) bool {
+		entries = append(entries, expiryEntry{expiryTime: expiryTime, id: id})
+		return len(entries) >= maxProposals
+	})
+
+	for _, entry := range entries {
+		proposal, found := k.GetCommitteeProposal(ctx, entry.id)
+		if !found {
+			// remove stale entries
+			ctx.KVStore(k.storeKey).Delete(types.CommitteeProposalExpiryKey(entry.expiryTime, entry.id))
+			continue
+		}
+		k.DeleteCommitteeProposal(ctx, proposal)
+	}
+}
+
+// emitScheduleEvents emits an unlocked or vested event for each lockup or
+// vesting period that ended at or before the current block time. At most
+// maxEvents index entries are processed in a block, starting with the earliest
+// ones, so that the remaining events are emitted in the following blocks
+// (e.g. after a chain halt).
+func (k Keeper) emitScheduleEvents(ctx sdk.Context, maxEvents uint32) {
+	if maxEvents == 0 {
+		return
+	}
+
+	type indexEntry struct {
+		eventTime   int64
+		addr        sdk.AccAddress
+		eventType   byte
+		periodIndex uint64
+	}
+
+	// NOTE: collect the entries first to avoid writing to the store while
+	// iterating over it
+	var entries []indexEntry
+	k.IterateScheduleEventIndex(ctx, ctx.BlockTime().Unix(), func(eventTime int64, addr sdk.AccAddress, eventType byte, periodIndex uint64) bool {
+		entries = append(entries, indexEntry{eventTime: eventTime, addr: addr, eventType: eventType, periodIndex: periodIndex})
+		return uint32(len(entries)) >= maxEvents
+	})
+
+	store := ctx.KVStore(k.storeKey)
+	for _, entry := range entries {
+		store.Delete(types.ScheduleEventIndexKey(entry.eventTime, entry.addr, entry.eventType, entry.periodIndex))
+
+		va, err := k.GetClawbackVestingAccount(ctx, entry.addr)
+		if err != nil {
+			continue
+		}
+
+		eventName, periods := types.EventTypeVested, va.VestingPeriods
+		if entry.eventType == types.ScheduleEventTypeUnlock {
+			eventName, periods = types.EventTypeUnlocked, va.LockupPeriods
+		}
+
+		// skip stale entries whose period has been modified since they were indexed
+		if !isPeriodEndTime(va.GetStartTime(), periods, entry.periodIndex, entry.eventTime) {
+			continue
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				eventName,
+				sdk.NewAttribute(types.AttributeKeyAccount, entry.addr.String()),
+				sdk.NewAttribute(types.AttributeKeyAmount, periods[entry.periodIndex].Amount.String()),
+				sdk.NewAttribute(types.AttributeKeyPeriodIndex, strconv.FormatUint(entry.periodIndex, 10)),
+			),
+		)
+	}
+}
+
+// convertCompletedVestingAccounts converts the clawback vesting accounts whose
+// vesting and lockup schedules have ended into the chain's default account
+// type. At most maxConversions index entries are processed in a block,
+// starting with the earliest end times.
+func (k Keeper) convertCompletedVestingAccounts(ctx sdk.Context, maxConversions uint32) {
+	type indexEntry struct {
+		endTime int64
+		addr    sdk.AccAddress
+	}
+
+	// NOTE: collect the entries first to avoid writing to the store while
+	// iterating over it
+	var entries []indexEntry
+	k.IterateEndTimeIndex(ctx, ctx.BlockTime().Unix(), func(endTime int64, addr sdk.AccAddress) bool {
+		entries = append(entries, indexEntry{endTime: endTime, addr: addr})
+		return uint32(len(entries)) >= maxConversions
+	})
+
+	store := ctx.KVStore(k.storeKey)
+	for _, entry := range entries {
+		va, err := k.GetClawbackVestingAccount(ctx, entry.addr)
+		// remove stale entries of accounts that have already been converted or
+		// whose schedule has been updated since they were indexed
+		if err != nil || va.EndTime != entry.endTime {
+			store.Delete(types.EndTimeIndexKey(entry.endTime, entry.addr))
+			continue
+		}
+
+		if !va.GetVestingCoins(ctx.BlockTime()).IsZero() || va.HasLockedCoins(ctx.BlockTime()) {
+			store.Delete(types.EndTimeIndexKey(entry.endTime, entry.addr))
+			continue
+		}
+
+		// NOTE: use a cached context so that the conversion is reverted if a
+		// hook returns an error. The conversion removes the index entry, which
+		// is kept if it fails so that the conversion is retried in the next block.
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.convertVestingAccount(cacheCtx, va); err != nil {
+			k.Logger(ctx).Error("failed to convert vesting account", "account", entry.addr.String(), "error", err)
+			continue
+		}
+		writeCache()
+	}
+}
+
+// isPeriodEndTime returns true if the period at the given index exists, has a
+// non-zero amount and ends at the given time.
+func isPeriodEndTime(startTime int64, periods sdkvesting.Periods, periodIndex uint64, eventTime int64) bool {
+	if periodIndex >= uint64(len(periods)) || periods[periodIndex].Amount.IsZero() {
+		return false
+	}
+	return periodEndTimes(startTime, periods)[periodIndex] == eventTime
+}
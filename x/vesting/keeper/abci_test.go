@@ -0,0 +1,218 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/evmos/vesting/x/vesting/types"
+)
+
+// endBlock runs the EndBlocker with a new event manager and returns the
+// emitted events.
+func (suite *KeeperTestSuite) endBlock() sdk.Events {
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	suite.keeper.EndBlocker(suite.ctx)
+	return suite.ctx.EventManager().Events()
+}
+
+// eventsOfType returns the events of the given type.
+func eventsOfType(events sdk.Events, eventType string) sdk.Events {
+	filtered := sdk.Events{}
+	for _, event := range events {
+		if event.Type == eventType {
+			filtered = append(filtered, event)
+		}
+	}
+	return filtered
+}
+
+// endTimeIndexEntries returns the addresses of the end time index entries with
+// an end time lower than or equal to the given one.
+func (suite *KeeperTestSuite) endTimeIndexEntries(endTime int64) []sdk.AccAddress {
+	var addrs []sdk.AccAddress
+	suite.keeper.IterateEndTimeIndex(suite.ctx, endTime, func(_ int64, addr sdk.AccAddress) bool {
+		addrs = append(addrs, addr)
+		return false
+	})
+	return addrs
+}
+
+func (suite *KeeperTestSuite) TestEndTimeIndex() {
+	funder := sdk.AccAddress("funder______________")
+	addr := sdk.AccAddress("vesting_account_____")
+	va := suite.createVestingAccount(funder, addr, testLockupPeriods, testVestingPeriods, true)
+	suite.Require().Equal(suite.ctx.BlockTime().Unix()+100, va.EndTime)
+
+	suite.Require().Empty(suite.endTimeIndexEntries(va.EndTime - 1))
+	suite.Require().Equal([]sdk.AccAddress{addr}, suite.endTimeIndexEntries(va.EndTime))
+
+	suite.keeper.DeleteVestingIndexes(suite.ctx, va)
+	suite.Require().Empty(suite.endTimeIndexEntries(va.EndTime))
+
+	suite.keeper.SetVestingIndexes(suite.ctx, va)
+	suite.Require().Equal([]sdk.AccAddress{addr}, suite.endTimeIndexEntries(va.EndTime))
+
+	// accounts without periods are not indexed
+	unfunded := sdk.AccAddress("unfunded_account____")
+	suite.accountKeeper.SetAccount(suite.ctx, suite.accountKeeper.NewAccountWithAddress(suite.ctx, unfunded))
+	_, err := suite.keeper.CreateClawbackVestingAccount(suite.ctx, types.NewMsgCreateClawbackVestingAccount(funder, unfunded, true))
+	suite.Require().NoError(err)
+	suite.Require().Equal([]sdk.AccAddress{addr}, suite.endTimeIndexEntries(va.EndTime))
+}
+
+func (suite *KeeperTestSuite) TestConvertCompletedVestingAccounts() {
+	funder := sdk.AccAddress("funder______________")
+	addr1 := sdk.AccAddress("vesting_account_1___")
+	addr2 := sdk.AccAddress("vesting_account_2___")
+	suite.createVestingAccount(funder, addr1, testLockupPeriods, testVestingPeriods, true)
+	suite.createVestingAccount(funder, addr2, testLockupPeriods, testVestingPeriods, true)
+
+	// the conversion is disabled by default
+	suite.advanceTime(100 * time.Second)
+	suite.Require().Empty(eventsOfType(suite.endBlock(), types.EventTypeConvertVestingAccount))
+	suite.getVestingAccount(addr1)
+
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, types.NewParams(true, 1, 10, 10)))
+
+	// at most one account is converted per block
+	for _, addr := range []sdk.AccAddress{addr1, addr2} {
+		events := eventsOfType(suite.endBlock(), types.EventTypeConvertVestingAccount)
+		suite.Require().Len(events, 1)
+		suite.Require().Equal(addr.String(), string(events[0].Attributes[0].Value))
+
+		_, isBaseAccount := suite.accountKeeper.GetAccount(suite.ctx, addr).(*authtypes.BaseAccount)
+		suite.Require().True(isBaseAccount)
+		suite.Require().Equal(stakeCoins(1000), suite.bankKeeper.SpendableCoins(suite.ctx, addr))
+		suite.advanceTime(time.Second)
+	}
+
+	suite.Require().Empty(suite.endTimeIndexEntries(suite.ctx.BlockTime().Unix()))
+	suite.Require().Empty(eventsOfType(suite.endBlock(), types.EventTypeConvertVestingAccount))
+}
+
+func (suite *KeeperTestSuite) TestConvertCompletedVestingAccountsSkipsStaleEntries() {
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, types.NewParams(true, 10, 10, 10)))
+
+	funder := sdk.AccAddress("funder______________")
+	addr := sdk.AccAddress("vesting_account_____")
+	va := suite.createVestingAccount(funder, addr, testLockupPeriods, testVestingPeriods, true)
+
+	// index the account at an earlier end time, as if its schedule had been
+	// extended without updating the index
+	stale := *va
+	stale.EndTime = va.EndTime - 50
+	suite.keeper.SetEndTimeIndex(suite.ctx, &stale)
+
+	suite.advanceTime(50 * time.Second)
+	suite.Require().Empty(eventsOfType(suite.endBlock(), types.EventTypeConvertVestingAccount))
+	suite.getVestingAccount(addr)
+	suite.Require().Empty(suite.endTimeIndexEntries(stale.EndTime))
+
+	// the entry of the actual end time converts the account
+	suite.advanceTime(50 * time.Second)
+	suite.Require().Len(eventsOfType(suite.endBlock(), types.EventTypeConvertVestingAccount), 1)
+	_, err := suite.keeper.GetClawbackVestingAccount(suite.ctx, addr)
+	suite.Require().ErrorIs(err, types.ErrNotSubjectToClawback)
+}
+
+// scheduleEvent is the account, period index and amount of an unlocked or
+// vested event.
+type scheduleEvent struct {
+	eventType   string
+	periodIndex string
+	amount      string
+}
+
+// scheduleEvents returns the unlocked and vested events of the given account.
+func scheduleEvents(events sdk.Events, addr sdk.AccAddress) []scheduleEvent {
+	var res []scheduleEvent
+	for _, event := range events {
+		if event.Type != types.EventTypeVested && event.Type != types.EventTypeUnlocked {
+			continue
+		}
+
+		attrs := make(map[string]string)
+		for _, attr := range event.Attributes {
+			attrs[attr.Key] = attr.Value
+		}
+		if attrs[types.AttributeKeyAccount] != addr.String() {
+			continue
+		}
+		res = append(res, scheduleEvent{
+			eventType:   event.Type,
+			periodIndex: attrs[types.AttributeKeyPeriodIndex],
+			amount:      attrs[types.AttributeKeyAmount],
+		})
+	}
+	return res
+}
+
+func (suite *KeeperTestSuite) TestEmitScheduleEvents() {
+	funder := sdk.AccAddress("funder______________")
+	addr := sdk.AccAddress("vesting_account_____")
+	suite.createVestingAccount(funder, addr, testLockupPeriods, testVestingPeriods, true)
+
+	suite.Require().Empty(scheduleEvents(suite.endBlock(), addr))
+
+	suite.advanceTime(49 * time.Second)
+	suite.Require().Empty(scheduleEvents(suite.endBlock(), addr))
+
+	suite.advanceTime(time.Second)
+	suite.Require().Equal(
+		[]scheduleEvent{{types.EventTypeVested, "0", "500stake"}},
+		scheduleEvents(suite.endBlock(), addr),
+	)
+	suite.Require().Empty(scheduleEvents(suite.endBlock(), addr))
+
+	suite.advanceTime(50 * time.Second)
+	suite.Require().ElementsMatch(
+		[]scheduleEvent{{types.EventTypeUnlocked, "0", "1000stake"}, {types.EventTypeVested, "1", "500stake"}},
+		scheduleEvents(suite.endBlock(), addr),
+	)
+
+	suite.advanceTime(time.Hour)
+	suite.Require().Empty(scheduleEvents(suite.endBlock(), addr))
+}
+
+func (suite *KeeperTestSuite) TestEmitScheduleEventsPerBlockCap() {
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, types.NewParams(false, 10, 1, 10)))
+
+	funder := sdk.AccAddress("funder______________")
+	addr := sdk.AccAddress("vesting_account_____")
+	suite.createVestingAccount(funder, addr, testLockupPeriods, testVestingPeriods, true)
+
+	// the events of a halted chain are emitted over the following blocks,
+	// oldest first
+	suite.advanceTime(time.Hour)
+	suite.Require().Equal([]scheduleEvent{{types.EventTypeVested, "0", "500stake"}}, scheduleEvents(suite.endBlock(), addr))
+	suite.Require().Len(scheduleEvents(suite.endBlock(), addr), 1)
+	suite.Require().Len(scheduleEvents(suite.endBlock(), addr), 1)
+	suite.Require().Empty(scheduleEvents(suite.endBlock(), addr))
+}
+
+func (suite *KeeperTestSuite) TestEmitScheduleEventsSkipsStaleEntries() {
+	funder := sdk.AccAddress("funder______________")
+	addr := sdk.AccAddress("vesting_account_____")
+	va := suite.createVestingAccount(funder, addr, testLockupPeriods, testVestingPeriods, true)
+
+	// index the periods at an earlier start time, as if the schedule had been
+	// shifted without updating the index
+	stale := *va
+	stale.StartTime = va.StartTime.Add(-10 * time.Second)
+	suite.keeper.SetScheduleEventIndex(suite.ctx, &stale)
+
+	suite.advanceTime(40 * time.Second)
+	suite.Require().Empty(scheduleEvents(suite.endBlock(), addr))
+
+	suite.advanceTime(10 * time.Second)
+	suite.Require().Equal([]scheduleEvent{{types.EventTypeVested, "0", "500stake"}}, scheduleEvents(suite.endBlock(), addr))
+
+	// the events of a converted account are dropped
+	suite.advanceTime(10 * time.Second)
+	_, err := suite.keeper.Clawback(suite.ctx, types.NewMsgClawback(funder, addr, nil))
+	suite.Require().NoError(err)
+	suite.advanceTime(time.Hour)
+	suite.Require().Empty(scheduleEvents(suite.endBlock(), addr))
+}
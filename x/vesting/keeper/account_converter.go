@@ -0,0 +1,90 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/evmos/vesting/x/vesting/types"
+)
+
+// GetWrappedAccount returns the account of the chain's default type that was
+// unwrapped into the clawback vesting account at the given address, if any.
+func (k Keeper) GetWrappedAccount(ctx sdk.Context, addr sdk.AccAddress) (authtypes.AccountI, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixWrappedAccount)
+	bz := store.Get(addr.Bytes())
+	if bz == nil {
+		return nil, false
+	}
+
+	var acc authtypes.AccountI
+	if err := k.cdc.UnmarshalInterface(bz, &acc); err != nil {
+		panic(err)
+	}
+	return acc, true
+}
+
+// SetWrappedAccount stores the given account of the chain's default type, so
+// that it can be restored when the clawback vesting account at its address is
+// converted back.
+func (k Keeper) SetWrappedAccount(ctx sdk.Context, acc authtypes.AccountI) {
+	bz, err := k.cdc.MarshalInterface(acc)
+	if err != nil {
+		panic(err)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixWrappedAccount)
+	store.Set(acc.GetAddress().Bytes(), bz)
+}
+
+// DeleteWrappedAccount removes the stored account of the chain's default type
+// at the given address.
+func (k Keeper) DeleteWrappedAccount(ctx sdk.Context, addr sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixWrappedAccount)
+	store.Delete(addr.Bytes())
+}
+
+// IterateWrappedAccounts iterates over the stored accounts of the chain's
+// default type and performs a callback function.
+func (k Keeper) IterateWrappedAccounts(ctx sdk.Context, cb func(acc authtypes.AccountI) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixWrappedAccount)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var acc authtypes.AccountI
+		if err := k.cdc.UnmarshalInterface(iterator.Value(), &acc); err != nil {
+			panic(err)
+		}
+
+		if cb(acc) {
+			break
+		}
+	}
+}
+
+// unwrapAccount returns the BaseAccount wrapped by the given account of the
+// chain's default type. Accounts other than BaseAccounts are stored, so that
+// their additional fields are restored by wrapAccount.
+func (k Keeper) unwrapAccount(ctx sdk.Context, acc authtypes.AccountI) (*authtypes.BaseAccount, error) {
+	baseAcc, err := k.accountConverter.ToBaseAccount(acc)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, isBaseAccount := acc.(*authtypes.BaseAccount); !isBaseAccount {
+		k.SetWrappedAccount(ctx, acc)
+	}
+	return baseAcc, nil
+}
+
+// wrapAccount wraps the given BaseAccount of a clawback vesting account into
+// the chain's default account type, restoring the fields of the account that
+// was unwrapped when the vesting account was created.
+func (k Keeper) wrapAccount(ctx sdk.Context, baseAcc *authtypes.BaseAccount) authtypes.AccountI {
+	original, _ := k.GetWrappedAccount(ctx, baseAcc.GetAddress())
+	k.DeleteWrappedAccount(ctx, baseAcc.GetAddress())
+	return k.accountConverter.FromBaseAccount(baseAcc, original)
+}
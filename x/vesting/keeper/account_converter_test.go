@@ -0,0 +1,141 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+
+	"github.com/evmos/vesting/x/vesting/types"
+)
+
+var _ types.AccountConverter = moduleAccountConverter{}
+
+// moduleAccountConverter is an AccountConverter for a chain whose default
+// account type is the ModuleAccount, used to test the restoration of the fields
+// that are not part of the BaseAccount.
+type moduleAccountConverter struct{}
+
+func (moduleAccountConverter) ToBaseAccount(acc authtypes.AccountI) (*authtypes.BaseAccount, error) {
+	macc, ok := acc.(*authtypes.ModuleAccount)
+	if !ok {
+		return nil, errortypes.ErrInvalidType
+	}
+	return macc.BaseAccount, nil
+}
+
+func (moduleAccountConverter) FromBaseAccount(baseAcc *authtypes.BaseAccount, original authtypes.AccountI) authtypes.AccountI {
+	macc := &authtypes.ModuleAccount{BaseAccount: baseAcc}
+	if original, ok := original.(*authtypes.ModuleAccount); ok {
+		macc.Name = original.Name
+		macc.Permissions = original.Permissions
+	}
+	return macc
+}
+
+var (
+	testLockupPeriods  = sdkvesting.Periods{{Length: 100, Amount: stakeCoins(1000)}}
+	testVestingPeriods = sdkvesting.Periods{{Length: 50, Amount: stakeCoins(500)}, {Length: 50, Amount: stakeCoins(500)}}
+)
+
+func (suite *KeeperTestSuite) TestConvertRestoresWrappedAccount() {
+	suite.setupKeepers(moduleAccountConverter{})
+
+	funder := sdk.AccAddress("funder______________")
+	addr := sdk.AccAddress("vesting_account_____")
+	macc := authtypes.NewModuleAccount(authtypes.NewBaseAccountWithAddress(addr), "custom", authtypes.Burner)
+	suite.accountKeeper.SetAccount(suite.ctx, suite.accountKeeper.NewAccount(suite.ctx, macc))
+
+	va := suite.createVestingAccount(funder, addr, testLockupPeriods, testVestingPeriods, false)
+	suite.Require().Equal(stakeCoins(1000), va.OriginalVesting)
+
+	wrapped, found := suite.keeper.GetWrappedAccount(suite.ctx, addr)
+	suite.Require().True(found)
+	suite.Require().Equal("custom", wrapped.(*authtypes.ModuleAccount).Name)
+
+	suite.advanceTime(100 * time.Second)
+	_, err := suite.keeper.ConvertVestingAccount(suite.ctx, types.NewMsgConvertVestingAccount(addr))
+	suite.Require().NoError(err)
+
+	acc := suite.accountKeeper.GetAccount(suite.ctx, addr)
+	converted, ok := acc.(*authtypes.ModuleAccount)
+	suite.Require().True(ok, "expected module account, got %T", acc)
+	suite.Require().Equal("custom", converted.Name)
+	suite.Require().Equal([]string{authtypes.Burner}, converted.Permissions)
+	suite.Require().Equal(va.GetAccountNumber(), converted.GetAccountNumber())
+
+	_, found = suite.keeper.GetWrappedAccount(suite.ctx, addr)
+	suite.Require().False(found)
+	suite.Require().False(suite.keeper.HasGovClawbackDisabled(suite.ctx, addr))
+}
+
+func (suite *KeeperTestSuite) TestClawbackConvertsAccount() {
+	suite.setupKeepers(moduleAccountConverter{})
+
+	funder := sdk.AccAddress("funder______________")
+	addr := sdk.AccAddress("vesting_account_____")
+	macc := authtypes.NewModuleAccount(authtypes.NewBaseAccountWithAddress(addr), "custom")
+	suite.accountKeeper.SetAccount(suite.ctx, suite.accountKeeper.NewAccount(suite.ctx, macc))
+
+	suite.createVestingAccount(funder, addr, testLockupPeriods, testVestingPeriods, false)
+	suite.advanceTime(50 * time.Second)
+
+	_, err := suite.keeper.Clawback(suite.ctx, types.NewMsgClawback(funder, addr, nil))
+	suite.Require().NoError(err)
+
+	// the account is converted back to the chain's default account type, so
+	// that the vested coins are no longer locked up
+	acc := suite.accountKeeper.GetAccount(suite.ctx, addr)
+	converted, ok := acc.(*authtypes.ModuleAccount)
+	suite.Require().True(ok, "expected module account, got %T", acc)
+	suite.Require().Equal("custom", converted.Name)
+	suite.Require().Equal(stakeCoins(500), suite.bankKeeper.SpendableCoins(suite.ctx, addr))
+	suite.Require().Equal(stakeCoins(500), suite.bankKeeper.GetAllBalances(suite.ctx, funder))
+
+	suite.Require().False(suite.keeper.HasGovClawbackDisabled(suite.ctx, addr))
+	_, found := suite.keeper.GetWrappedAccount(suite.ctx, addr)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestCreateWithBaseAccountConverter() {
+	addr := sdk.AccAddress("vesting_account_____")
+	macc := authtypes.NewModuleAccount(authtypes.NewBaseAccountWithAddress(addr), "custom")
+	suite.accountKeeper.SetAccount(suite.ctx, suite.accountKeeper.NewAccount(suite.ctx, macc))
+
+	_, err := suite.keeper.CreateClawbackVestingAccount(
+		suite.ctx, types.NewMsgCreateClawbackVestingAccount(sdk.AccAddress("funder______________"), addr, false),
+	)
+	suite.Require().ErrorIs(err, errortypes.ErrInvalidType)
+
+	// base accounts are not stored, since there are no fields to restore
+	other := sdk.AccAddress("other_account_______")
+	suite.createVestingAccount(sdk.AccAddress("funder______________"), other, testLockupPeriods, testVestingPeriods, true)
+	_, found := suite.keeper.GetWrappedAccount(suite.ctx, other)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestWrappedAccountsGenesis() {
+	suite.setupKeepers(moduleAccountConverter{})
+
+	addr := sdk.AccAddress("vesting_account_____")
+	macc := authtypes.NewModuleAccount(authtypes.NewBaseAccountWithAddress(addr), "custom", authtypes.Minter)
+	suite.keeper.SetWrappedAccount(suite.ctx, macc)
+
+	genesis := suite.keeper.ExportGenesis(suite.ctx)
+	suite.Require().Len(genesis.WrappedAccounts, 1)
+	suite.Require().NoError(genesis.Validate())
+
+	bz := suite.cdc.MustMarshalJSON(genesis)
+	var imported types.GenesisState
+	suite.cdc.MustUnmarshalJSON(bz, &imported)
+	suite.Require().NoError(imported.Validate())
+
+	suite.setupKeepers(moduleAccountConverter{})
+	suite.keeper.InitGenesis(suite.ctx, imported)
+
+	wrapped, found := suite.keeper.GetWrappedAccount(suite.ctx, addr)
+	suite.Require().True(found)
+	suite.Require().Equal(macc, wrapped)
+}
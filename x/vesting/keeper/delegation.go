@@ -0,0 +1,315 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/evmos/vesting/x/vesting/types"
+)
+
+// sendClawback sends the clawed back coins from the account to the destination
+// address. If the liquid balance of the bond denom is insufficient, the missing
+// amount is taken from the delegations and then from the unbonding delegations
+// of the account, in the order returned by the staking keeper (i.e. by
+// validator address):
+//   - for the community pool (e.g. governance clawbacks) and the vesting module
+//     account (burn), the missing tokens are undelegated into the balance of the
+//     account, without waiting for the unbonding period, before the coins are sent
+//   - for other destinations, the delegations and unbonding delegations are
+//     transferred to the destination address, keeping the same validators
+func (k Keeper) sendClawback(
+	ctx sdk.Context,
+	address, destinationAddr sdk.AccAddress,
+	amount sdk.Coins,
+) error {
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+
+	liquid := amount
+	shortfall := sdkmath.ZeroInt()
+	if bondAmt := amount.AmountOf(bondDenom); bondAmt.IsPositive() {
+		balance := k.bankKeeper.GetBalance(ctx, address, bondDenom).Amount
+		if balance.LT(bondAmt) {
+			shortfall = bondAmt.Sub(balance)
+			liquid = amount.Sub(sdk.NewCoin(bondDenom, shortfall))
+		}
+	}
+
+	var (
+		remaining = shortfall
+		err       error
+	)
+	switch destinationAddr.String() {
+	case authtypes.NewModuleAddress(distributiontypes.ModuleName).String():
+		if remaining, err = k.reclaimDelegatedTokens(ctx, address, shortfall); err == nil && !remaining.IsPositive() {
+			// In case destination is community pool (e.g. Gov Clawback)
+			// call the corresponding function
+			err = k.distributionKeeper.FundCommunityPool(ctx, amount, address)
+		}
+	case authtypes.NewModuleAddress(types.ModuleName).String():
+		if remaining, err = k.reclaimDelegatedTokens(ctx, address, shortfall); err == nil && !remaining.IsPositive() {
+			// In case destination is the vesting module, burn the clawed back coins
+			err = k.burnClawback(ctx, address, amount)
+		}
+	default:
+		if !liquid.IsZero() {
+			if err = k.bankKeeper.SendCoins(ctx, address, destinationAddr, liquid); err != nil {
+				return err
+			}
+		}
+
+		if remaining, err = k.transferDelegations(ctx, address, destinationAddr, shortfall); err == nil {
+			remaining, err = k.transferUnbondingDelegations(ctx, address, destinationAddr, remaining)
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	if remaining.IsPositive() {
+		return errorsmod.Wrapf(errortypes.ErrInsufficientFunds,
+			"balance, delegations and unbonding delegations of %s are insufficient to clawback %s%s",
+			address, shortfall, bondDenom,
+		)
+	}
+
+	return nil
+}
+
+// reclaimDelegatedTokens undelegates up to the given amount of tokens from the
+// delegations and then from the unbonding delegations of the delegator into its
+// balance, without waiting for the unbonding period. It returns the amount that
+// could not be undelegated.
+func (k Keeper) reclaimDelegatedTokens(
+	ctx sdk.Context,
+	delegatorAddr sdk.AccAddress,
+	amount sdkmath.Int,
+) (sdkmath.Int, error) {
+	if !amount.IsPositive() {
+		return amount, nil
+	}
+
+	remaining, err := k.unbondDelegations(ctx, delegatorAddr, amount,
+		func(validator stakingtypes.Validator, _ bool, tokens sdkmath.Int) error {
+			return k.undelegateFromPool(ctx, validator, delegatorAddr, tokens)
+		},
+	)
+	if err != nil {
+		return remaining, err
+	}
+
+	return k.removeUnbondingEntries(ctx, delegatorAddr, remaining,
+		func(_ sdk.ValAddress, _ stakingtypes.UnbondingDelegationEntry, tokens sdkmath.Int) error {
+			// the unbonding tokens are held by the not bonded pool
+			coins := sdk.NewCoins(sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), tokens))
+			return k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, stakingtypes.NotBondedPoolName, delegatorAddr, coins)
+		},
+	)
+}
+
+// transferDelegations transfers up to the given amount of delegated tokens from
+// the delegator to the destination address, keeping the same validators. The
+// tokens unbonded from a validator that is removed by the unbonding (i.e. an
+// unbonded validator without shares left) are sent to the destination address
+// instead. It returns the amount that could not be transferred.
+func (k Keeper) transferDelegations(
+	ctx sdk.Context,
+	delegatorAddr, destinationAddr sdk.AccAddress,
+	amount sdkmath.Int,
+) (sdkmath.Int, error) {
+	return k.unbondDelegations(ctx, delegatorAddr, amount,
+		func(validator stakingtypes.Validator, removed bool, tokens sdkmath.Int) error {
+			if removed {
+				if err := k.undelegateFromPool(ctx, validator, delegatorAddr, tokens); err != nil {
+					return err
+				}
+				coins := sdk.NewCoins(sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), tokens))
+				return k.bankKeeper.SendCoins(ctx, delegatorAddr, destinationAddr, coins)
+			}
+
+			// the unbonded tokens are still held by the pool of the validator's status,
+			// so they are delegated again without moving them between pools
+			_, err := k.stakingKeeper.Delegate(ctx, destinationAddr, tokens, validator.GetStatus(), validator, false)
+			return err
+		},
+	)
+}
+
+// transferUnbondingDelegations transfers up to the given amount of unbonding
+// tokens from the delegator to the destination address. The transferred tokens
+// keep the creation height and completion time of the original entries. It
+// returns the amount that could not be transferred.
+func (k Keeper) transferUnbondingDelegations(
+	ctx sdk.Context,
+	delegatorAddr, destinationAddr sdk.AccAddress,
+	amount sdkmath.Int,
+) (sdkmath.Int, error) {
+	return k.removeUnbondingEntries(ctx, delegatorAddr, amount,
+		func(valAddr sdk.ValAddress, entry stakingtypes.UnbondingDelegationEntry, tokens sdkmath.Int) error {
+			destUbd := k.stakingKeeper.SetUnbondingDelegationEntry(
+				ctx, destinationAddr, valAddr, entry.CreationHeight, entry.CompletionTime, tokens,
+			)
+			k.stakingKeeper.InsertUBDQueue(ctx, destUbd, entry.CompletionTime)
+			return nil
+		},
+	)
+}
+
+// transferAllDelegations transfers all the delegated and unbonding tokens of
+// the delegator to the destination address. It returns the transferred amount
+// in the bond denom.
+func (k Keeper) transferAllDelegations(
+	ctx sdk.Context,
+	delegatorAddr, destinationAddr sdk.AccAddress,
+) (sdk.Coin, error) {
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+
+	bonded := k.stakingKeeper.GetDelegatorBonded(ctx, delegatorAddr)
+	remainingBonded, err := k.transferDelegations(ctx, delegatorAddr, destinationAddr, bonded)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	unbonding := k.stakingKeeper.GetDelegatorUnbonding(ctx, delegatorAddr)
+	remainingUnbonding, err := k.transferUnbondingDelegations(ctx, delegatorAddr, destinationAddr, unbonding)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	transferred := bonded.Sub(remainingBonded).Add(unbonding.Sub(remainingUnbonding))
+	return sdk.NewCoin(bondDenom, transferred), nil
+}
+
+// unbondDelegations unbonds up to the given amount of tokens from all the
+// delegations of the delegator, in the order returned by the staking keeper.
+// The unbonded tokens remain in the pool of the validator's status and are
+// passed to the given function with the updated validator, or with the
+// validator before the unbonding if the unbonding removed it. It returns the
+// amount that could not be unbonded.
+func (k Keeper) unbondDelegations(
+	ctx sdk.Context,
+	delegatorAddr sdk.AccAddress,
+	amount sdkmath.Int,
+	cb func(validator stakingtypes.Validator, removed bool, tokens sdkmath.Int) error,
+) (sdkmath.Int, error) {
+	remaining := amount
+
+	for _, delegation := range k.stakingKeeper.GetAllDelegatorDelegations(ctx, delegatorAddr) {
+		if !remaining.IsPositive() {
+			break
+		}
+
+		valAddr := delegation.GetValidatorAddr()
+		validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+		if !found {
+			return remaining, errorsmod.Wrap(stakingtypes.ErrNoValidatorFound, valAddr.String())
+		}
+
+		// unbond the whole delegation if it is not larger than the remaining amount
+		shares := delegation.Shares
+		if tokens := validator.TokensFromShares(shares).TruncateInt(); tokens.GT(remaining) {
+			var err error
+			if shares, err = k.stakingKeeper.ValidateUnbondAmount(ctx, delegatorAddr, valAddr, remaining); err != nil {
+				return remaining, err
+			}
+		}
+
+		unbonded, err := k.stakingKeeper.Unbond(ctx, delegatorAddr, valAddr, shares)
+		if err != nil {
+			return remaining, err
+		}
+
+		if !unbonded.IsPositive() {
+			continue
+		}
+
+		// NOTE: refresh the validator since its tokens and shares have been updated.
+		// Unbond removes an unbonded validator whose last shares are unbonded.
+		updated, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+		if found {
+			validator = updated
+		}
+
+		if err := cb(validator, !found, unbonded); err != nil {
+			return remaining, err
+		}
+
+		remaining = remaining.Sub(sdkmath.MinInt(unbonded, remaining))
+	}
+
+	return remaining, nil
+}
+
+// removeUnbondingEntries removes up to the given amount of tokens from all the
+// unbonding delegation entries of the delegator, in the order returned by the
+// staking keeper. The removed tokens remain in the not bonded pool and are
+// passed to the given function with the validator address and the original
+// entry. It returns the amount that could not be removed.
+func (k Keeper) removeUnbondingEntries(
+	ctx sdk.Context,
+	delegatorAddr sdk.AccAddress,
+	amount sdkmath.Int,
+	cb func(valAddr sdk.ValAddress, entry stakingtypes.UnbondingDelegationEntry, tokens sdkmath.Int) error,
+) (sdkmath.Int, error) {
+	remaining := amount
+
+	for _, ubd := range k.stakingKeeper.GetAllUnbondingDelegations(ctx, delegatorAddr) {
+		if !remaining.IsPositive() {
+			break
+		}
+
+		valAddr, err := sdk.ValAddressFromBech32(ubd.ValidatorAddress)
+		if err != nil {
+			return remaining, err
+		}
+
+		for i, entry := range ubd.Entries {
+			if !remaining.IsPositive() {
+				break
+			}
+
+			removed := sdkmath.MinInt(entry.Balance, remaining)
+			if !removed.IsPositive() {
+				continue
+			}
+
+			if err := cb(valAddr, entry, removed); err != nil {
+				return remaining, err
+			}
+
+			entry.Balance = entry.Balance.Sub(removed)
+			entry.InitialBalance = entry.InitialBalance.Sub(sdkmath.MinInt(entry.InitialBalance, removed))
+			ubd.Entries[i] = entry
+
+			remaining = remaining.Sub(removed)
+		}
+
+		k.stakingKeeper.SetUnbondingDelegation(ctx, ubd)
+	}
+
+	return remaining, nil
+}
+
+// undelegateFromPool sends the given amount of tokens unbonded from the
+// validator from the staking pool of the validator's status to the delegator.
+func (k Keeper) undelegateFromPool(
+	ctx sdk.Context,
+	validator stakingtypes.Validator,
+	delegatorAddr sdk.AccAddress,
+	tokens sdkmath.Int,
+) error {
+	pool := stakingtypes.NotBondedPoolName
+	if validator.IsBonded() {
+		pool = stakingtypes.BondedPoolName
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), tokens))
+	return k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, pool, delegatorAddr, coins)
+}
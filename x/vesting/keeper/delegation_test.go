@@ -0,0 +1,168 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/evmos/vesting/testutil"
+	vestingtypes "github.com/evmos/vesting/x/vesting/types"
+)
+
+// delegationTokens returns the tokens delegated by the delegator to the test
+// validator, or zero if there is no delegation.
+func (suite *KeeperTestSuite) delegationTokens(delegator sdk.AccAddress) sdk.Int {
+	delegation, found := suite.stakingKeeper.GetDelegation(suite.ctx, delegator, suite.validator.GetOperator())
+	if !found {
+		return sdk.ZeroInt()
+	}
+	validator, found := suite.stakingKeeper.GetValidator(suite.ctx, suite.validator.GetOperator())
+	suite.Require().True(found)
+	return validator.TokensFromShares(delegation.Shares).TruncateInt()
+}
+
+// unbondingTokens returns the tokens of the unbonding delegation entries of the
+// delegator from the test validator.
+func (suite *KeeperTestSuite) unbondingTokens(delegator sdk.AccAddress) sdk.Int {
+	total := sdk.ZeroInt()
+	ubd, found := suite.stakingKeeper.GetUnbondingDelegation(suite.ctx, delegator, suite.validator.GetOperator())
+	if !found {
+		return total
+	}
+	for _, entry := range ubd.Entries {
+		total = total.Add(entry.Balance)
+	}
+	return total
+}
+
+// undelegate starts the unbonding of the given amount of tokens delegated by
+// the delegator to the test validator.
+func (suite *KeeperTestSuite) undelegate(delegator sdk.AccAddress, amount int64) time.Time {
+	completionTime, err := suite.stakingKeeper.Undelegate(suite.ctx, delegator, suite.validator.GetOperator(), sdk.NewDec(amount))
+	suite.Require().NoError(err)
+	return completionTime
+}
+
+func (suite *KeeperTestSuite) TestClawbackTransfersDelegations() {
+	funder := sdk.AccAddress("funder______________")
+	addr := sdk.AccAddress("vesting_account_____")
+	staker := sdk.AccAddress("staker______________")
+
+	suite.fundAccount(staker, stakeCoins(100))
+	suite.delegate(staker, 100)
+
+	suite.createVestingAccount(funder, addr, testLockupPeriods, testVestingPeriods, false)
+	suite.delegate(addr, 600)
+	suite.advanceTime(50 * time.Second)
+
+	_, err := suite.keeper.Clawback(suite.ctx, vestingtypes.NewMsgClawback(funder, addr, nil))
+	suite.Require().NoError(err)
+
+	// the unvested 500 tokens are the liquid 400 tokens and 100 delegated tokens
+	suite.Require().Equal(stakeCoins(400), suite.bankKeeper.GetAllBalances(suite.ctx, funder))
+	suite.Require().Equal(sdk.NewInt(100), suite.delegationTokens(funder))
+	suite.Require().True(suite.bankKeeper.GetAllBalances(suite.ctx, addr).IsZero())
+	suite.Require().Equal(sdk.NewInt(500), suite.delegationTokens(addr))
+	suite.Require().Equal(sdk.NewInt(100), suite.delegationTokens(staker))
+}
+
+func (suite *KeeperTestSuite) TestClawbackTransfersUnbondingDelegations() {
+	funder := sdk.AccAddress("funder______________")
+	addr := sdk.AccAddress("vesting_account_____")
+	staker := sdk.AccAddress("staker______________")
+
+	suite.fundAccount(staker, stakeCoins(100))
+	suite.delegate(staker, 100)
+
+	suite.createVestingAccount(funder, addr, testLockupPeriods, testVestingPeriods, false)
+	suite.delegate(addr, 1000)
+	completionTime := suite.undelegate(addr, 300)
+
+	_, err := suite.keeper.Clawback(suite.ctx, vestingtypes.NewMsgClawback(funder, addr, nil))
+	suite.Require().NoError(err)
+
+	suite.Require().True(suite.bankKeeper.GetAllBalances(suite.ctx, funder).IsZero())
+	suite.Require().Equal(sdk.NewInt(700), suite.delegationTokens(funder))
+	suite.Require().Equal(sdk.NewInt(300), suite.unbondingTokens(funder))
+	suite.Require().True(suite.delegationTokens(addr).IsZero())
+	suite.Require().True(suite.unbondingTokens(addr).IsZero())
+
+	// the transferred entry keeps the completion time of the original entry
+	ubd, found := suite.stakingKeeper.GetUnbondingDelegation(suite.ctx, funder, suite.validator.GetOperator())
+	suite.Require().True(found)
+	suite.Require().Len(ubd.Entries, 1)
+	suite.Require().Equal(completionTime, ubd.Entries[0].CompletionTime)
+	suite.Require().Contains(
+		suite.stakingKeeper.GetUBDQueueTimeSlice(suite.ctx, completionTime),
+		stakingtypes.DVPair{DelegatorAddress: funder.String(), ValidatorAddress: suite.validator.GetOperator().String()},
+	)
+}
+
+func (suite *KeeperTestSuite) TestClawbackUnbondsLastShares() {
+	funder := sdk.AccAddress("funder______________")
+	addr := sdk.AccAddress("vesting_account_____")
+
+	suite.createVestingAccount(funder, addr, testLockupPeriods, testVestingPeriods, false)
+	suite.delegate(addr, 600)
+
+	_, err := suite.keeper.Clawback(suite.ctx, vestingtypes.NewMsgClawback(funder, addr, nil))
+	suite.Require().NoError(err)
+
+	// unbonding the last shares removes the unbonded validator, so the tokens
+	// are sent to the funder instead of being delegated again
+	_, found := suite.stakingKeeper.GetValidator(suite.ctx, suite.validator.GetOperator())
+	suite.Require().False(found)
+	suite.Require().Equal(stakeCoins(1000), suite.bankKeeper.GetAllBalances(suite.ctx, funder))
+	suite.Require().True(suite.bankKeeper.GetAllBalances(suite.ctx, addr).IsZero())
+	suite.Require().True(
+		suite.bankKeeper.GetAllBalances(suite.ctx, suite.accountKeeper.GetModuleAddress(stakingtypes.NotBondedPoolName)).IsZero(),
+	)
+}
+
+func (suite *KeeperTestSuite) TestGovClawbackReclaimsDelegations() {
+	funder := sdk.AccAddress("funder______________")
+	addr := sdk.AccAddress("vesting_account_____")
+	staker := sdk.AccAddress("staker______________")
+
+	suite.fundAccount(staker, stakeCoins(100))
+	suite.delegate(staker, 100)
+
+	suite.createVestingAccount(funder, addr, testLockupPeriods, testVestingPeriods, true)
+	suite.delegate(addr, 600)
+	suite.undelegate(addr, 200)
+
+	_, err := suite.keeper.Clawback(suite.ctx, vestingtypes.NewMsgClawback(suite.authority, addr, nil))
+	suite.Require().NoError(err)
+
+	// the delegated and unbonding tokens are undelegated into the balance of
+	// the account before funding the community pool
+	suite.Require().Equal(
+		sdk.NewDecCoinsFromCoins(stakeCoins(1000)...),
+		suite.distrKeeper.GetFeePoolCommunityCoins(suite.ctx),
+	)
+	suite.Require().True(suite.bankKeeper.GetAllBalances(suite.ctx, addr).IsZero())
+	suite.Require().True(suite.delegationTokens(addr).IsZero())
+	suite.Require().True(suite.unbondingTokens(addr).IsZero())
+	suite.Require().Equal(
+		stakeCoins(100),
+		suite.bankKeeper.GetAllBalances(suite.ctx, suite.accountKeeper.GetModuleAddress(stakingtypes.NotBondedPoolName)),
+	)
+}
+
+func (suite *KeeperTestSuite) TestBurnClawbackReclaimsDelegations() {
+	funder := sdk.AccAddress("funder______________")
+	addr := sdk.AccAddress("vesting_account_____")
+
+	suite.createVestingAccount(funder, addr, testLockupPeriods, testVestingPeriods, false)
+	suite.delegate(addr, 1000)
+
+	msg := vestingtypes.NewMsgClawback(funder, addr, nil)
+	msg.Burn = true
+	_, err := suite.keeper.Clawback(suite.ctx, msg)
+	suite.Require().NoError(err)
+
+	_, found := suite.stakingKeeper.GetValidator(suite.ctx, suite.validator.GetOperator())
+	suite.Require().False(found)
+	suite.Require().True(suite.bankKeeper.GetSupply(suite.ctx, testutil.StakeDenom).IsZero())
+}
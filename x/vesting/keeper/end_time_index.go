@@ -0,0 +1,51 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/vesting/x/vesting/types"
+)
+
+// SetEndTimeIndex adds the given clawback vesting account to the end time
+// index. Accounts without vesting or lockup periods are not indexed, since they
+// have not been funded yet.
+func (k Keeper) SetEndTimeIndex(ctx sdk.Context, va *types.ClawbackVestingAccount) {
+	if len(va.VestingPeriods) == 0 && len(va.LockupPeriods) == 0 {
+		return
+	}
+	key := types.EndTimeIndexKey(va.EndTime, va.GetAddress())
+	ctx.KVStore(k.storeKey).Set(key, []byte{0x01})
+}
+
+// DeleteEndTimeIndex removes the entry of the given clawback vesting account
+// from the end time index. If no entry is found, this will no-op.
+func (k Keeper) DeleteEndTimeIndex(ctx sdk.Context, va *types.ClawbackVestingAccount) {
+	key := types.EndTimeIndexKey(va.EndTime, va.GetAddress())
+	ctx.KVStore(k.storeKey).Delete(key)
+}
+
+// IterateEndTimeIndex iterates over the end time index entries with an end time
+// lower than or equal to the given one, in ascending order of end time.
+// The iteration stops when the callback returns true.
+func (k Keeper) IterateEndTimeIndex(
+	ctx sdk.Context,
+	endTime int64,
+	cb func(endTime int64, addr sdk.AccAddress) (stop bool),
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEndTimeIndex)
+	// NOTE: the end of the range is exclusive, so use the prefix of the next second
+	end := types.EndTimeIndexPrefix(endTime + 1)[len(types.KeyPrefixEndTimeIndex):]
+
+	iterator := store.Iterator(nil, end)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		t, addr := types.SplitEndTimeIndexKey(iterator.Key())
+		if cb(t, addr) {
+			break
+		}
+	}
+}
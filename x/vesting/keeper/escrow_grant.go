@@ -0,0 +1,100 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/vesting/x/vesting/types"
+)
+
+// GetEscrowGrant returns the escrow grant with the given id, if any.
+func (k Keeper) GetEscrowGrant(ctx sdk.Context, id uint64) (grant types.EscrowGrant, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.EscrowGrantKey(id))
+	if bz == nil {
+		return grant, false
+	}
+	k.cdc.MustUnmarshal(bz, &grant)
+	return grant, true
+}
+
+// SetEscrowGrant stores the given escrow grant and indexes it by recipient.
+func (k Keeper) SetEscrowGrant(ctx sdk.Context, grant types.EscrowGrant) {
+	store := ctx.KVStore(k.storeKey)
+	recipient := sdk.MustAccAddressFromBech32(grant.RecipientAddress)
+	store.Set(types.EscrowGrantKey(grant.Id), k.cdc.MustMarshal(&grant))
+	store.Set(types.EscrowGrantByRecipientKey(recipient, grant.Id), []byte{0x01})
+}
+
+// DeleteEscrowGrant removes the given escrow grant and its recipient index
+// entry.
+func (k Keeper) DeleteEscrowGrant(ctx sdk.Context, grant types.EscrowGrant) {
+	store := ctx.KVStore(k.storeKey)
+	recipient := sdk.MustAccAddressFromBech32(grant.RecipientAddress)
+	store.Delete(types.EscrowGrantKey(grant.Id))
+	store.Delete(types.EscrowGrantByRecipientKey(recipient, grant.Id))
+}
+
+// GetNextEscrowGrantID returns the id of the next escrow grant and increments
+// it.
+func (k Keeper) GetNextEscrowGrantID(ctx sdk.Context) uint64 {
+	id := k.PeekNextEscrowGrantID(ctx)
+	k.SetNextEscrowGrantID(ctx, id+1)
+	return id
+}
+
+// SetNextEscrowGrantID sets the id of the next escrow grant.
+func (k Keeper) SetNextEscrowGrantID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.KeyNextEscrowGrantID, sdk.Uint64ToBigEndian(id))
+}
+
+// PeekNextEscrowGrantID returns the id of the next escrow grant without
+// incrementing it.
+func (k Keeper) PeekNextEscrowGrantID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyNextEscrowGrantID)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// IterateEscrowGrants iterates over all the escrow grants, ordered by id, and
+// performs a callback function.
+func (k Keeper) IterateEscrowGrants(ctx sdk.Context, cb func(grant types.EscrowGrant) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEscrowGrant)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var grant types.EscrowGrant
+		k.cdc.MustUnmarshal(iterator.Value(), &grant)
+		if cb(grant) {
+			break
+		}
+	}
+}
+
+// retargetEscrowGrants sets the given new recipient as the recipient of all
+// the escrow grants of the given recipient.
+func (k Keeper) retargetEscrowGrants(ctx sdk.Context, recipient, newRecipient sdk.AccAddress) {
+	// NOTE: collect the ids first to avoid writing to the store while iterating
+	// over it
+	var ids []uint64
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.EscrowGrantByRecipientPrefix(recipient))
+	iterator := store.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		ids = append(ids, sdk.BigEndianToUint64(iterator.Key()))
+	}
+	iterator.Close()
+
+	for _, id := range ids {
+		grant, found := k.GetEscrowGrant(ctx, id)
+		if !found {
+			continue
+		}
+		k.DeleteEscrowGrant(ctx, grant)
+		grant.RecipientAddress = newRecipient.String()
+		k.SetEscrowGrant(ctx, grant)
+	}
+}
@@ -0,0 +1,133 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/evmos/vesting/x/vesting/types"
+)
+
+// createEscrowGrant funds an escrow grant of 1000stake to the recipient that
+// vests according to testVestingPeriods and unlocks immediately.
+func (suite *KeeperTestSuite) createEscrowGrant(funder, recipient sdk.AccAddress) uint64 {
+	suite.fundAccount(funder, stakeCoins(1000))
+	res, err := suite.keeper.CreateEscrowGrant(suite.ctx, types.NewMsgCreateEscrowGrant(
+		funder, recipient, suite.ctx.BlockTime(), nil, testVestingPeriods,
+	))
+	suite.Require().NoError(err)
+	return res.Id
+}
+
+func (suite *KeeperTestSuite) TestCreateEscrowGrant() {
+	funder := sdk.AccAddress("funder______________")
+	recipient := sdk.AccAddress("recipient___________")
+
+	id := suite.createEscrowGrant(funder, recipient)
+	suite.Require().Equal(uint64(1), id)
+
+	// the coins are held by the module account instead of the recipient
+	moduleAddr := suite.accountKeeper.GetModuleAddress(types.ModuleName)
+	suite.Require().Equal(stakeCoins(1000), suite.bankKeeper.GetAllBalances(suite.ctx, moduleAddr))
+	suite.Require().True(suite.bankKeeper.GetAllBalances(suite.ctx, funder).IsZero())
+	suite.Require().True(suite.bankKeeper.GetAllBalances(suite.ctx, recipient).IsZero())
+	suite.Require().Nil(suite.accountKeeper.GetAccount(suite.ctx, recipient))
+
+	// the absent lockup schedule defaults to an instant unlock
+	grant, found := suite.keeper.GetEscrowGrant(suite.ctx, id)
+	suite.Require().True(found)
+	suite.Require().Equal(stakeCoins(1000), grant.OriginalAmount)
+	suite.Require().Len(grant.LockupPeriods, 1)
+	suite.Require().Equal(int64(0), grant.LockupPeriods[0].Length)
+	suite.Require().Equal(uint64(2), suite.keeper.GetNextEscrowGrantID(suite.ctx))
+
+	// module accounts cannot be the recipient
+	distrAddr := suite.accountKeeper.GetModuleAddress(distrtypes.ModuleName)
+	suite.fundAccount(funder, stakeCoins(1000))
+	_, err := suite.keeper.CreateEscrowGrant(suite.ctx, types.NewMsgCreateEscrowGrant(
+		funder, distrAddr, suite.ctx.BlockTime(), nil, testVestingPeriods,
+	))
+	suite.Require().ErrorContains(err, "is not allowed to receive funds")
+	suite.Require().Equal(stakeCoins(1000), suite.bankKeeper.GetAllBalances(suite.ctx, funder))
+}
+
+func (suite *KeeperTestSuite) TestClaimEscrowGrant() {
+	funder := sdk.AccAddress("funder______________")
+	recipient := sdk.AccAddress("recipient___________")
+	id := suite.createEscrowGrant(funder, recipient)
+
+	_, err := suite.keeper.ClaimEscrowGrant(suite.ctx, types.NewMsgClaimEscrowGrant(recipient, id))
+	suite.Require().ErrorIs(err, types.ErrInsufficientUnlockedCoins)
+
+	suite.advanceTime(50 * time.Second)
+	_, err = suite.keeper.ClaimEscrowGrant(suite.ctx, types.NewMsgClaimEscrowGrant(funder, id))
+	suite.Require().ErrorContains(err, "is not the recipient")
+
+	res, err := suite.keeper.ClaimEscrowGrant(suite.ctx, types.NewMsgClaimEscrowGrant(recipient, id))
+	suite.Require().NoError(err)
+	suite.Require().Equal(stakeCoins(500), res.Coins)
+	suite.Require().Equal(stakeCoins(500), suite.bankKeeper.GetAllBalances(suite.ctx, recipient))
+
+	// the claimed coins cannot be claimed twice
+	_, err = suite.keeper.ClaimEscrowGrant(suite.ctx, types.NewMsgClaimEscrowGrant(recipient, id))
+	suite.Require().ErrorIs(err, types.ErrInsufficientUnlockedCoins)
+
+	// the grant is removed once fully claimed
+	suite.advanceTime(50 * time.Second)
+	res, err = suite.keeper.ClaimEscrowGrant(suite.ctx, types.NewMsgClaimEscrowGrant(recipient, id))
+	suite.Require().NoError(err)
+	suite.Require().Equal(stakeCoins(500), res.Coins)
+	suite.Require().Equal(stakeCoins(1000), suite.bankKeeper.GetAllBalances(suite.ctx, recipient))
+	_, found := suite.keeper.GetEscrowGrant(suite.ctx, id)
+	suite.Require().False(found)
+
+	_, err = suite.keeper.ClaimEscrowGrant(suite.ctx, types.NewMsgClaimEscrowGrant(recipient, id))
+	suite.Require().ErrorContains(err, "does not exist")
+}
+
+func (suite *KeeperTestSuite) TestClawbackEscrowGrant() {
+	funder := sdk.AccAddress("funder______________")
+	recipient := sdk.AccAddress("recipient___________")
+	id := suite.createEscrowGrant(funder, recipient)
+
+	suite.advanceTime(50 * time.Second)
+	_, err := suite.keeper.ClawbackEscrowGrant(suite.ctx, types.NewMsgClawbackEscrowGrant(recipient, id, nil))
+	suite.Require().ErrorContains(err, "is not the funder")
+
+	// the unvested coins are returned to the funder by default
+	_, err = suite.keeper.ClawbackEscrowGrant(suite.ctx, types.NewMsgClawbackEscrowGrant(funder, id, nil))
+	suite.Require().NoError(err)
+	suite.Require().Equal(stakeCoins(500), suite.bankKeeper.GetAllBalances(suite.ctx, funder))
+
+	_, err = suite.keeper.ClawbackEscrowGrant(suite.ctx, types.NewMsgClawbackEscrowGrant(funder, id, nil))
+	suite.Require().ErrorIs(err, types.ErrNothingToClawback)
+
+	// the vested coins can still be claimed, which removes the grant
+	suite.advanceTime(time.Hour)
+	res, err := suite.keeper.ClaimEscrowGrant(suite.ctx, types.NewMsgClaimEscrowGrant(recipient, id))
+	suite.Require().NoError(err)
+	suite.Require().Equal(stakeCoins(500), res.Coins)
+	_, found := suite.keeper.GetEscrowGrant(suite.ctx, id)
+	suite.Require().False(found)
+	suite.Require().True(suite.bankKeeper.GetAllBalances(suite.ctx, suite.accountKeeper.GetModuleAddress(types.ModuleName)).IsZero())
+}
+
+func (suite *KeeperTestSuite) TestClawbackEscrowGrantDestination() {
+	funder := sdk.AccAddress("funder______________")
+	recipient := sdk.AccAddress("recipient___________")
+	dest := sdk.AccAddress("destination_________")
+	id := suite.createEscrowGrant(funder, recipient)
+
+	distrAddr := suite.accountKeeper.GetModuleAddress(distrtypes.ModuleName)
+	_, err := suite.keeper.ClawbackEscrowGrant(suite.ctx, types.NewMsgClawbackEscrowGrant(funder, id, distrAddr))
+	suite.Require().ErrorContains(err, "is not allowed to receive funds")
+
+	// nothing has vested, so the whole grant is clawed back and removed
+	_, err = suite.keeper.ClawbackEscrowGrant(suite.ctx, types.NewMsgClawbackEscrowGrant(funder, id, dest))
+	suite.Require().NoError(err)
+	suite.Require().Equal(stakeCoins(1000), suite.bankKeeper.GetAllBalances(suite.ctx, dest))
+	suite.Require().True(suite.bankKeeper.GetAllBalances(suite.ctx, funder).IsZero())
+	_, found := suite.keeper.GetEscrowGrant(suite.ctx, id)
+	suite.Require().False(found)
+}
@@ -0,0 +1,16 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/vesting/x/vesting/types"
+)
+
+// SetVestingIndexes exposes setVestingIndexes to the keeper tests.
+func (k Keeper) SetVestingIndexes(ctx sdk.Context, va *types.ClawbackVestingAccount) {
+	k.setVestingIndexes(ctx, va)
+}
+
+// DeleteVestingIndexes exposes deleteVestingIndexes to the keeper tests.
+func (k Keeper) DeleteVestingIndexes(ctx sdk.Context, va *types.ClawbackVestingAccount) {
+	k.deleteVestingIndexes(ctx, va)
+}
@@ -0,0 +1,227 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/evmos/vesting/x/vesting/types"
+)
+
+// GetFunderCommittee returns the funder committee with the given address, if
+// any.
+func (k Keeper) GetFunderCommittee(ctx sdk.Context, addr sdk.AccAddress) (committee types.FunderCommittee, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.FunderCommitteeKey(addr))
+	if bz == nil {
+		return committee, false
+	}
+	k.cdc.MustUnmarshal(bz, &committee)
+	return committee, true
+}
+
+// SetFunderCommittee stores the given funder committee.
+func (k Keeper) SetFunderCommittee(ctx sdk.Context, committee types.FunderCommittee) {
+	addr := sdk.MustAccAddressFromBech32(committee.Address)
+	ctx.KVStore(k.storeKey).Set(types.FunderCommitteeKey(addr), k.cdc.MustMarshal(&committee))
+}
+
+// GetNextFunderCommitteeID returns the id used to derive the address of the
+// next funder committee and increments it.
+func (k Keeper) GetNextFunderCommitteeID(ctx sdk.Context) uint64 {
+	id := k.PeekNextFunderCommitteeID(ctx)
+	k.SetNextFunderCommitteeID(ctx, id+1)
+	return id
+}
+
+// SetNextFunderCommitteeID sets the id used to derive the address of the next
+// funder committee.
+func (k Keeper) SetNextFunderCommitteeID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.KeyNextFunderCommitteeID, sdk.Uint64ToBigEndian(id))
+}
+
+// PeekNextFunderCommitteeID returns the id used to derive the address of the
+// next funder committee without incrementing it.
+func (k Keeper) PeekNextFunderCommitteeID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyNextFunderCommitteeID)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// IterateFunderCommittees iterates over all the funder committees and performs
+// a callback function.
+func (k Keeper) IterateFunderCommittees(ctx sdk.Context, cb func(committee types.FunderCommittee) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFunderCommittee)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var committee types.FunderCommittee
+		k.cdc.MustUnmarshal(iterator.Value(), &committee)
+		if cb(committee) {
+			break
+		}
+	}
+}
+
+// GetCommitteeProposal returns the funder committee proposal with the given
+// id, if any.
+func (k Keeper) GetCommitteeProposal(ctx sdk.Context, id uint64) (proposal types.CommitteeProposal, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.CommitteeProposalKey(id))
+	if bz == nil {
+		return proposal, false
+	}
+	k.cdc.MustUnmarshal(bz, &proposal)
+	return proposal, true
+}
+
+// SetCommitteeProposal stores the given funder committee proposal and indexes
+// it by committee and expiry time.
+func (k Keeper) SetCommitteeProposal(ctx sdk.Context, proposal types.CommitteeProposal) {
+	store := ctx.KVStore(k.storeKey)
+	committee := sdk.MustAccAddressFromBech32(proposal.CommitteeAddress)
+	store.Set(types.CommitteeProposalKey(proposal.Id), k.cdc.MustMarshal(&proposal))
+	store.Set(types.CommitteeProposalByCommitteeKey(committee, proposal.Id), []byte{0x01})
+	store.Set(types.CommitteeProposalExpiryKey(proposal.ExpiryTime, proposal.Id), []byte{0x01})
+}
+
+// DeleteCommitteeProposal removes the given funder committee proposal and its
+// index entries.
+func (k Keeper) DeleteCommitteeProposal(ctx sdk.Context, proposal types.CommitteeProposal) {
+	store := ctx.KVStore(k.storeKey)
+	committee := sdk.MustAccAddressFromBech32(proposal.CommitteeAddress)
+	store.Delete(types.CommitteeProposalKey(proposal.Id))
+	store.Delete(types.CommitteeProposalByCommitteeKey(committee, proposal.Id))
+	store.Delete(types.CommitteeProposalExpiryKey(proposal.ExpiryTime, proposal.Id))
+}
+
+// GetNextCommitteeProposalID returns the id of the next funder committee
+// proposal and increments it.
+func (k Keeper) GetNextCommitteeProposalID(ctx sdk.Context) uint64 {
+	id := k.PeekNextCommitteeProposalID(ctx)
+	k.SetNextCommitteeProposalID(ctx, id+1)
+	return id
+}
+
+// SetNextCommitteeProposalID sets the id of the next funder committee
+// proposal.
+func (k Keeper) SetNextCommitteeProposalID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.KeyNextCommitteeProposalID, sdk.Uint64ToBigEndian(id))
+}
+
+// PeekNextCommitteeProposalID returns the id of the next funder committee
+// proposal without incrementing it.
+func (k Keeper) PeekNextCommitteeProposalID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyNextCommitteeProposalID)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// IterateCommitteeProposals iterates over all the funder committee proposals,
+// ordered by id, and performs a callback function.
+func (k Keeper) IterateCommitteeProposals(ctx sdk.Context, cb func(proposal types.CommitteeProposal) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCommitteeProposal)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var proposal types.CommitteeProposal
+		k.cdc.MustUnmarshal(iterator.Value(), &proposal)
+		if cb(proposal) {
+			break
+		}
+	}
+}
+
+// IterateExpiredCommitteeProposals iterates over the funder committee
+// proposals that expire at or before the given time, ordered by expiry time,
+// and performs a callback function with their expiry time and id.
+func (k Keeper) IterateExpiredCommitteeProposals(
+	ctx sdk.Context,
+	expiryTime int64,
+	cb func(expiryTime int64, id uint64) (stop bool),
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCommitteeProposalExpiry)
+	// NOTE: the end of the range is exclusive, so use the prefix of the next second
+	end := sdk.Uint64ToBigEndian(uint64(expiryTime + 1))
+
+	iterator := store.Iterator(nil, end)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		t, id := types.SplitCommitteeProposalExpiryKey(iterator.Key())
+		if cb(t, id) {
+			break
+		}
+	}
+}
+
+// executeCommitteeProposal executes the action of the given funder committee
+// proposal, with the committee as the funder of the vesting account.
+func (k Keeper) executeCommitteeProposal(ctx sdk.Context, proposal types.CommitteeProposal) error {
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	switch proposal.Action {
+	case types.COMMITTEE_ACTION_CLAWBACK:
+		_, err := k.Clawback(goCtx, &types.MsgClawback{
+			FunderAddress:  proposal.CommitteeAddress,
+			AccountAddress: proposal.VestingAddress,
+			DestAddress:    proposal.DestAddress,
+		})
+		return err
+	case types.COMMITTEE_ACTION_UPDATE_FUNDER:
+		_, err := k.UpdateVestingFunder(goCtx, &types.MsgUpdateVestingFunder{
+			FunderAddress:    proposal.CommitteeAddress,
+			NewFunderAddress: proposal.NewFunderAddress,
+			VestingAddress:   proposal.VestingAddress,
+		})
+		return err
+	case types.COMMITTEE_ACTION_ACCELERATE:
+		return k.accelerateVesting(ctx, proposal.CommitteeAddress, proposal.VestingAddress)
+	default:
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid committee action %s", proposal.Action)
+	}
+}
+
+// accelerateVesting vests all the unvested coins of the given clawback
+// vesting account immediately. The lockup schedule is not modified.
+func (k Keeper) accelerateVesting(ctx sdk.Context, funder, vestingAddress string) error {
+	va, err := k.GetClawbackVestingAccount(ctx, sdk.MustAccAddressFromBech32(vestingAddress))
+	if err != nil {
+		return err
+	}
+
+	if va.FunderAddress != funder {
+		return errorsmod.Wrapf(errortypes.ErrUnauthorized, "%s is not the funder of account %s", funder, vestingAddress)
+	}
+
+	if va.IsVestingPaused() {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "vesting of account %s is paused", vestingAddress)
+	}
+
+	// the acceleration replaces the upcoming vesting events
+	k.deleteVestingIndexes(ctx, va)
+	accelerated, err := va.AccelerateVesting(ctx.BlockTime())
+	if err != nil {
+		return err
+	}
+	k.accountKeeper.SetAccount(ctx, va)
+	k.setVestingIndexes(ctx, va)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAccelerateVesting,
+			sdk.NewAttribute(types.AttributeKeyFunder, funder),
+			sdk.NewAttribute(types.AttributeKeyAccount, vestingAddress),
+			sdk.NewAttribute(types.AttributeKeyCoins, accelerated.String()),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/vesting/x/vesting/types"
+)
+
+var (
+	testMember1 = sdk.AccAddress("member_1____________")
+	testMember2 = sdk.AccAddress("member_2____________")
+	testMember3 = sdk.AccAddress("member_3____________")
+)
+
+// createFunderCommittee creates a funder committee of the three test members
+// with the given threshold and a proposal lifetime of 100 seconds, and sets it
+// as the funder of a new vesting account at the given address.
+func (suite *KeeperTestSuite) createFunderCommittee(addr sdk.AccAddress, threshold uint32) sdk.AccAddress {
+	funder := sdk.AccAddress("funder______________")
+	members := []string{testMember1.String(), testMember2.String(), testMember3.String()}
+
+	res, err := suite.keeper.CreateFunderCommittee(suite.ctx, types.NewMsgCreateFunderCommittee(funder, members, threshold, 100))
+	suite.Require().NoError(err)
+	committee := sdk.MustAccAddressFromBech32(res.Address)
+
+	suite.createVestingAccount(funder, addr, testLockupPeriods, testVestingPeriods, false)
+	_, err = suite.keeper.UpdateVestingFunder(suite.ctx, types.NewMsgUpdateVestingFunder(funder, committee, addr))
+	suite.Require().NoError(err)
+
+	return committee
+}
+
+func (suite *KeeperTestSuite) TestCreateFunderCommittee() {
+	addr := sdk.AccAddress("vesting_account_____")
+	committee := suite.createFunderCommittee(addr, 2)
+	suite.Require().Equal(types.NewFunderCommitteeAddress(1), committee)
+
+	stored, found := suite.keeper.GetFunderCommittee(suite.ctx, committee)
+	suite.Require().True(found)
+	suite.Require().Equal([]string{testMember1.String(), testMember2.String(), testMember3.String()}, stored.Members)
+	suite.Require().Equal(uint32(2), stored.Threshold)
+	suite.Require().Equal(int64(100), stored.ProposalLifetime)
+	suite.Require().Equal(committee.String(), suite.getVestingAccount(addr).FunderAddress)
+}
+
+func (suite *KeeperTestSuite) TestCommitteeProposalThreshold() {
+	addr := sdk.AccAddress("vesting_account_____")
+	dest := sdk.AccAddress("destination_________")
+	committee := suite.createFunderCommittee(addr, 2)
+
+	// the proposer's approval does not reach the threshold
+	res, err := suite.keeper.SubmitCommitteeProposal(suite.ctx, types.NewMsgSubmitCommitteeProposal(
+		testMember1, committee, addr, types.COMMITTEE_ACTION_CLAWBACK, dest.String(), "",
+	))
+	suite.Require().NoError(err)
+	suite.Require().False(res.Executed)
+
+	proposal, found := suite.keeper.GetCommitteeProposal(suite.ctx, res.Id)
+	suite.Require().True(found)
+	suite.Require().Equal([]string{testMember1.String()}, proposal.Approvals)
+	suite.Require().Equal(suite.ctx.BlockTime().Unix()+100, proposal.ExpiryTime)
+
+	_, err = suite.keeper.ApproveCommitteeProposal(suite.ctx, types.NewMsgApproveCommitteeProposal(dest, res.Id))
+	suite.Require().ErrorContains(err, "is not a member")
+
+	_, err = suite.keeper.ApproveCommitteeProposal(suite.ctx, types.NewMsgApproveCommitteeProposal(testMember1, res.Id))
+	suite.Require().ErrorContains(err, "already approved")
+	suite.Require().Equal(stakeCoins(1000), suite.bankKeeper.GetAllBalances(suite.ctx, addr))
+
+	// the second approval reaches the threshold and executes the clawback
+	approveRes, err := suite.keeper.ApproveCommitteeProposal(suite.ctx, types.NewMsgApproveCommitteeProposal(testMember2, res.Id))
+	suite.Require().NoError(err)
+	suite.Require().True(approveRes.Executed)
+
+	suite.Require().Equal(stakeCoins(1000), suite.bankKeeper.GetAllBalances(suite.ctx, dest))
+	suite.Require().True(suite.bankKeeper.GetAllBalances(suite.ctx, addr).IsZero())
+	_, found = suite.keeper.GetCommitteeProposal(suite.ctx, res.Id)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestExecuteCommitteeProposal() {
+	addr := sdk.AccAddress("vesting_account_____")
+	newFunder := sdk.AccAddress("new_funder__________")
+	committee := suite.createFunderCommittee(addr, 1)
+	suite.advanceTime(10 * time.Second)
+
+	// a threshold of one executes the proposal on submission
+	res, err := suite.keeper.SubmitCommitteeProposal(suite.ctx, types.NewMsgSubmitCommitteeProposal(
+		testMember1, committee, addr, types.COMMITTEE_ACTION_ACCELERATE, "", "",
+	))
+	suite.Require().NoError(err)
+	suite.Require().True(res.Executed)
+
+	va := suite.getVestingAccount(addr)
+	suite.Require().Equal(stakeCoins(1000), va.GetVestedCoins(suite.ctx.BlockTime()))
+	suite.Require().Equal(stakeCoins(1000), va.GetLockedUpCoins(suite.ctx.BlockTime()))
+
+	res, err = suite.keeper.SubmitCommitteeProposal(suite.ctx, types.NewMsgSubmitCommitteeProposal(
+		testMember2, committee, addr, types.COMMITTEE_ACTION_UPDATE_FUNDER, "", newFunder.String(),
+	))
+	suite.Require().NoError(err)
+	suite.Require().True(res.Executed)
+	suite.Require().Equal(newFunder.String(), suite.getVestingAccount(addr).FunderAddress)
+
+	// the committee is no longer the funder of the account
+	_, err = suite.keeper.SubmitCommitteeProposal(suite.ctx, types.NewMsgSubmitCommitteeProposal(
+		testMember1, committee, addr, types.COMMITTEE_ACTION_ACCELERATE, "", "",
+	))
+	suite.Require().ErrorContains(err, "is not the funder")
+}
+
+func (suite *KeeperTestSuite) TestCommitteeProposalExpiry() {
+	addr := sdk.AccAddress("vesting_account_____")
+	committee := suite.createFunderCommittee(addr, 2)
+
+	res, err := suite.keeper.SubmitCommitteeProposal(suite.ctx, types.NewMsgSubmitCommitteeProposal(
+		testMember1, committee, addr, types.COMMITTEE_ACTION_ACCELERATE, "", "",
+	))
+	suite.Require().NoError(err)
+
+	suite.advanceTime(99 * time.Second)
+	suite.endBlock()
+	_, found := suite.keeper.GetCommitteeProposal(suite.ctx, res.Id)
+	suite.Require().True(found)
+
+	suite.advanceTime(time.Second)
+	_, err = suite.keeper.ApproveCommitteeProposal(suite.ctx, types.NewMsgApproveCommitteeProposal(testMember2, res.Id))
+	suite.Require().ErrorContains(err, "has expired")
+
+	suite.endBlock()
+	_, found = suite.keeper.GetCommitteeProposal(suite.ctx, res.Id)
+	suite.Require().False(found)
+}
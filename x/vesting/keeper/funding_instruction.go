@@ -0,0 +1,120 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/vesting/x/vesting/types"
+)
+
+// GetFundingInstruction returns the recurring funding instruction with the
+// given id, if any.
+func (k Keeper) GetFundingInstruction(ctx sdk.Context, id uint64) (instruction types.FundingInstruction, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.FundingInstructionKey(id))
+	if bz == nil {
+		return instruction, false
+	}
+	k.cdc.MustUnmarshal(bz, &instruction)
+	return instruction, true
+}
+
+// SetFundingInstruction stores the given recurring funding instruction and
+// indexes it by the due time of its next grant.
+func (k Keeper) SetFundingInstruction(ctx sdk.Context, instruction types.FundingInstruction) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.FundingInstructionKey(instruction.Id), k.cdc.MustMarshal(&instruction))
+	store.Set(types.FundingInstructionQueueKey(instruction.NextTime, instruction.Id), []byte{0x01})
+}
+
+// DeleteFundingInstruction removes the given recurring funding instruction
+// and its due time index entry.
+func (k Keeper) DeleteFundingInstruction(ctx sdk.Context, instruction types.FundingInstruction) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.FundingInstructionKey(instruction.Id))
+	store.Delete(types.FundingInstructionQueueKey(instruction.NextTime, instruction.Id))
+}
+
+// GetNextFundingInstructionID returns the id of the next recurring funding
+// instruction and increments it.
+func (k Keeper) GetNextFundingInstructionID(ctx sdk.Context) uint64 {
+	id := k.PeekNextFundingInstructionID(ctx)
+	k.SetNextFundingInstructionID(ctx, id+1)
+	return id
+}
+
+// SetNextFundingInstructionID sets the id of the next recurring funding
+// instruction.
+func (k Keeper) SetNextFundingInstructionID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.KeyNextFundingInstructionID, sdk.Uint64ToBigEndian(id))
+}
+
+// PeekNextFundingInstructionID returns the id of the next recurring funding
+// instruction without incrementing it.
+func (k Keeper) PeekNextFundingInstructionID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyNextFundingInstructionID)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// IterateFundingInstructions iterates over all the recurring funding
+// instructions, ordered by id, and performs a callback function.
+func (k Keeper) IterateFundingInstructions(ctx sdk.Context, cb func(instruction types.FundingInstruction) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFundingInstruction)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var instruction types.FundingInstruction
+		k.cdc.MustUnmarshal(iterator.Value(), &instruction)
+		if cb(instruction) {
+			break
+		}
+	}
+}
+
+// IterateFundingInstructionQueue iterates over the funding instructions whose
+// next grant is due at or before the given time, ordered by due time, and
+// performs a callback function with their due time and id.
+func (k Keeper) IterateFundingInstructionQueue(
+	ctx sdk.Context,
+	dueTime int64,
+	cb func(dueTime int64, id uint64) (stop bool),
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFundingInstructionQueue)
+	// NOTE: the end of the range is exclusive, so use the prefix of the next second
+	end := sdk.Uint64ToBigEndian(uint64(dueTime + 1))
+
+	iterator := store.Iterator(nil, end)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		t, id := types.SplitFundingInstructionQueueKey(iterator.Key())
+		if cb(t, id) {
+			break
+		}
+	}
+}
+
+// retargetFundingInstructions sets the given new vesting account as the
+// vesting account of all the recurring funding instructions of the given
+// vesting account.
+func (k Keeper) retargetFundingInstructions(ctx sdk.Context, vestingAddr, newVestingAddr sdk.AccAddress) {
+	// NOTE: collect the instructions first to avoid writing to the store while
+	// iterating over it
+	var instructions []types.FundingInstruction
+	k.IterateFundingInstructions(ctx, func(instruction types.FundingInstruction) bool {
+		if instruction.VestingAddress == vestingAddr.String() {
+			instructions = append(instructions, instruction)
+		}
+		return false
+	})
+
+	for _, instruction := range instructions {
+		instruction.VestingAddress = newVestingAddr.String()
+		k.SetFundingInstruction(ctx, instruction)
+	}
+}
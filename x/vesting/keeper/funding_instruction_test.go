@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+
+	"github.com/evmos/vesting/x/vesting/types"
+)
+
+// testGrantPeriods is the lockup template of the test funding instructions.
+var testGrantPeriods = sdkvesting.Periods{{Length: 10, Amount: stakeCoins(100)}}
+
+// createFundingInstruction registers a funding instruction of two grants of the
+// funder to the vesting account, 100 seconds apart, starting at the current
+// block time.
+func (suite *KeeperTestSuite) createFundingInstruction(funder, addr sdk.AccAddress) types.FundingInstruction {
+	res, err := suite.keeper.CreateFundingInstruction(suite.ctx, types.NewMsgCreateFundingInstruction(
+		funder, addr, suite.ctx.BlockTime(), 100, 2, testGrantPeriods,
+	))
+	suite.Require().NoError(err)
+
+	instruction, found := suite.keeper.GetFundingInstruction(suite.ctx, res.Id)
+	suite.Require().True(found)
+	return instruction
+}
+
+func (suite *KeeperTestSuite) TestExecuteFundingInstructions() {
+	funder := sdk.AccAddress("funder______________")
+	addr := sdk.AccAddress("vesting_account_____")
+
+	suite.createVestingAccount(funder, addr, testLockupPeriods, testVestingPeriods, false)
+	suite.fundAccount(funder, stakeCoins(200))
+	instruction := suite.createFundingInstruction(funder, addr)
+	startTime := instruction.NextTime
+
+	events := eventsOfType(suite.endBlock(), types.EventTypeExecuteFundingInstruction)
+	suite.Require().Len(events, 1)
+	suite.Require().Equal(stakeCoins(1100), suite.getVestingAccount(addr).OriginalVesting)
+
+	instruction, found := suite.keeper.GetFundingInstruction(suite.ctx, instruction.Id)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(1), instruction.RemainingCount)
+	suite.Require().Equal(startTime+100, instruction.NextTime)
+
+	// the next grant is not due yet
+	suite.advanceTime(99 * time.Second)
+	suite.Require().Empty(eventsOfType(suite.endBlock(), types.EventTypeExecuteFundingInstruction))
+
+	suite.advanceTime(time.Second)
+	suite.Require().Len(eventsOfType(suite.endBlock(), types.EventTypeExecuteFundingInstruction), 1)
+	suite.Require().Equal(stakeCoins(1200), suite.getVestingAccount(addr).OriginalVesting)
+	suite.Require().True(suite.bankKeeper.GetAllBalances(suite.ctx, funder).IsZero())
+
+	_, found = suite.keeper.GetFundingInstruction(suite.ctx, instruction.Id)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestFailedFundingInstructionIsPostponed() {
+	funder := sdk.AccAddress("funder______________")
+	addr := sdk.AccAddress("vesting_account_____")
+
+	suite.createVestingAccount(funder, addr, testLockupPeriods, testVestingPeriods, false)
+	instruction := suite.createFundingInstruction(funder, addr)
+	startTime := instruction.NextTime
+
+	// the funder lacks balance, so the grant is postponed without consuming it
+	events := suite.endBlock()
+	suite.Require().Empty(eventsOfType(events, types.EventTypeExecuteFundingInstruction))
+	suite.Require().Len(eventsOfType(events, types.EventTypeFundingInstructionFailed), 1)
+	suite.Require().Equal(stakeCoins(1000), suite.getVestingAccount(addr).OriginalVesting)
+
+	instruction, found := suite.keeper.GetFundingInstruction(suite.ctx, instruction.Id)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(2), instruction.RemainingCount)
+	suite.Require().Equal(startTime+100, instruction.NextTime)
+
+	suite.fundAccount(funder, stakeCoins(200))
+	suite.advanceTime(100 * time.Second)
+	suite.Require().Len(eventsOfType(suite.endBlock(), types.EventTypeExecuteFundingInstruction), 1)
+	suite.Require().Equal(stakeCoins(1100), suite.getVestingAccount(addr).OriginalVesting)
+
+	instruction, found = suite.keeper.GetFundingInstruction(suite.ctx, instruction.Id)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(1), instruction.RemainingCount)
+}
+
+func (suite *KeeperTestSuite) TestFundingInstructionRemovedAfterFunderUpdate() {
+	funder := sdk.AccAddress("funder______________")
+	newFunder := sdk.AccAddress("new_funder__________")
+	addr := sdk.AccAddress("vesting_account_____")
+
+	suite.createVestingAccount(funder, addr, testLockupPeriods, testVestingPeriods, false)
+	suite.fundAccount(funder, stakeCoins(200))
+	instruction := suite.createFundingInstruction(funder, addr)
+
+	_, err := suite.keeper.UpdateVestingFunder(suite.ctx, types.NewMsgUpdateVestingFunder(funder, newFunder, addr))
+	suite.Require().NoError(err)
+
+	events := eventsOfType(suite.endBlock(), types.EventTypeFundingInstructionFailed)
+	suite.Require().Len(events, 1)
+	suite.Require().Contains(events[0].Attributes, sdk.NewAttribute(types.AttributeKeyRemaining, "0").ToKVPair())
+
+	_, found := suite.keeper.GetFundingInstruction(suite.ctx, instruction.Id)
+	suite.Require().False(found)
+	suite.Require().Equal(stakeCoins(200), suite.bankKeeper.GetAllBalances(suite.ctx, funder))
+	suite.Require().Equal(stakeCoins(1000), suite.getVestingAccount(addr).OriginalVesting)
+}
@@ -0,0 +1,170 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/evmos/vesting/x/vesting/types"
+)
+
+// InitGenesis sets the module parameters and store entries, and indexes the
+// clawback vesting accounts of the genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		panic(err)
+	}
+
+	for _, accAny := range data.WrappedAccounts {
+		acc, ok := accAny.GetCachedValue().(authtypes.AccountI)
+		if !ok {
+			panic(fmt.Sprintf("expected wrapped account, got %T", accAny.GetCachedValue()))
+		}
+		k.SetWrappedAccount(ctx, acc)
+	}
+
+	for _, address := range data.GovClawbackDisabledAccounts {
+		k.SetGovClawbackDisabled(ctx, sdk.MustAccAddressFromBech32(address))
+	}
+
+	for _, address := range data.ClawbackRenouncedAccounts {
+		k.SetClawbackRenounced(ctx, sdk.MustAccAddressFromBech32(address))
+	}
+
+	for _, paused := range data.PausedAccounts {
+		k.SetVestingPaused(ctx, sdk.MustAccAddressFromBech32(paused.Address), paused.PausedAt)
+	}
+
+	for _, amendment := range data.ScheduleAmendments {
+		k.SetScheduleAmendment(ctx, sdk.MustAccAddressFromBech32(amendment.VestingAddress), amendment)
+	}
+
+	// NOTE: setting the instructions also rebuilds their due time queue
+	for _, instruction := range data.FundingInstructions {
+		k.SetFundingInstruction(ctx, instruction)
+	}
+	if data.NextFundingInstructionId > 0 {
+		k.SetNextFundingInstructionID(ctx, data.NextFundingInstructionId)
+	}
+
+	// NOTE: setting the grants also rebuilds their recipient index
+	for _, grant := range data.EscrowGrants {
+		k.SetEscrowGrant(ctx, grant)
+	}
+	if data.NextEscrowGrantId > 0 {
+		k.SetNextEscrowGrantID(ctx, data.NextEscrowGrantId)
+	}
+
+	for _, committee := range data.FunderCommittees {
+		k.SetFunderCommittee(ctx, committee)
+	}
+	if data.NextFunderCommitteeId > 0 {
+		k.SetNextFunderCommitteeID(ctx, data.NextFunderCommitteeId)
+	}
+
+	// NOTE: setting the proposals also rebuilds their committee and expiry
+	// time indexes
+	for _, proposal := range data.CommitteeProposals {
+		k.SetCommitteeProposal(ctx, proposal)
+	}
+	if data.NextCommitteeProposalId > 0 {
+		k.SetNextCommitteeProposalID(ctx, data.NextCommitteeProposalId)
+	}
+
+	k.IndexClawbackVestingAccounts(ctx)
+}
+
+// ExportGenesis returns the module genesis state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	wrappedAccounts := []*codectypes.Any{}
+	k.IterateWrappedAccounts(ctx, func(acc authtypes.AccountI) bool {
+		accAny, err := codectypes.NewAnyWithValue(acc)
+		if err != nil {
+			panic(err)
+		}
+		wrappedAccounts = append(wrappedAccounts, accAny)
+		return false
+	})
+
+	govClawbackDisabled := []string{}
+	k.IterateGovClawbackDisabled(ctx, func(addr sdk.AccAddress) bool {
+		govClawbackDisabled = append(govClawbackDisabled, addr.String())
+		return false
+	})
+
+	clawbackRenounced := []string{}
+	k.IterateClawbackRenounced(ctx, func(addr sdk.AccAddress) bool {
+		clawbackRenounced = append(clawbackRenounced, addr.String())
+		return false
+	})
+
+	pausedAccounts := []types.PausedAccount{}
+	k.IterateVestingPaused(ctx, func(addr sdk.AccAddress, pausedAt int64) bool {
+		pausedAccounts = append(pausedAccounts, types.PausedAccount{Address: addr.String(), PausedAt: pausedAt})
+		return false
+	})
+
+	scheduleAmendments := []types.ScheduleAmendment{}
+	k.IterateScheduleAmendments(ctx, func(amendment types.ScheduleAmendment) bool {
+		scheduleAmendments = append(scheduleAmendments, amendment)
+		return false
+	})
+
+	fundingInstructions := []types.FundingInstruction{}
+	k.IterateFundingInstructions(ctx, func(instruction types.FundingInstruction) bool {
+		fundingInstructions = append(fundingInstructions, instruction)
+		return false
+	})
+
+	escrowGrants := []types.EscrowGrant{}
+	k.IterateEscrowGrants(ctx, func(grant types.EscrowGrant) bool {
+		escrowGrants = append(escrowGrants, grant)
+		return false
+	})
+
+	funderCommittees := []types.FunderCommittee{}
+	k.IterateFunderCommittees(ctx, func(committee types.FunderCommittee) bool {
+		funderCommittees = append(funderCommittees, committee)
+		return false
+	})
+
+	committeeProposals := []types.CommitteeProposal{}
+	k.IterateCommitteeProposals(ctx, func(proposal types.CommitteeProposal) bool {
+		committeeProposals = append(committeeProposals, proposal)
+		return false
+	})
+
+	return &types.GenesisState{
+		Params:                      k.GetParams(ctx),
+		WrappedAccounts:             wrappedAccounts,
+		GovClawbackDisabledAccounts: govClawbackDisabled,
+		ClawbackRenouncedAccounts:   clawbackRenounced,
+		PausedAccounts:              pausedAccounts,
+		ScheduleAmendments:          scheduleAmendments,
+		FundingInstructions:         fundingInstructions,
+		NextFundingInstructionId:    k.PeekNextFundingInstructionID(ctx),
+		EscrowGrants:                escrowGrants,
+		NextEscrowGrantId:           k.PeekNextEscrowGrantID(ctx),
+		FunderCommittees:            funderCommittees,
+		NextFunderCommitteeId:       k.PeekNextFunderCommitteeID(ctx),
+		CommitteeProposals:          committeeProposals,
+		NextCommitteeProposalId:     k.PeekNextCommitteeProposalID(ctx),
+	}
+}
+
+// IndexClawbackVestingAccounts adds all the clawback vesting accounts stored in
+// the account keeper to the end time and schedule event indexes. The periods
+// that ended before the current block time are not added to the schedule event
+// index.
+func (k Keeper) IndexClawbackVestingAccounts(ctx sdk.Context) {
+	k.accountKeeper.IterateAccounts(ctx, func(acc authtypes.AccountI) bool {
+		if va, ok := acc.(*types.ClawbackVestingAccount); ok {
+			k.setVestingIndexes(ctx, va)
+		}
+		return false
+	})
+}
@@ -0,0 +1,319 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+
+	"github.com/evmos/vesting/x/vesting/types"
+)
+
+// reimportGenesis exports the genesis state of the vesting module, validates
+// it and imports it into new keepers with an empty state.
+func (suite *KeeperTestSuite) reimportGenesis() types.GenesisState {
+	genesis := suite.keeper.ExportGenesis(suite.ctx)
+	suite.Require().NoError(genesis.Validate())
+
+	bz := suite.cdc.MustMarshalJSON(genesis)
+	var imported types.GenesisState
+	suite.cdc.MustUnmarshalJSON(bz, &imported)
+	suite.Require().NoError(imported.Validate())
+
+	suite.setupKeepers(nil)
+	suite.keeper.InitGenesis(suite.ctx, imported)
+	return imported
+}
+
+func (suite *KeeperTestSuite) TestGenesisRoundTrip() {
+	funder := sdk.AccAddress("funder______________")
+	addr := sdk.AccAddress("vesting_account_____")
+
+	testCases := []struct {
+		name string
+		// malleate writes the state to export and returns the checks of the
+		// reimported genesis state and keeper
+		malleate func() func(genesis types.GenesisState)
+	}{
+		{
+			name: "clawback flags",
+			malleate: func() func(types.GenesisState) {
+				govDisabled := sdk.AccAddress("gov_disabled________")
+				suite.createVestingAccount(funder, addr, testLockupPeriods, testVestingPeriods, true)
+				_, err := suite.keeper.RenounceClawback(suite.ctx, types.NewMsgRenounceClawback(funder, addr, true))
+				suite.Require().NoError(err)
+				suite.createVestingAccount(funder, govDisabled, testLockupPeriods, testVestingPeriods, false)
+
+				return func(genesis types.GenesisState) {
+					suite.Require().ElementsMatch([]string{addr.String(), govDisabled.String()}, genesis.GovClawbackDisabledAccounts)
+					suite.Require().Equal([]string{addr.String()}, genesis.ClawbackRenouncedAccounts)
+
+					suite.Require().True(suite.keeper.HasClawbackRenounced(suite.ctx, addr))
+					suite.Require().True(suite.keeper.HasGovClawbackDisabled(suite.ctx, addr))
+					suite.Require().False(suite.keeper.HasClawbackRenounced(suite.ctx, govDisabled))
+					suite.Require().True(suite.keeper.HasGovClawbackDisabled(suite.ctx, govDisabled))
+				}
+			},
+		},
+		{
+			name: "paused accounts",
+			malleate: func() func(types.GenesisState) {
+				suite.createVestingAccount(funder, addr, testLockupPeriods, testVestingPeriods, false)
+				suite.advanceTime(10 * time.Second)
+				_, err := suite.keeper.PauseVesting(suite.ctx, types.NewMsgPauseVesting(suite.authority, addr))
+				suite.Require().NoError(err)
+				pausedAt := suite.ctx.BlockTime().Unix()
+
+				return func(genesis types.GenesisState) {
+					suite.Require().Equal([]types.PausedAccount{{Address: addr.String(), PausedAt: pausedAt}}, genesis.PausedAccounts)
+
+					var paused []types.PausedAccount
+					suite.keeper.IterateVestingPaused(suite.ctx, func(addr sdk.AccAddress, pausedAt int64) bool {
+						paused = append(paused, types.PausedAccount{Address: addr.String(), PausedAt: pausedAt})
+						return false
+					})
+					suite.Require().Equal(genesis.PausedAccounts, paused)
+				}
+			},
+		},
+		{
+			name: "schedule amendments",
+			malleate: func() func(types.GenesisState) {
+				suite.createVestingAccount(funder, addr, testLockupPeriods, testVestingPeriods, false)
+				amendedPeriods := sdkvesting.Periods{{Length: 200, Amount: stakeCoins(1000)}}
+				_, err := suite.keeper.ProposeScheduleAmendment(suite.ctx, types.NewMsgProposeScheduleAmendment(funder, addr, nil, amendedPeriods))
+				suite.Require().NoError(err)
+
+				return func(genesis types.GenesisState) {
+					suite.Require().Len(genesis.ScheduleAmendments, 1)
+					suite.Require().Equal(addr.String(), genesis.ScheduleAmendments[0].VestingAddress)
+
+					amendment, found := suite.keeper.GetScheduleAmendment(suite.ctx, addr)
+					suite.Require().True(found)
+					suite.Require().Equal(funder.String(), amendment.FunderAddress)
+					suite.Require().Empty(amendment.LockupPeriods)
+					suite.Require().Equal(amendedPeriods, amendment.VestingPeriods)
+				}
+			},
+		},
+		{
+			name: "funding instructions",
+			malleate: func() func(types.GenesisState) {
+				suite.createVestingAccount(funder, addr, testLockupPeriods, testVestingPeriods, false)
+				instruction := suite.createFundingInstruction(funder, addr)
+
+				return func(genesis types.GenesisState) {
+					suite.Require().Equal([]types.FundingInstruction{instruction}, genesis.FundingInstructions)
+					imported, found := suite.keeper.GetFundingInstruction(suite.ctx, instruction.Id)
+					suite.Require().True(found)
+					suite.Require().Equal(instruction, imported)
+
+					// the due time queue is rebuilt, so the imported instruction is executed
+					// once the vesting account, which is not part of the module state, exists
+					suite.createVestingAccount(funder, addr, testLockupPeriods, testVestingPeriods, false)
+					suite.fundAccount(funder, stakeCoins(100))
+					suite.Require().Len(eventsOfType(suite.endBlock(), types.EventTypeExecuteFundingInstruction), 1)
+					suite.Require().Equal(stakeCoins(1100), suite.getVestingAccount(addr).OriginalVesting)
+
+					// the id of the next instruction is restored
+					suite.Require().Equal(uint64(2), suite.createFundingInstruction(funder, addr).Id)
+				}
+			},
+		},
+		{
+			name: "escrow grants",
+			malleate: func() func(types.GenesisState) {
+				suite.fundAccount(funder, stakeCoins(1000))
+				res, err := suite.keeper.CreateEscrowGrant(suite.ctx, types.NewMsgCreateEscrowGrant(
+					funder, addr, suite.ctx.BlockTime(), nil, testVestingPeriods,
+				))
+				suite.Require().NoError(err)
+
+				suite.advanceTime(50 * time.Second)
+				_, err = suite.keeper.ClaimEscrowGrant(suite.ctx, types.NewMsgClaimEscrowGrant(addr, res.Id))
+				suite.Require().NoError(err)
+				grant, found := suite.keeper.GetEscrowGrant(suite.ctx, res.Id)
+				suite.Require().True(found)
+
+				return func(genesis types.GenesisState) {
+					suite.Require().Equal([]types.EscrowGrant{grant}, genesis.EscrowGrants)
+					imported, found := suite.keeper.GetEscrowGrant(suite.ctx, res.Id)
+					suite.Require().True(found)
+					suite.Require().Equal(stakeCoins(500), imported.Claimed)
+
+					// the recipient index is rebuilt
+					grants, err := suite.keeper.EscrowGrants(suite.ctx, &types.QueryEscrowGrantsRequest{Address: addr.String()})
+					suite.Require().NoError(err)
+					suite.Require().Equal([]types.EscrowGrant{grant}, grants.Grants)
+
+					// the id of the next grant is restored
+					suite.Require().Equal(uint64(2), suite.keeper.GetNextEscrowGrantID(suite.ctx))
+				}
+			},
+		},
+		{
+			name: "funder committees",
+			malleate: func() func(types.GenesisState) {
+				committee := suite.createFunderCommittee(addr, 2)
+				res, err := suite.keeper.SubmitCommitteeProposal(suite.ctx, types.NewMsgSubmitCommitteeProposal(
+					testMember1, committee, addr, types.COMMITTEE_ACTION_ACCELERATE, "", "",
+				))
+				suite.Require().NoError(err)
+				proposal, found := suite.keeper.GetCommitteeProposal(suite.ctx, res.Id)
+				suite.Require().True(found)
+
+				return func(genesis types.GenesisState) {
+					suite.Require().Len(genesis.FunderCommittees, 1)
+					suite.Require().Equal([]types.CommitteeProposal{proposal}, genesis.CommitteeProposals)
+					_, found := suite.keeper.GetFunderCommittee(suite.ctx, committee)
+					suite.Require().True(found)
+
+					// the committee index is rebuilt
+					proposals, err := suite.keeper.CommitteeProposals(suite.ctx, &types.QueryCommitteeProposalsRequest{Address: committee.String()})
+					suite.Require().NoError(err)
+					suite.Require().Equal([]types.CommitteeProposal{proposal}, proposals.Proposals)
+
+					// the ids of the next committee and proposal are restored
+					suite.Require().Equal(uint64(2), suite.keeper.GetNextFunderCommitteeID(suite.ctx))
+					suite.Require().Equal(uint64(2), suite.keeper.GetNextCommitteeProposalID(suite.ctx))
+
+					// the expiry time index is rebuilt
+					suite.advanceTime(100 * time.Second)
+					suite.endBlock()
+					_, found = suite.keeper.GetCommitteeProposal(suite.ctx, res.Id)
+					suite.Require().False(found)
+				}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			check := tc.malleate()
+			check(suite.reimportGenesis())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestValidateGenesis() {
+	funder := sdk.AccAddress("funder______________")
+	addr := sdk.AccAddress("vesting_account_____")
+	paused := sdk.AccAddress("paused_account______")
+	recipient := sdk.AccAddress("recipient___________")
+	member := sdk.AccAddress("committee_account___")
+
+	// export a genesis state that holds an entry of each kind
+	suite.createVestingAccount(funder, addr, testLockupPeriods, testVestingPeriods, true)
+	_, err := suite.keeper.RenounceClawback(suite.ctx, types.NewMsgRenounceClawback(funder, addr, true))
+	suite.Require().NoError(err)
+	suite.createFundingInstruction(funder, addr)
+	_, err = suite.keeper.ProposeScheduleAmendment(suite.ctx, types.NewMsgProposeScheduleAmendment(funder, addr, nil, testVestingPeriods))
+	suite.Require().NoError(err)
+
+	suite.createVestingAccount(funder, paused, testLockupPeriods, testVestingPeriods, true)
+	_, err = suite.keeper.PauseVesting(suite.ctx, types.NewMsgPauseVesting(suite.authority, paused))
+	suite.Require().NoError(err)
+
+	suite.fundAccount(funder, stakeCoins(1000))
+	_, err = suite.keeper.CreateEscrowGrant(suite.ctx, types.NewMsgCreateEscrowGrant(
+		funder, recipient, suite.ctx.BlockTime(), nil, testVestingPeriods,
+	))
+	suite.Require().NoError(err)
+
+	committee := suite.createFunderCommittee(member, 2)
+	_, err = suite.keeper.SubmitCommitteeProposal(suite.ctx, types.NewMsgSubmitCommitteeProposal(
+		testMember1, committee, member, types.COMMITTEE_ACTION_ACCELERATE, "", "",
+	))
+	suite.Require().NoError(err)
+
+	valid := *suite.keeper.ExportGenesis(suite.ctx)
+	suite.Require().NoError(valid.Validate())
+
+	testCases := []struct {
+		name     string
+		malleate func(genesis *types.GenesisState)
+		expError string
+	}{
+		{
+			name: "duplicate clawback renounced account",
+			malleate: func(genesis *types.GenesisState) {
+				genesis.ClawbackRenouncedAccounts = append(genesis.ClawbackRenouncedAccounts, genesis.ClawbackRenouncedAccounts...)
+			},
+			expError: "duplicate address",
+		},
+		{
+			name:     "invalid gov clawback disabled account",
+			malleate: func(genesis *types.GenesisState) { genesis.GovClawbackDisabledAccounts = []string{"invalid"} },
+			expError: "invalid gov clawback disabled accounts",
+		},
+		{
+			name: "invalid pause time",
+			malleate: func(genesis *types.GenesisState) {
+				genesis.PausedAccounts = []types.PausedAccount{{Address: paused.String()}}
+			},
+			expError: "invalid pause time",
+		},
+		{
+			name: "duplicate schedule amendment",
+			malleate: func(genesis *types.GenesisState) {
+				genesis.ScheduleAmendments = append(genesis.ScheduleAmendments, genesis.ScheduleAmendments...)
+			},
+			expError: "duplicate address",
+		},
+		{
+			name:     "funding instruction id not lower than the next id",
+			malleate: func(genesis *types.GenesisState) { genesis.NextFundingInstructionId = 1 },
+			expError: "is not lower than the next id",
+		},
+		{
+			name: "duplicate funding instruction",
+			malleate: func(genesis *types.GenesisState) {
+				genesis.FundingInstructions = append(genesis.FundingInstructions, genesis.FundingInstructions...)
+			},
+			expError: "duplicate funding instruction",
+		},
+		{
+			name:     "escrow grant id not lower than the next id",
+			malleate: func(genesis *types.GenesisState) { genesis.NextEscrowGrantId = 1 },
+			expError: "is not lower than the next id",
+		},
+		{
+			name: "duplicate escrow grant",
+			malleate: func(genesis *types.GenesisState) {
+				genesis.EscrowGrants = append(genesis.EscrowGrants, genesis.EscrowGrants...)
+			},
+			expError: "duplicate escrow grant",
+		},
+		{
+			name: "escrow grant claimed more than the original amount",
+			malleate: func(genesis *types.GenesisState) {
+				grant := genesis.EscrowGrants[0]
+				grant.Claimed = stakeCoins(2000)
+				genesis.EscrowGrants = []types.EscrowGrant{grant}
+			},
+			expError: "exceed the original amount",
+		},
+		{
+			name:     "next funder committee id not greater than the committees",
+			malleate: func(genesis *types.GenesisState) { genesis.NextFunderCommitteeId = 1 },
+			expError: "must be greater than the number of committees",
+		},
+		{
+			name:     "committee proposal of a missing committee",
+			malleate: func(genesis *types.GenesisState) { genesis.FunderCommittees = nil },
+			expError: "does not exist",
+		},
+		{
+			name:     "committee proposal id not lower than the next id",
+			malleate: func(genesis *types.GenesisState) { genesis.NextCommitteeProposalId = 1 },
+			expError: "is not lower than the next id",
+		},
+	}
+
+	for _, tc := range testCases {
+		genesis := valid
+		tc.malleate(&genesis)
+		suite.Require().ErrorContains(genesis.Validate(), tc.expError, tc.name)
+	}
+}
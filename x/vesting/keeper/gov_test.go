@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/evmos/vesting/x/vesting"
+	"github.com/evmos/vesting/x/vesting/types"
+)
+
+func (suite *KeeperTestSuite) TestGovClawback() {
+	funder := sdk.AccAddress("funder______________")
+	addr := sdk.AccAddress("vesting_account_____")
+
+	suite.createVestingAccount(funder, addr, testLockupPeriods, testVestingPeriods, true)
+	suite.advanceTime(50 * time.Second)
+
+	// a gov v1 proposal executes the messages signed by the gov module account
+	msg := types.NewMsgClawback(suite.keeper.GetAuthority(), addr, nil)
+	suite.Require().NoError(msg.ValidateBasic())
+	suite.Require().Equal([]sdk.AccAddress{authtypes.NewModuleAddress(govtypes.ModuleName)}, msg.GetSigners())
+
+	_, err := suite.keeper.Clawback(suite.ctx, msg)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(sdk.NewDecCoinsFromCoins(stakeCoins(500)...), suite.distrKeeper.GetFeePoolCommunityCoins(suite.ctx))
+	suite.Require().Equal(stakeCoins(500), suite.bankKeeper.GetAllBalances(suite.ctx, addr))
+	suite.Require().True(suite.bankKeeper.GetAllBalances(suite.ctx, funder).IsZero())
+	_, err = suite.keeper.GetClawbackVestingAccount(suite.ctx, addr)
+	suite.Require().ErrorIs(err, types.ErrNotSubjectToClawback)
+}
+
+func (suite *KeeperTestSuite) TestGovClawbackDestination() {
+	funder := sdk.AccAddress("funder______________")
+	addr := sdk.AccAddress("vesting_account_____")
+	other := sdk.AccAddress("other_account_______")
+	dest := sdk.AccAddress("destination_________")
+
+	suite.createVestingAccount(funder, addr, testLockupPeriods, testVestingPeriods, true)
+	suite.createVestingAccount(funder, other, testLockupPeriods, testVestingPeriods, true)
+
+	// the destination address overrides the community pool
+	_, err := suite.keeper.Clawback(suite.ctx, types.NewMsgClawback(suite.authority, addr, dest))
+	suite.Require().NoError(err)
+	suite.Require().Equal(stakeCoins(1000), suite.bankKeeper.GetAllBalances(suite.ctx, dest))
+	suite.Require().True(suite.distrKeeper.GetFeePoolCommunityCoins(suite.ctx).IsZero())
+
+	// module accounts cannot be the destination
+	distrAddr := suite.accountKeeper.GetModuleAddress(distrtypes.ModuleName)
+	_, err = suite.keeper.Clawback(suite.ctx, types.NewMsgClawback(suite.authority, other, distrAddr))
+	suite.Require().ErrorContains(err, "is not allowed to receive funds")
+	suite.Require().Equal(stakeCoins(1000), suite.bankKeeper.GetAllBalances(suite.ctx, other))
+
+	// without a destination address, the community pool is funded
+	_, err = suite.keeper.Clawback(suite.ctx, types.NewMsgClawback(suite.authority, other, nil))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDecCoinsFromCoins(stakeCoins(1000)...), suite.distrKeeper.GetFeePoolCommunityCoins(suite.ctx))
+	suite.Require().Equal(stakeCoins(1000), suite.bankKeeper.GetAllBalances(suite.ctx, dest))
+}
+
+func (suite *KeeperTestSuite) TestClawbackProposalHandler() {
+	funder := sdk.AccAddress("funder______________")
+	addr := sdk.AccAddress("vesting_account_____")
+	other := sdk.AccAddress("other_account_______")
+	dest := sdk.AccAddress("destination_________")
+
+	suite.createVestingAccount(funder, addr, testLockupPeriods, testVestingPeriods, true)
+	suite.createVestingAccount(funder, other, testLockupPeriods, testVestingPeriods, true)
+	handler := vesting.NewVestingProposalHandler(&suite.keeper)
+
+	// the deprecated handler still claws back to the community pool by default
+	err := handler(suite.ctx, types.NewClawbackProposal("title", "description", addr.String(), ""))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDecCoinsFromCoins(stakeCoins(1000)...), suite.distrKeeper.GetFeePoolCommunityCoins(suite.ctx))
+	suite.Require().True(suite.bankKeeper.GetAllBalances(suite.ctx, addr).IsZero())
+
+	err = handler(suite.ctx, types.NewClawbackProposal("title", "description", other.String(), dest.String()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(stakeCoins(1000), suite.bankKeeper.GetAllBalances(suite.ctx, dest))
+
+	err = handler(suite.ctx, types.NewClawbackProposal("title", "description", other.String(), ""))
+	suite.Require().ErrorIs(err, types.ErrNotSubjectToClawback)
+}
@@ -5,9 +5,12 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 	"github.com/evmos/vesting/x/vesting/types"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -49,3 +52,258 @@ func (k Keeper) Balances(
 		Vested:   vested,
 	}, nil
 }
+
+// Params returns the vesting module parameters
+func (k Keeper) Params(
+	goCtx context.Context,
+	_ *types.QueryParamsRequest,
+) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// PausedVestingAccounts returns the clawback vesting accounts whose vesting is
+// paused, with their pause time
+func (k Keeper) PausedVestingAccounts(
+	goCtx context.Context,
+	req *types.QueryPausedVestingAccountsRequest,
+) (*types.QueryPausedVestingAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixVestingPaused)
+
+	var accounts []types.PausedVestingAccount
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		accounts = append(accounts, types.PausedVestingAccount{
+			Address:  sdk.AccAddress(key).String(),
+			PausedAt: int64(sdk.BigEndianToUint64(value)),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPausedVestingAccountsResponse{
+		Accounts:   accounts,
+		Pagination: pageRes,
+	}, nil
+}
+
+// ScheduleAmendment returns the pending schedule amendment of a clawback
+// vesting account
+func (k Keeper) ScheduleAmendment(
+	goCtx context.Context,
+	req *types.QueryScheduleAmendmentRequest,
+) (*types.QueryScheduleAmendmentResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	amendment, found := k.GetScheduleAmendment(ctx, addr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no schedule amendment pending for account %s", req.Address)
+	}
+
+	return &types.QueryScheduleAmendmentResponse{Amendment: amendment}, nil
+}
+
+// FundingInstruction returns the recurring funding instruction with the given
+// id
+func (k Keeper) FundingInstruction(
+	goCtx context.Context,
+	req *types.QueryFundingInstructionRequest,
+) (*types.QueryFundingInstructionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	instruction, found := k.GetFundingInstruction(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "funding instruction %d does not exist", req.Id)
+	}
+
+	return &types.QueryFundingInstructionResponse{Instruction: instruction}, nil
+}
+
+// FundingInstructions returns all recurring funding instructions
+func (k Keeper) FundingInstructions(
+	goCtx context.Context,
+	req *types.QueryFundingInstructionsRequest,
+) (*types.QueryFundingInstructionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFundingInstruction)
+
+	var instructions []types.FundingInstruction
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var instruction types.FundingInstruction
+		if err := k.cdc.Unmarshal(value, &instruction); err != nil {
+			return err
+		}
+		instructions = append(instructions, instruction)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFundingInstructionsResponse{
+		Instructions: instructions,
+		Pagination:   pageRes,
+	}, nil
+}
+
+// EscrowGrant returns the escrow grant with the given id and its claimable
+// coins
+func (k Keeper) EscrowGrant(
+	goCtx context.Context,
+	req *types.QueryEscrowGrantRequest,
+) (*types.QueryEscrowGrantResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	grant, found := k.GetEscrowGrant(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "escrow grant %d does not exist", req.Id)
+	}
+
+	return &types.QueryEscrowGrantResponse{
+		Grant:     grant,
+		Claimable: grant.GetClaimableCoins(ctx.BlockTime()),
+	}, nil
+}
+
+// EscrowGrants returns the escrow grants of a recipient
+func (k Keeper) EscrowGrants(
+	goCtx context.Context,
+	req *types.QueryEscrowGrantsRequest,
+) (*types.QueryEscrowGrantsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.EscrowGrantByRecipientPrefix(addr))
+
+	var grants []types.EscrowGrant
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		grant, found := k.GetEscrowGrant(ctx, sdk.BigEndianToUint64(key))
+		if !found {
+			return fmt.Errorf("escrow grant %d does not exist", sdk.BigEndianToUint64(key))
+		}
+		grants = append(grants, grant)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEscrowGrantsResponse{
+		Grants:     grants,
+		Pagination: pageRes,
+	}, nil
+}
+
+// FunderCommittee returns the funder committee with the given address
+func (k Keeper) FunderCommittee(
+	goCtx context.Context,
+	req *types.QueryFunderCommitteeRequest,
+) (*types.QueryFunderCommitteeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	committee, found := k.GetFunderCommittee(ctx, addr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "funder committee %s does not exist", req.Address)
+	}
+
+	return &types.QueryFunderCommitteeResponse{Committee: committee}, nil
+}
+
+// CommitteeProposal returns the funder committee proposal with the given id
+func (k Keeper) CommitteeProposal(
+	goCtx context.Context,
+	req *types.QueryCommitteeProposalRequest,
+) (*types.QueryCommitteeProposalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	proposal, found := k.GetCommitteeProposal(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "committee proposal %d does not exist", req.Id)
+	}
+
+	return &types.QueryCommitteeProposalResponse{Proposal: proposal}, nil
+}
+
+// CommitteeProposals returns the pending proposals of a funder committee
+func (k Keeper) CommitteeProposals(
+	goCtx context.Context,
+	req *types.QueryCommitteeProposalsRequest,
+) (*types.QueryCommitteeProposalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CommitteeProposalByCommitteePrefix(addr))
+
+	var proposals []types.CommitteeProposal
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		proposal, found := k.GetCommitteeProposal(ctx, sdk.BigEndianToUint64(key))
+		if !found {
+			return fmt.Errorf("committee proposal %d does not exist", sdk.BigEndianToUint64(key))
+		}
+		proposals = append(proposals, proposal)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCommitteeProposalsResponse{
+		Proposals:  proposals,
+		Pagination: pageRes,
+	}, nil
+}
@@ -0,0 +1,29 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"github.com/evmos/vesting/x/vesting/types"
+)
+
+// SetHooks sets the vesting hooks. It panics if the hooks have already been set.
+func (k *Keeper) SetHooks(vh types.VestingHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set vesting hooks twice")
+	}
+
+	k.hooks = vh
+	return k
+}
+
+// Hooks returns the registered vesting hooks, or a no-op implementation if no
+// hooks have been set.
+func (k Keeper) Hooks() types.VestingHooks {
+	if k.hooks == nil {
+		// return a no-op implementation if no hooks are set
+		return types.MultiVestingHooks{}
+	}
+
+	return k.hooks
+}
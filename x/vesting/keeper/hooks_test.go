@@ -0,0 +1,240 @@
+package keeper_test
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/vesting/x/vesting/types"
+)
+
+var _ types.VestingHooks = &mockHooks{}
+
+// mockHooks records the calls of the vesting hooks and returns an error for
+// the hook named in fail.
+type mockHooks struct {
+	calls []string
+	fail  string
+	// onCall is called before a hook returns, to check the state at the time
+	// of the call
+	onCall func(ctx sdk.Context, hook string)
+}
+
+func (h *mockHooks) call(ctx sdk.Context, hook, args string) error {
+	h.calls = append(h.calls, fmt.Sprintf("%s(%s)", hook, args))
+	if h.onCall != nil {
+		h.onCall(ctx, hook)
+	}
+	if h.fail == hook {
+		return errors.New("hook failed")
+	}
+	return nil
+}
+
+func (h *mockHooks) AfterVestingAccountCreated(ctx sdk.Context, vestingAddr, funderAddr sdk.AccAddress) error {
+	return h.call(ctx, "created", fmt.Sprintf("%s,%s", vestingAddr, funderAddr))
+}
+
+func (h *mockHooks) AfterVestingAccountFunded(ctx sdk.Context, vestingAddr, funderAddr sdk.AccAddress, coins sdk.Coins) error {
+	return h.call(ctx, "funded", fmt.Sprintf("%s,%s,%s", vestingAddr, funderAddr, coins))
+}
+
+func (h *mockHooks) AfterClawback(ctx sdk.Context, vestingAddr, destAddr sdk.AccAddress, clawedBack sdk.Coins) error {
+	return h.call(ctx, "clawback", fmt.Sprintf("%s,%s,%s", vestingAddr, destAddr, clawedBack))
+}
+
+func (h *mockHooks) AfterFunderUpdated(ctx sdk.Context, vestingAddr, oldFunderAddr, newFunderAddr sdk.AccAddress) error {
+	return h.call(ctx, "funder_updated", fmt.Sprintf("%s,%s,%s", vestingAddr, oldFunderAddr, newFunderAddr))
+}
+
+func (h *mockHooks) AfterVestingAccountConverted(ctx sdk.Context, vestingAddr sdk.AccAddress) error {
+	return h.call(ctx, "converted", vestingAddr.String())
+}
+
+func (suite *KeeperTestSuite) TestHooksInvocationOrder() {
+	hooks := &mockHooks{}
+	suite.keeper.SetHooks(hooks)
+
+	funder := sdk.AccAddress("funder______________")
+	newFunder := sdk.AccAddress("new_funder__________")
+	addr := sdk.AccAddress("vesting_account_____")
+	other := sdk.AccAddress("other_account_______")
+
+	// the hooks are called after the state changes they notify
+	hooks.onCall = func(ctx sdk.Context, hook string) {
+		switch hook {
+		case "created":
+			_, err := suite.keeper.GetClawbackVestingAccount(ctx, addr)
+			suite.Require().NoError(err)
+		case "funded":
+			suite.Require().Equal(stakeCoins(1000), suite.bankKeeper.GetAllBalances(ctx, addr))
+		case "clawback":
+			suite.Require().Equal(stakeCoins(500), suite.bankKeeper.GetAllBalances(ctx, newFunder))
+		}
+	}
+
+	suite.createVestingAccount(funder, addr, testLockupPeriods, testVestingPeriods, true)
+
+	_, err := suite.keeper.UpdateVestingFunder(suite.ctx, types.NewMsgUpdateVestingFunder(funder, newFunder, addr))
+	suite.Require().NoError(err)
+
+	suite.advanceTime(50 * time.Second)
+	_, err = suite.keeper.Clawback(suite.ctx, types.NewMsgClawback(newFunder, addr, nil))
+	suite.Require().NoError(err)
+
+	suite.Require().Equal([]string{
+		fmt.Sprintf("created(%s,%s)", addr, funder),
+		fmt.Sprintf("funded(%s,%s,1000stake)", addr, funder),
+		fmt.Sprintf("funder_updated(%s,%s,%s)", addr, funder, newFunder),
+		fmt.Sprintf("clawback(%s,%s,500stake)", addr, newFunder),
+		fmt.Sprintf("converted(%s)", addr),
+	}, hooks.calls)
+
+	// the conversion of a completed account
+	hooks.calls = nil
+	hooks.onCall = func(ctx sdk.Context, hook string) {
+		if hook == "converted" {
+			_, err := suite.keeper.GetClawbackVestingAccount(ctx, other)
+			suite.Require().ErrorIs(err, types.ErrNotSubjectToClawback)
+		}
+	}
+	suite.createVestingAccount(funder, other, testLockupPeriods, testVestingPeriods, true)
+	suite.advanceTime(100 * time.Second)
+	_, err = suite.keeper.ConvertVestingAccount(suite.ctx, types.NewMsgConvertVestingAccount(other))
+	suite.Require().NoError(err)
+	suite.Require().Equal(fmt.Sprintf("converted(%s)", other), hooks.calls[len(hooks.calls)-1])
+}
+
+func (suite *KeeperTestSuite) TestHooksOfOtherFundingPaths() {
+	hooks := &mockHooks{}
+	suite.keeper.SetHooks(hooks)
+
+	funder := sdk.AccAddress("funder______________")
+	manager := sdk.AccAddress("manager_____________")
+	addr := sdk.AccAddress("vesting_account_____")
+	recipient := sdk.AccAddress("recipient___________")
+
+	// a self-lockup funds the account with itself as the funder
+	suite.fundAccount(addr, stakeCoins(1000))
+	_, err := suite.keeper.SelfLockup(suite.ctx, types.NewMsgSelfLockup(addr, testLockupPeriods))
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{
+		fmt.Sprintf("created(%s,%s)", addr, addr),
+		fmt.Sprintf("funded(%s,%s,1000stake)", addr, addr),
+	}, hooks.calls)
+
+	// escrow grants and manager updates don't call any hook
+	hooks.calls = nil
+	suite.fundAccount(funder, stakeCoins(1000))
+	_, err = suite.keeper.CreateEscrowGrant(suite.ctx, types.NewMsgCreateEscrowGrant(
+		funder, recipient, suite.ctx.BlockTime(), nil, testVestingPeriods,
+	))
+	suite.Require().NoError(err)
+	_, err = suite.keeper.SetVestingManager(suite.ctx, types.NewMsgSetVestingManager(addr, addr, manager))
+	suite.Require().NoError(err)
+	suite.Require().Empty(hooks.calls)
+}
+
+func (suite *KeeperTestSuite) TestHooksErrorPropagation() {
+	funder := sdk.AccAddress("funder______________")
+	addr := sdk.AccAddress("vesting_account_____")
+
+	testCases := []struct {
+		name string
+		hook string
+		run  func() error
+	}{
+		{
+			name: "created",
+			hook: "created",
+			run: func() error {
+				suite.accountKeeper.SetAccount(suite.ctx, suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr))
+				_, err := suite.keeper.CreateClawbackVestingAccount(suite.ctx, types.NewMsgCreateClawbackVestingAccount(funder, addr, true))
+				return err
+			},
+		},
+		{
+			name: "funded",
+			hook: "funded",
+			run: func() error {
+				suite.accountKeeper.SetAccount(suite.ctx, suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr))
+				_, err := suite.keeper.CreateClawbackVestingAccount(suite.ctx, types.NewMsgCreateClawbackVestingAccount(funder, addr, true))
+				suite.Require().NoError(err)
+				suite.fundAccount(funder, stakeCoins(1000))
+				_, err = suite.keeper.FundVestingAccount(suite.ctx, types.NewMsgFundVestingAccount(
+					funder, addr, suite.ctx.BlockTime(), testLockupPeriods, testVestingPeriods,
+				))
+				return err
+			},
+		},
+		{
+			name: "clawback",
+			hook: "clawback",
+			run: func() error {
+				suite.createVestingAccount(funder, addr, testLockupPeriods, testVestingPeriods, true)
+				_, err := suite.keeper.Clawback(suite.ctx, types.NewMsgClawback(funder, addr, nil))
+				return err
+			},
+		},
+		{
+			name: "funder updated",
+			hook: "funder_updated",
+			run: func() error {
+				suite.createVestingAccount(funder, addr, testLockupPeriods, testVestingPeriods, true)
+				_, err := suite.keeper.UpdateVestingFunder(suite.ctx, types.NewMsgUpdateVestingFunder(funder, sdk.AccAddress("new_funder__________"), addr))
+				return err
+			},
+		},
+		{
+			name: "converted by clawback",
+			hook: "converted",
+			run: func() error {
+				suite.createVestingAccount(funder, addr, testLockupPeriods, testVestingPeriods, true)
+				_, err := suite.keeper.Clawback(suite.ctx, types.NewMsgClawback(funder, addr, nil))
+				return err
+			},
+		},
+		{
+			name: "converted",
+			hook: "converted",
+			run: func() error {
+				suite.createVestingAccount(funder, addr, testLockupPeriods, testVestingPeriods, true)
+				suite.advanceTime(100 * time.Second)
+				_, err := suite.keeper.ConvertVestingAccount(suite.ctx, types.NewMsgConvertVestingAccount(addr))
+				return err
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.keeper.SetHooks(&mockHooks{fail: tc.hook})
+			suite.Require().ErrorContains(tc.run(), "hook failed")
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestHooksErrorRetriesConversion() {
+	hooks := &mockHooks{fail: "converted"}
+	suite.keeper.SetHooks(hooks)
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, types.NewParams(true, 10, 10, 10)))
+
+	funder := sdk.AccAddress("funder______________")
+	addr := sdk.AccAddress("vesting_account_____")
+	va := suite.createVestingAccount(funder, addr, testLockupPeriods, testVestingPeriods, true)
+
+	// the failed conversion is reverted and the index entry is kept
+	suite.advanceTime(100 * time.Second)
+	suite.Require().Empty(eventsOfType(suite.endBlock(), types.EventTypeConvertVestingAccount))
+	suite.getVestingAccount(addr)
+	suite.Require().Equal([]sdk.AccAddress{addr}, suite.endTimeIndexEntries(va.EndTime))
+
+	// the conversion is retried in the next block
+	hooks.fail = ""
+	suite.advanceTime(time.Second)
+	suite.Require().Len(eventsOfType(suite.endBlock(), types.EventTypeConvertVestingAccount), 1)
+	suite.Require().Empty(suite.endTimeIndexEntries(va.EndTime))
+}
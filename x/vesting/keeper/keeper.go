@@ -23,11 +23,19 @@ type Keeper struct {
 	stakingKeeper      types.StakingKeeper
 	distributionKeeper types.DistributionKeeper
 
+	// accountConverter wraps and unwraps the chain's default account type
+	accountConverter types.AccountConverter
+
+	// hooks are notified of changes to clawback vesting accounts
+	hooks types.VestingHooks
+
 	// The x/gov module account used for executing transaction by governance.
 	authority sdk.AccAddress
 }
 
-// NewKeeper creates new instances of the vesting Keeper
+// NewKeeper creates new instances of the vesting Keeper. The account converter
+// is used to convert the chain's default account type into vesting accounts and
+// back. If nil, the x/auth BaseAccount is used as the default account type.
 func NewKeeper(
 	storeKey storetypes.StoreKey,
 	authority sdk.AccAddress,
@@ -36,12 +44,17 @@ func NewKeeper(
 	bk types.BankKeeper,
 	dk types.DistributionKeeper,
 	sk types.StakingKeeper,
+	ac types.AccountConverter,
 ) Keeper {
 	// ensure gov module account is set and is not nil
 	if err := sdk.VerifyAddressFormat(authority); err != nil {
 		panic(err)
 	}
 
+	if ac == nil {
+		ac = types.BaseAccountConverter{}
+	}
+
 	return Keeper{
 		storeKey:           storeKey,
 		authority:          authority,
@@ -50,9 +63,16 @@ func NewKeeper(
 		accountKeeper:      ak,
 		bankKeeper:         bk,
 		stakingKeeper:      sk,
+		accountConverter:   ac,
 	}
 }
 
+// GetAuthority returns the x/gov module account address used for executing
+// authority-gated messages.
+func (k Keeper) GetAuthority() sdk.AccAddress {
+	return k.authority
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
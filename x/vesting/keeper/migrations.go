@@ -6,9 +6,13 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/evmos/vesting/x/vesting/migrations/v2"
+	"github.com/evmos/vesting/x/vesting/migrations/v3"
 )
 
-var _ module.MigrationHandler = Migrator{}.Migrate1to2
+var (
+	_ module.MigrationHandler = Migrator{}.Migrate1to2
+	_ module.MigrationHandler = Migrator{}.Migrate2to3
+)
 
 // Migrator is a struct for handling in-place store migrations.
 type Migrator struct {
@@ -26,3 +30,8 @@ func NewMigrator(keeper Keeper) Migrator {
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return v2.MigrateStore(ctx, m.keeper.accountKeeper)
 }
+
+// Migrate2to3 migrates the store from consensus version 2 to 3
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.MigrateStore(ctx, m.keeper)
+}
@@ -0,0 +1,48 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/vesting/x/vesting/keeper"
+	"github.com/evmos/vesting/x/vesting/types"
+)
+
+func (suite *KeeperTestSuite) TestMigrate2to3() {
+	funder := sdk.AccAddress("funder______________")
+	addr := sdk.AccAddress("vesting_account_____")
+	va := suite.createVestingAccount(funder, addr, testLockupPeriods, testVestingPeriods, true)
+
+	// remove the entries that didn't exist in the v2 store
+	suite.keeper.DeleteVestingIndexes(suite.ctx, va)
+	suite.ctx.KVStore(suite.keys[types.StoreKey]).Delete(types.KeyPrefixParams)
+
+	suite.Require().NoError(keeper.NewMigrator(suite.keeper).Migrate2to3(suite.ctx))
+
+	suite.Require().Equal(types.DefaultParams(), suite.keeper.GetParams(suite.ctx))
+	suite.Require().Equal([]sdk.AccAddress{addr}, suite.endTimeIndexEntries(va.EndTime))
+
+	// only the upcoming periods are indexed
+	suite.advanceTime(100 * time.Second)
+	suite.Require().Len(scheduleEvents(suite.endBlock(), addr), 3)
+}
+
+func (suite *KeeperTestSuite) TestMigrate2to3SkipsPastEvents() {
+	funder := sdk.AccAddress("funder______________")
+	addr := sdk.AccAddress("vesting_account_____")
+	va := suite.createVestingAccount(funder, addr, testLockupPeriods, testVestingPeriods, true)
+	suite.keeper.DeleteVestingIndexes(suite.ctx, va)
+
+	// the first vesting period ends before the migration
+	suite.advanceTime(60 * time.Second)
+	suite.Require().NoError(keeper.NewMigrator(suite.keeper).Migrate2to3(suite.ctx))
+
+	suite.Require().Empty(scheduleEvents(suite.endBlock(), addr))
+
+	suite.advanceTime(40 * time.Second)
+	suite.Require().ElementsMatch(
+		[]scheduleEvent{{types.EventTypeUnlocked, "0", "1000stake"}, {types.EventTypeVested, "1", "500stake"}},
+		scheduleEvents(suite.endBlock(), addr),
+	)
+}
@@ -7,16 +7,19 @@ import (
 	"context"
 	"fmt"
 	"github.com/evmos/vesting/x/vesting/types"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
-	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 var _ types.MsgServer = &Keeper{}
@@ -60,10 +63,9 @@ func (k Keeper) CreateClawbackVestingAccount(
 		)
 	}
 
-	baseVestingAcc := &sdkvesting.BaseVestingAccount{BaseAccount: acc.(*authtypes.BaseAccount)}
-	vestingAcc := &types.ClawbackVestingAccount{
-		BaseVestingAccount: baseVestingAcc,
-		FunderAddress:      funderAddress.String(),
+	vestingAcc, err := k.newClawbackVestingAccount(ctx, acc, funderAddress)
+	if err != nil {
+		return nil, err
 	}
 	ak.SetAccount(ctx, vestingAcc)
 
@@ -71,6 +73,10 @@ func (k Keeper) CreateClawbackVestingAccount(
 		k.SetGovClawbackDisabled(ctx, vestingAcc.GetAddress())
 	}
 
+	if err = k.Hooks().AfterVestingAccountCreated(ctx, vestingAddress, funderAddress); err != nil {
+		return nil, err
+	}
+
 	telemetry.IncrCounter(
 		float32(ctx.GasMeter().GasConsumed()),
 		"tx", "create_clawback_vesting_account", "gas_used",
@@ -90,7 +96,7 @@ func (k Keeper) CreateClawbackVestingAccount(
 }
 
 // FundVestingAccount funds a ClawbackVestingAccount with the provided amount.
-// This can only be executed by the funder of the vesting account.
+// This can only be executed by the funder or the manager of the vesting account.
 //
 // Checks performed on the ValidateBasic include:
 //   - funder and vesting addresses are correct bech32 format
@@ -119,43 +125,38 @@ func (k Keeper) FundVestingAccount(goCtx context.Context, msg *types.MsgFundVest
 		return nil, err
 	}
 
-	vestingCoins := msg.VestingPeriods.TotalAmount()
-	lockupCoins := msg.LockupPeriods.TotalAmount()
+	var vestingCoins sdk.Coins
+	msg.LockupPeriods, msg.VestingPeriods, vestingCoins = defaultGrantSchedule(msg.LockupPeriods, msg.VestingPeriods)
 
 	fmt.Println("vestingCoins: ", vestingCoins)
-	fmt.Println("lockupCoins: ", lockupCoins)
 
-	// If lockup absent, default to an instant unlock schedule
-	if !vestingCoins.IsZero() && len(msg.LockupPeriods) == 0 {
-		msg.LockupPeriods = sdkvesting.Periods{
-			{Length: 0, Amount: vestingCoins},
-		}
-		lockupCoins = vestingCoins
+	if !vestingAcc.IsFunderOrManager(msg.FunderAddress) {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "account %s can only accept grants from account %s or its manager", msg.VestingAddress, vestingAcc.FunderAddress)
 	}
 
-	// If vesting absent, default to an instant vesting schedule
-	if !lockupCoins.IsZero() && len(msg.VestingPeriods) == 0 {
-		msg.VestingPeriods = sdkvesting.Periods{
-			{Length: 0, Amount: lockupCoins},
-		}
-		vestingCoins = lockupCoins
+	if vestingAcc.IsVestingPaused() {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "vesting of account %s is paused", msg.VestingAddress)
 	}
 
-	if msg.FunderAddress != vestingAcc.FunderAddress {
-		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "account %s can only accept grants from account %s", msg.VestingAddress, vestingAcc.FunderAddress)
-	}
+	// the schedule of the account changes with the new grant
+	k.deleteVestingIndexes(ctx, vestingAcc)
 
 	err = k.addGrant(ctx, vestingAcc, msg.GetStartTime().Unix(), msg.GetLockupPeriods(), msg.GetVestingPeriods(), vestingCoins)
 	if err != nil {
 		return nil, err
 	}
 	ak.SetAccount(ctx, vestingAcc)
+	k.setVestingIndexes(ctx, vestingAcc)
 
 	// Send coins from the funder to vesting account
 	if err = bk.SendCoins(ctx, funderAddr, vestingAddr, vestingCoins); err != nil {
 		return nil, err
 	}
 
+	if err = k.Hooks().AfterVestingAccountFunded(ctx, vestingAddr, funderAddr, vestingCoins); err != nil {
+		return nil, err
+	}
+
 	telemetry.IncrCounter(
 		float32(ctx.GasMeter().GasConsumed()),
 		"tx", "fund_vesting_account", "gas_used",
@@ -177,6 +178,10 @@ func (k Keeper) FundVestingAccount(goCtx context.Context, msg *types.MsgFundVest
 
 // Clawback removes the unvested amount from a ClawbackVestingAccount.
 // The destination defaults to the funder address, but can be overridden.
+// For governance clawbacks, the destination defaults to the community pool.
+// Alternatively, the unvested amount can be burned.
+// When the clawback is requested by the manager of the account, the proceeds
+// always go to the funder.
 //
 // Checks performed on the ValidateBasic include:
 //   - funder and vesting addresses are correct bech32 format
@@ -186,75 +191,117 @@ func (k Keeper) Clawback(
 	msg *types.MsgClawback,
 ) (*types.MsgClawbackResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	ak := k.accountKeeper
-	bk := k.bankKeeper
 
-	// NOTE: ignore error in case dest address is not defined
-	dest, _ := sdk.AccAddressFromBech32(msg.DestAddress)
+	if _, err := k.clawback(ctx, msg); err != nil {
+		return nil, err
+	}
 
-	// NOTE: error checked during msg validation
-	addr := sdk.MustAccAddressFromBech32(msg.AccountAddress)
+	telemetry.IncrCounter(
+		float32(ctx.GasMeter().GasConsumed()),
+		"tx", "clawback", "gas_used",
+	)
 
-	// Default destination to funder address
-	if msg.DestAddress == "" {
-		dest = sdk.MustAccAddressFromBech32(msg.FunderAddress)
-	}
+	return &types.MsgClawbackResponse{}, nil
+}
 
-	if k.authority.String() != msg.FunderAddress && bk.BlockedAddr(dest) {
-		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized,
-			"account is not allowed to receive funds: %s", msg.DestAddress,
-		)
+// BatchClawback removes the unvested amount from multiple
+// ClawbackVestingAccounts. This can only be executed by the governance module
+// account. In best effort mode, the accounts whose clawback fails are skipped,
+// otherwise the first failure reverts the clawback of all accounts.
+//
+// Checks performed on the ValidateBasic include:
+//   - authority and account addresses are correct bech32 format
+//   - account addresses are not empty nor duplicated
+//   - if destination address is not empty it is also correct bech32 format
+func (k Keeper) BatchClawback(
+	goCtx context.Context,
+	msg *types.MsgBatchClawback,
+) (*types.MsgBatchClawbackResponse, error) {
+	if k.authority.String() != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), msg.Authority)
 	}
 
-	// Get clawback vesting account
-	va, err := k.GetClawbackVestingAccount(ctx, addr)
-	if err != nil {
-		return nil, err
-	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	// Check if account has any vesting or lockup periods
-	if len(va.VestingPeriods) == 0 && len(va.LockupPeriods) == 0 {
-		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "account %s has no vesting or lockup periods", msg.AccountAddress)
-	}
+	var (
+		total     sdk.Coins
+		succeeded uint64
+		failed    uint64
+	)
 
-	// Check to see if it's a governance proposal clawback
-	if k.authority.String() == msg.FunderAddress {
-		if k.HasGovClawbackDisabled(ctx, addr) {
-			return nil, errorsmod.Wrap(types.ErrNotSubjectToGovClawback, addr.String())
+	// the state changes of the batch are only written once all the accounts
+	// are processed, so that a failure reverts the clawback of all accounts
+	batchCtx, writeBatch := ctx.CacheContext()
+
+	results := make([]types.ClawbackResult, 0, len(msg.AccountAddresses))
+	for _, address := range msg.AccountAddresses {
+		clawbackMsg := &types.MsgClawback{
+			FunderAddress:  msg.Authority,
+			AccountAddress: address,
+			DestAddress:    msg.DestAddress,
+			Burn:           msg.Burn,
 		}
 
-		dest = ak.GetModuleAddress(distributiontypes.ModuleName)
+		if !msg.BestEffort {
+			clawedBack, err := k.clawback(batchCtx, clawbackMsg)
+			if err != nil {
+				return nil, errorsmod.Wrapf(err, "failed to clawback account %s", address)
+			}
 
-		// Check if account funder is same as in msg
-	} else if va.FunderAddress != msg.FunderAddress {
-		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized, "clawback can only be requested by original funder: %s", va.FunderAddress)
+			total = total.Add(clawedBack...)
+			succeeded++
+			results = append(results, types.ClawbackResult{AccountAddress: address, ClawedBack: clawedBack})
+			continue
+		}
+
+		// write the state changes of the clawback only if it succeeds
+		cacheCtx, writeCache := batchCtx.CacheContext()
+		clawedBack, err := k.clawback(cacheCtx, clawbackMsg)
+		if err != nil {
+			failed++
+			results = append(results, types.ClawbackResult{AccountAddress: address, Error: err.Error()})
+			continue
+		}
+
+		writeCache()
+		total = total.Add(clawedBack...)
+		succeeded++
+		results = append(results, types.ClawbackResult{AccountAddress: address, ClawedBack: clawedBack})
 	}
 
-	// Perform clawback transfer
-	if err := k.transferClawback(ctx, *va, dest); err != nil {
-		return nil, err
+	writeBatch()
+
+	dest := msg.DestAddress
+	switch {
+	case msg.Burn:
+		dest = k.accountKeeper.GetModuleAddress(types.ModuleName).String()
+	case dest == "":
+		dest = k.accountKeeper.GetModuleAddress(distributiontypes.ModuleName).String()
 	}
 
 	telemetry.IncrCounter(
 		float32(ctx.GasMeter().GasConsumed()),
-		"tx", "clawback", "gas_used",
+		"tx", "batch_clawback", "gas_used",
 	)
 
 	ctx.EventManager().EmitEvents(
 		sdk.Events{
 			sdk.NewEvent(
-				types.EventTypeClawback,
-				sdk.NewAttribute(types.AttributeKeyFunder, msg.FunderAddress),
-				sdk.NewAttribute(types.AttributeKeyAccount, msg.AccountAddress),
-				sdk.NewAttribute(types.AttributeKeyDestination, dest.String()),
+				types.EventTypeBatchClawback,
+				sdk.NewAttribute(types.AttributeKeyDestination, dest),
+				sdk.NewAttribute(types.AttributeKeyCoins, total.String()),
+				sdk.NewAttribute(types.AttributeKeySucceeded, strconv.FormatUint(succeeded, 10)),
+				sdk.NewAttribute(types.AttributeKeyFailed, strconv.FormatUint(failed, 10)),
 			),
 		},
 	)
 
-	return &types.MsgClawbackResponse{}, nil
+	return &types.MsgBatchClawbackResponse{Results: results}, nil
 }
 
 // UpdateVestingFunder updates the funder account of a ClawbackVestingAccount.
+// This can only be executed by the current funder, and clears the manager of
+// the account.
 //
 // Checks performed on the ValidateBasic include:
 //   - new funder and vesting addresses are correct bech32 format
@@ -293,8 +340,14 @@ func (k Keeper) UpdateVestingFunder(
 
 	// Perform clawback account update
 	va.FunderAddress = msg.NewFunderAddress
+	va.ManagerAddress = ""
 	ak.SetAccount(ctx, va)
 
+	oldFunder := sdk.MustAccAddressFromBech32(msg.FunderAddress)
+	if err = k.Hooks().AfterFunderUpdated(ctx, vesting, oldFunder, newFunder); err != nil {
+		return nil, err
+	}
+
 	telemetry.IncrCounter(
 		float32(ctx.GasMeter().GasConsumed()),
 		"tx", "update_vesting_funder", "gas_used",
@@ -314,131 +367,1744 @@ func (k Keeper) UpdateVestingFunder(
 	return &types.MsgUpdateVestingFunderResponse{}, nil
 }
 
-// ConvertVestingAccount converts a ClawbackVestingAccount to the default chain account
-// after its lockup and vesting periods have concluded.
-func (k Keeper) ConvertVestingAccount(
+// SetVestingManager sets or clears the manager of a ClawbackVestingAccount.
+// The manager can fund and perform clawback on behalf of the funder. No
+// vesting hook is called, as the funder of the account is unchanged.
+//
+// Checks performed on the ValidateBasic include:
+//   - funder and vesting addresses are correct bech32 format
+//   - if manager address is not empty it is also correct bech32 format
+//   - manager address is not the same as the funder address
+func (k Keeper) SetVestingManager(
 	goCtx context.Context,
-	msg *types.MsgConvertVestingAccount,
-) (*types.MsgConvertVestingAccountResponse, error) {
+	msg *types.MsgSetVestingManager,
+) (*types.MsgSetVestingManagerResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	address := sdk.MustAccAddressFromBech32(msg.VestingAddress)
 
-	vestingAcc, err := k.GetClawbackVestingAccount(ctx, address)
+	// NOTE: errors checked during msg validation
+	vesting := sdk.MustAccAddressFromBech32(msg.VestingAddress)
+
+	// Check if vesting account exists
+	va, err := k.GetClawbackVestingAccount(ctx, vesting)
 	if err != nil {
 		return nil, err
 	}
 
-	// check if account has any vesting coins left
-	if !vestingAcc.GetVestingCoins(ctx.BlockTime()).IsZero() {
-		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "vesting coins still left in account: %s", msg.VestingAddress)
+	// Check if current funder is same as in msg
+	if va.FunderAddress != msg.FunderAddress {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized, "%s is not the current funder and cannot set the manager address", msg.FunderAddress)
 	}
 
-	// check if account has any locked up coins left
-	if vestingAcc.HasLockedCoins(ctx.BlockTime()) {
-		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "locked up coins still left in account: %s", msg.VestingAddress)
-	}
+	va.ManagerAddress = msg.ManagerAddress
+	k.accountKeeper.SetAccount(ctx, va)
 
-	// if gov clawback is disabled, remove the entry from the store.
-	// if no entry is found for the address, this will no-op
-	k.DeleteGovClawbackDisabled(ctx, address)
+	telemetry.IncrCounter(
+		float32(ctx.GasMeter().GasConsumed()),
+		"tx", "set_vesting_manager", "gas_used",
+	)
 
-	k.accountKeeper.SetAccount(ctx, vestingAcc.BaseAccount)
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeSetVestingManager,
+				sdk.NewAttribute(types.AttributeKeyFunder, msg.FunderAddress),
+				sdk.NewAttribute(types.AttributeKeyAccount, msg.VestingAddress),
+				sdk.NewAttribute(types.AttributeKeyManager, msg.ManagerAddress),
+			),
+		},
+	)
 
-	return &types.MsgConvertVestingAccountResponse{}, nil
+	return &types.MsgSetVestingManagerResponse{}, nil
 }
 
-// addGrant merges a new clawback vesting grant into an existing
-// ClawbackVestingAccount.
-func (k Keeper) addGrant(
-	ctx sdk.Context,
-	va *types.ClawbackVestingAccount,
-	grantStartTime int64,
-	grantLockupPeriods, grantVestingPeriods sdkvesting.Periods,
-	grantCoins sdk.Coins,
-) error {
-	// check if the clawback vesting account has only been initialized and not yet funded --
-	// in that case it's necessary to update the vesting account with the given start time because this is set to zero in the initialization
-	if len(va.LockupPeriods) == 0 && len(va.VestingPeriods) == 0 {
-		va.StartTime = time.Unix(grantStartTime, 0).UTC()
+// RenounceClawback permanently waives the clawback rights of the funder (and
+// manager) of a ClawbackVestingAccount, and optionally the governance clawback.
+// This can only be executed by the funder of the vesting account. The vesting
+// and lockup schedules of the account are not modified.
+//
+// Checks performed on the ValidateBasic include:
+//   - funder and vesting addresses are correct bech32 format
+func (k Keeper) RenounceClawback(
+	goCtx context.Context,
+	msg *types.MsgRenounceClawback,
+) (*types.MsgRenounceClawbackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// NOTE: errors checked during msg validation
+	vesting := sdk.MustAccAddressFromBech32(msg.VestingAddress)
+
+	// Check if vesting account exists
+	va, err := k.GetClawbackVestingAccount(ctx, vesting)
+	if err != nil {
+		return nil, err
 	}
 
-	// how much is really delegated?
-	bondedAmt := k.stakingKeeper.GetDelegatorBonded(ctx, va.GetAddress())
-	unbondingAmt := k.stakingKeeper.GetDelegatorUnbonding(ctx, va.GetAddress())
-	delegatedAmt := bondedAmt.Add(unbondingAmt)
-	delegated := sdk.NewCoins(sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), delegatedAmt))
+	// Check if current funder is same as in msg
+	if va.FunderAddress != msg.FunderAddress {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized, "%s is not the current funder and cannot renounce the clawback", msg.FunderAddress)
+	}
 
-	// modify schedules for the new grant
-	newLockupStart, newLockupEnd, newLockupPeriods := types.DisjunctPeriods(va.GetStartTime(), grantStartTime, va.LockupPeriods, grantLockupPeriods)
-	newVestingStart, newVestingEnd, newVestingPeriods := types.DisjunctPeriods(
-		va.GetStartTime(),
-		grantStartTime,
-		va.GetVestingPeriods(),
-		grantVestingPeriods,
+	renounced := k.HasClawbackRenounced(ctx, vesting)
+	govClawbackDisabled := k.HasGovClawbackDisabled(ctx, vesting)
+	if renounced && (!msg.RenounceGovClawback || govClawbackDisabled) {
+		return nil, errorsmod.Wrapf(types.ErrClawbackRenounced, "clawback of %s is already renounced", msg.VestingAddress)
+	}
+
+	k.SetClawbackRenounced(ctx, vesting)
+	if msg.RenounceGovClawback {
+		k.SetGovClawbackDisabled(ctx, vesting)
+	}
+
+	telemetry.IncrCounter(
+		float32(ctx.GasMeter().GasConsumed()),
+		"tx", "renounce_clawback", "gas_used",
 	)
 
-	if newLockupStart != newVestingStart {
-		return errorsmod.Wrapf(
-			types.ErrVestingLockup,
-			"vesting start time calculation should match lockup start (%d ≠ %d)",
-			newVestingStart, newLockupStart,
-		)
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeRenounceClawback,
+				sdk.NewAttribute(types.AttributeKeyFunder, msg.FunderAddress),
+				sdk.NewAttribute(types.AttributeKeyAccount, msg.VestingAddress),
+				sdk.NewAttribute(types.AttributeKeyGovClawback, strconv.FormatBool(msg.RenounceGovClawback)),
+			),
+		},
+	)
+
+	return &types.MsgRenounceClawbackResponse{}, nil
+}
+
+// PauseVesting pauses the vesting clock of a ClawbackVestingAccount. While
+// paused, no coins vest; the lockup schedule is not affected. This can only be
+// executed by the governance module account.
+//
+// Checks performed on the ValidateBasic include:
+//   - authority and vesting addresses are correct bech32 format
+func (k Keeper) PauseVesting(
+	goCtx context.Context,
+	msg *types.MsgPauseVesting,
+) (*types.MsgPauseVestingResponse, error) {
+	if k.authority.String() != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), msg.Authority)
 	}
 
-	va.StartTime = time.Unix(newLockupStart, 0).UTC()
-	va.EndTime = types.Max64(newLockupEnd, newVestingEnd)
-	va.LockupPeriods = newLockupPeriods
-	va.VestingPeriods = newVestingPeriods
-	va.OriginalVesting = va.OriginalVesting.Add(grantCoins...)
+	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	// cap DV at the current unvested amount, DF rounds out to current delegated
-	unvested := va.GetVestingCoins(ctx.BlockTime())
-	va.DelegatedVesting = delegated.Min(unvested)
-	va.DelegatedFree = delegated.Sub(va.DelegatedVesting...)
-	return nil
+	// NOTE: errors checked during msg validation
+	vesting := sdk.MustAccAddressFromBech32(msg.VestingAddress)
+
+	va, err := k.GetClawbackVestingAccount(ctx, vesting)
+	if err != nil {
+		return nil, err
+	}
+
+	if va.IsVestingPaused() {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "vesting of account %s is already paused", msg.VestingAddress)
+	}
+
+	if va.GetVestingCoins(ctx.BlockTime()).IsZero() {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "account %s has no unvested coins", msg.VestingAddress)
+	}
+
+	pausedAt := ctx.BlockTime().Unix()
+
+	// the pause removes the upcoming vesting events
+	k.deleteVestingIndexes(ctx, va)
+	va.VestingPausedAt = pausedAt
+	k.accountKeeper.SetAccount(ctx, va)
+	k.setVestingIndexes(ctx, va)
+	k.SetVestingPaused(ctx, vesting, pausedAt)
+
+	telemetry.IncrCounter(
+		float32(ctx.GasMeter().GasConsumed()),
+		"tx", "pause_vesting", "gas_used",
+	)
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypePauseVesting,
+				sdk.NewAttribute(types.AttributeKeyAccount, msg.VestingAddress),
+				sdk.NewAttribute(types.AttributeKeyPausedAt, strconv.FormatInt(pausedAt, 10)),
+			),
+		},
+	)
+
+	return &types.MsgPauseVestingResponse{}, nil
 }
 
-// transferClawback transfers unvested tokens in a ClawbackVestingAccount to
-// the destination address. Then, it updates the lockup schedule, removes future
-// vesting events and deletes the store entry for governance clawback if it exists.
-func (k Keeper) transferClawback(
-	ctx sdk.Context,
-	vestingAccount types.ClawbackVestingAccount,
-	destinationAddr sdk.AccAddress,
-) error {
-	// Compute clawback amount, unlock unvested tokens and remove future vesting events
-	updatedAcc, toClawBack := vestingAccount.ComputeClawback(ctx.BlockTime().Unix())
-	// Returns an error if there is nothing to clawback (e.g. all tokens are vested)
-	if toClawBack.IsZero() {
-		return errorsmod.Wrapf(types.ErrNothingToClawback, "account %s", vestingAccount.GetAddress())
+// ResumeVesting resumes the paused vesting clock of a ClawbackVestingAccount.
+// The upcoming vesting events are shifted by the pause duration. This can only
+// be executed by the governance module account.
+//
+// Checks performed on the ValidateBasic include:
+//   - authority and vesting addresses are correct bech32 format
+func (k Keeper) ResumeVesting(
+	goCtx context.Context,
+	msg *types.MsgResumeVesting,
+) (*types.MsgResumeVestingResponse, error) {
+	if k.authority.String() != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), msg.Authority)
 	}
 
-	// convert the account back to a normal EthAccount
-	//
-	// NOTE: this is necessary to allow the bank keeper to send the locked coins away to the
-	// destination address. If the account is not converted, the coins will still be seen as locked,
-	// and can therefore not be transferred.
+	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	// set the account with the updated values of the vesting schedule
-	k.accountKeeper.SetAccount(ctx, updatedAcc.BaseAccount)
+	// NOTE: errors checked during msg validation
+	vesting := sdk.MustAccAddressFromBech32(msg.VestingAddress)
 
-	address := updatedAcc.GetAddress()
+	va, err := k.GetClawbackVestingAccount(ctx, vesting)
+	if err != nil {
+		return nil, err
+	}
 
-	// if gov clawback is disabled, remove the entry from the store.
-	// if no entry is found for the address, this will no-op
-	k.DeleteGovClawbackDisabled(ctx, address)
+	if !va.IsVestingPaused() {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "vesting of account %s is not paused", msg.VestingAddress)
+	}
+
+	k.deleteVestingIndexes(ctx, va)
+	pauseDuration := va.ResumeVesting(ctx.BlockTime().Unix())
+	k.accountKeeper.SetAccount(ctx, va)
+	k.setVestingIndexes(ctx, va)
+	k.DeleteVestingPaused(ctx, vesting)
+
+	telemetry.IncrCounter(
+		float32(ctx.GasMeter().GasConsumed()),
+		"tx", "resume_vesting", "gas_used",
+	)
 
-	// In case destination is community pool (e.g. Gov Clawback)
-	// call the corresponding function
-	if destinationAddr.String() == authtypes.NewModuleAddress(distributiontypes.ModuleName).String() {
-		return k.distributionKeeper.FundCommunityPool(ctx, toClawBack, address)
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeResumeVesting,
+				sdk.NewAttribute(types.AttributeKeyAccount, msg.VestingAddress),
+				sdk.NewAttribute(types.AttributeKeyPauseDuration, strconv.FormatInt(pauseDuration, 10)),
+			),
+		},
+	)
+
+	return &types.MsgResumeVestingResponse{}, nil
+}
+
+// UnlockVesting unlocks all the lockup periods of the given ClawbackVestingAccounts.
+// The vesting schedules are not modified. This can only be executed by the
+// governance module account.
+//
+// Checks performed on the ValidateBasic include:
+//   - authority and vesting addresses are correct bech32 format
+//   - vesting addresses are not empty and unique
+func (k Keeper) UnlockVesting(
+	goCtx context.Context,
+	msg *types.MsgUnlockVesting,
+) (*types.MsgUnlockVestingResponse, error) {
+	if k.authority.String() != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), msg.Authority)
 	}
 
-	// NOTE: don't use `SpendableCoins` to get the minimum value to clawback since
-	// the amount is retrieved from `ComputeClawback`, which ensures correctness.
-	// `SpendableCoins` can result in gas exhaustion if the user has too many
-	// different denoms (because of store iteration).
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	events := make(sdk.Events, 0, len(msg.VestingAddresses))
+	for _, vestingAddress := range msg.VestingAddresses {
+		// NOTE: errors checked during msg validation
+		vesting := sdk.MustAccAddressFromBech32(vestingAddress)
+
+		va, err := k.GetClawbackVestingAccount(ctx, vesting)
+		if err != nil {
+			return nil, err
+		}
+
+		k.deleteVestingIndexes(ctx, va)
+		unlocked := va.UnlockAll(ctx.BlockTime())
+		k.accountKeeper.SetAccount(ctx, va)
+		k.setVestingIndexes(ctx, va)
+
+		events = append(events,
+			sdk.NewEvent(
+				types.EventTypeUnlockVesting,
+				sdk.NewAttribute(types.AttributeKeyAccount, vestingAddress),
+				sdk.NewAttribute(types.AttributeKeyCoins, unlocked.String()),
+			),
+		)
+	}
+
+	telemetry.IncrCounter(
+		float32(ctx.GasMeter().GasConsumed()),
+		"tx", "unlock_vesting", "gas_used",
+	)
+
+	ctx.EventManager().EmitEvents(events)
+
+	return &types.MsgUnlockVestingResponse{}, nil
+}
+
+// ProposeScheduleAmendment stores an amendment of the future lockup and vesting
+// periods of a ClawbackVestingAccount, which is applied once accepted by the
+// vesting account. A new proposal replaces the pending one. This can only be
+// executed by the funder of the vesting account.
+//
+// Checks performed on the ValidateBasic include:
+//   - funder and vesting addresses are correct bech32 format
+//   - periods have a positive length and valid amounts
+func (k Keeper) ProposeScheduleAmendment(
+	goCtx context.Context,
+	msg *types.MsgProposeScheduleAmendment,
+) (*types.MsgProposeScheduleAmendmentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// NOTE: errors checked during msg validation
+	vesting := sdk.MustAccAddressFromBech32(msg.VestingAddress)
+	funder := sdk.MustAccAddressFromBech32(msg.FunderAddress)
+
+	va, err := k.GetClawbackVestingAccount(ctx, vesting)
+	if err != nil {
+		return nil, err
+	}
+
+	if va.FunderAddress != msg.FunderAddress {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized, "%s is not the current funder and cannot amend the schedule", msg.FunderAddress)
+	}
+
+	if va.IsVestingPaused() {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "vesting of account %s is paused", msg.VestingAddress)
+	}
+
+	// check that the amendment can be applied to the current schedule
+	if err := va.AmendSchedule(ctx.BlockTime().Unix(), msg.LockupPeriods, msg.VestingPeriods); err != nil {
+		return nil, err
+	}
+
+	k.SetScheduleAmendment(ctx, vesting, types.NewScheduleAmendment(funder, vesting, msg.LockupPeriods, msg.VestingPeriods))
+
+	telemetry.IncrCounter(
+		float32(ctx.GasMeter().GasConsumed()),
+		"tx", "propose_schedule_amendment", "gas_used",
+	)
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeProposeScheduleAmendment,
+				sdk.NewAttribute(types.AttributeKeyFunder, msg.FunderAddress),
+				sdk.NewAttribute(types.AttributeKeyAccount, msg.VestingAddress),
+			),
+		},
+	)
+
+	return &types.MsgProposeScheduleAmendmentResponse{}, nil
+}
+
+// AcceptScheduleAmendment replaces the future lockup and vesting periods of a
+// ClawbackVestingAccount with the pending amendment proposed by its funder.
+// The passed periods and the original vesting of the account are kept. This
+// can only be executed by the vesting account.
+//
+// Checks performed on the ValidateBasic include:
+//   - vesting address is correct bech32 format
+func (k Keeper) AcceptScheduleAmendment(
+	goCtx context.Context,
+	msg *types.MsgAcceptScheduleAmendment,
+) (*types.MsgAcceptScheduleAmendmentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// NOTE: errors checked during msg validation
+	vesting := sdk.MustAccAddressFromBech32(msg.VestingAddress)
+
+	amendment, found := k.GetScheduleAmendment(ctx, vesting)
+	if !found {
+		return nil, errorsmod.Wrapf(errortypes.ErrNotFound, "no schedule amendment pending for account %s", msg.VestingAddress)
+	}
+
+	va, err := k.GetClawbackVestingAccount(ctx, vesting)
+	if err != nil {
+		return nil, err
+	}
+
+	if va.FunderAddress != amendment.FunderAddress {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized, "schedule amendment was proposed by %s, who is not the current funder", amendment.FunderAddress)
+	}
+
+	if va.IsVestingPaused() {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "vesting of account %s is paused", msg.VestingAddress)
+	}
+
+	// the amendment replaces the upcoming lockup and vesting events
+	k.deleteVestingIndexes(ctx, va)
+	if err := va.AmendSchedule(ctx.BlockTime().Unix(), amendment.LockupPeriods, amendment.VestingPeriods); err != nil {
+		return nil, err
+	}
+	k.accountKeeper.SetAccount(ctx, va)
+	k.setVestingIndexes(ctx, va)
+	k.DeleteScheduleAmendment(ctx, vesting)
+
+	telemetry.IncrCounter(
+		float32(ctx.GasMeter().GasConsumed()),
+		"tx", "accept_schedule_amendment", "gas_used",
+	)
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeAcceptScheduleAmendment,
+				sdk.NewAttribute(types.AttributeKeyFunder, amendment.FunderAddress),
+				sdk.NewAttribute(types.AttributeKeyAccount, msg.VestingAddress),
+			),
+		},
+	)
+
+	return &types.MsgAcceptScheduleAmendmentResponse{}, nil
+}
+
+// SelfLockup locks up a portion of the balance of an account under the given
+// lockup schedule, starting at the current block time. The locked up coins are
+// vested, so that they cannot be clawed back. An account that is not a
+// clawback vesting account yet is converted into one that is its own funder,
+// with the clawback and the governance clawback renounced.
+//
+// Checks performed on the ValidateBasic include:
+//   - address is correct bech32 format
+//   - lockup periods are non-empty
+//   - lockup periods contain valid amounts and lengths
+func (k Keeper) SelfLockup(
+	goCtx context.Context,
+	msg *types.MsgSelfLockup,
+) (*types.MsgSelfLockupResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ak := k.accountKeeper
+	bk := k.bankKeeper
+
+	// NOTE: errors checked during msg validation
+	address := sdk.MustAccAddressFromBech32(msg.Address)
+
+	acc := ak.GetAccount(ctx, address)
+	if acc == nil {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "account %s does not exist", msg.Address)
+	}
+
+	lockupCoins := msg.LockupPeriods.TotalAmount()
+
+	// the locked up coins must be part of the spendable balance of the account
+	for _, coin := range lockupCoins {
+		if spendable := bk.SpendableCoin(ctx, address, coin.Denom); spendable.IsLT(coin) {
+			return nil, errorsmod.Wrapf(errortypes.ErrInsufficientFunds, "spendable balance %s is smaller than %s", spendable, coin)
+		}
+	}
+
+	va, isClawback := acc.(*types.ClawbackVestingAccount)
+	if !isClawback {
+		var err error
+		va, err = k.newClawbackVestingAccount(ctx, acc, address)
+		if err != nil {
+			return nil, err
+		}
+
+		// nobody can claw back the coins of a self-lockup account
+		k.SetClawbackRenounced(ctx, address)
+		k.SetGovClawbackDisabled(ctx, address)
+
+		if err = k.Hooks().AfterVestingAccountCreated(ctx, address, address); err != nil {
+			return nil, err
+		}
+	} else if va.IsVestingPaused() {
+		// the coins would not vest until the vesting is resumed
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "vesting of account %s is paused", msg.Address)
+	}
+
+	startTime := ctx.BlockTime().Unix()
+	vestingPeriods := sdkvesting.Periods{{Length: 0, Amount: lockupCoins}}
+
+	// the schedule of the account changes with the new grant
+	k.deleteVestingIndexes(ctx, va)
+	if err := k.addGrant(ctx, va, startTime, msg.LockupPeriods, vestingPeriods, lockupCoins); err != nil {
+		return nil, err
+	}
+	ak.SetAccount(ctx, va)
+	k.setVestingIndexes(ctx, va)
+
+	if err := k.Hooks().AfterVestingAccountFunded(ctx, address, address, lockupCoins); err != nil {
+		return nil, err
+	}
+
+	telemetry.IncrCounter(
+		float32(ctx.GasMeter().GasConsumed()),
+		"tx", "self_lockup", "gas_used",
+	)
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeSelfLockup,
+				sdk.NewAttribute(types.AttributeKeyAccount, msg.Address),
+				sdk.NewAttribute(types.AttributeKeyCoins, lockupCoins.String()),
+				sdk.NewAttribute(types.AttributeKeyStartTime, ctx.BlockTime().String()),
+			),
+		},
+	)
+
+	return &types.MsgSelfLockupResponse{}, nil
+}
+
+// FundVestingAccountFromCommunityPool funds a ClawbackVestingAccount from the
+// community pool, with governance as the funder. An account that is not a
+// clawback vesting account yet is converted into one. Unvested coins are
+// returned to the community pool by a governance clawback. This can only be
+// executed by the governance module account.
+//
+// Checks performed on the ValidateBasic include:
+//   - authority and vesting addresses are correct bech32 format
+//   - lockup and vesting periods contain valid amounts and lengths
+//   - both vesting and lockup periods describe the same total amount
+func (k Keeper) FundVestingAccountFromCommunityPool(
+	goCtx context.Context,
+	msg *types.MsgFundVestingAccountFromCommunityPool,
+) (*types.MsgFundVestingAccountFromCommunityPoolResponse, error) {
+	if k.authority.String() != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ak := k.accountKeeper
+
+	// NOTE: errors checked during msg validation
+	vestingAddr := sdk.MustAccAddressFromBech32(msg.VestingAddress)
+
+	if k.bankKeeper.BlockedAddr(vestingAddr) {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized,
+			"%s is not allowed to receive funds", msg.VestingAddress,
+		)
+	}
+
+	acc := ak.GetAccount(ctx, vestingAddr)
+	if acc == nil {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "account %s does not exist", msg.VestingAddress)
+	}
+
+	vestingAcc, isClawback := acc.(*types.ClawbackVestingAccount)
+	if isClawback {
+		if vestingAcc.FunderAddress != msg.Authority {
+			return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "account %s can only accept grants from account %s", msg.VestingAddress, vestingAcc.FunderAddress)
+		}
+
+		if k.HasGovClawbackDisabled(ctx, vestingAddr) {
+			return nil, errorsmod.Wrapf(types.ErrNotSubjectToGovClawback, "account %s", msg.VestingAddress)
+		}
+
+		if vestingAcc.IsVestingPaused() {
+			return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "vesting of account %s is paused", msg.VestingAddress)
+		}
+	} else {
+		var err error
+		vestingAcc, err = k.newClawbackVestingAccount(ctx, acc, k.authority)
+		if err != nil {
+			return nil, err
+		}
+
+		if err = k.Hooks().AfterVestingAccountCreated(ctx, vestingAddr, k.authority); err != nil {
+			return nil, err
+		}
+	}
+
+	lockupPeriods, vestingPeriods, vestingCoins := defaultGrantSchedule(msg.LockupPeriods, msg.VestingPeriods)
+
+	// the schedule of the account changes with the new grant
+	k.deleteVestingIndexes(ctx, vestingAcc)
+	if err := k.addGrant(ctx, vestingAcc, msg.StartTime.Unix(), lockupPeriods, vestingPeriods, vestingCoins); err != nil {
+		return nil, err
+	}
+	ak.SetAccount(ctx, vestingAcc)
+	k.setVestingIndexes(ctx, vestingAcc)
+
+	// Send coins from the community pool to the vesting account
+	if err := k.distributionKeeper.DistributeFromFeePool(ctx, vestingCoins, vestingAddr); err != nil {
+		return nil, err
+	}
+
+	if err := k.Hooks().AfterVestingAccountFunded(ctx, vestingAddr, k.authority, vestingCoins); err != nil {
+		return nil, err
+	}
+
+	telemetry.IncrCounter(
+		float32(ctx.GasMeter().GasConsumed()),
+		"tx", "fund_vesting_account_from_community_pool", "gas_used",
+	)
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeFundFromCommunityPool,
+				sdk.NewAttribute(types.AttributeKeyAccount, msg.VestingAddress),
+				sdk.NewAttribute(types.AttributeKeyCoins, vestingCoins.String()),
+				sdk.NewAttribute(types.AttributeKeyStartTime, msg.StartTime.String()),
+			),
+		},
+	)
+
+	return &types.MsgFundVestingAccountFromCommunityPoolResponse{}, nil
+}
+
+// CreateFundingInstruction registers a recurring funding of a
+// ClawbackVestingAccount, which is executed in EndBlock when each grant is due.
+// Each grant is locked up according to the lockup periods, relative to its due
+// time, and vests immediately. This can only be executed by the funder or the
+// manager of the vesting account, who funds the grants.
+//
+// Checks performed on the ValidateBasic include:
+//   - funder and vesting addresses are correct bech32 format
+//   - interval and count are positive
+//   - lockup periods are non-empty and contain valid amounts and lengths
+func (k Keeper) CreateFundingInstruction(
+	goCtx context.Context,
+	msg *types.MsgCreateFundingInstruction,
+) (*types.MsgCreateFundingInstructionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// NOTE: errors checked during msg validation
+	vestingAddr := sdk.MustAccAddressFromBech32(msg.VestingAddress)
+
+	if k.bankKeeper.BlockedAddr(vestingAddr) {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized,
+			"%s is not allowed to receive funds", msg.VestingAddress,
+		)
+	}
+
+	va, err := k.GetClawbackVestingAccount(ctx, vestingAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	if !va.IsFunderOrManager(msg.FunderAddress) {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "account %s can only accept grants from account %s or its manager", msg.VestingAddress, va.FunderAddress)
+	}
+
+	if va.IsVestingPaused() {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "vesting of account %s is paused", msg.VestingAddress)
+	}
+
+	if msg.StartTime.Before(ctx.BlockTime()) {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "start time %s is before the current block time", msg.StartTime)
+	}
+
+	instruction := types.FundingInstruction{
+		Id:             k.GetNextFundingInstructionID(ctx),
+		FunderAddress:  msg.FunderAddress,
+		VestingAddress: msg.VestingAddress,
+		LockupPeriods:  msg.LockupPeriods,
+		Interval:       msg.Interval,
+		RemainingCount: msg.Count,
+		NextTime:       msg.StartTime.Unix(),
+	}
+	k.SetFundingInstruction(ctx, instruction)
+
+	telemetry.IncrCounter(
+		float32(ctx.GasMeter().GasConsumed()),
+		"tx", "create_funding_instruction", "gas_used",
+	)
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeCreateFundingInstruction,
+				sdk.NewAttribute(types.AttributeKeyID, strconv.FormatUint(instruction.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyFunder, msg.FunderAddress),
+				sdk.NewAttribute(types.AttributeKeyAccount, msg.VestingAddress),
+				sdk.NewAttribute(types.AttributeKeyCoins, msg.LockupPeriods.TotalAmount().String()),
+			),
+		},
+	)
+
+	return &types.MsgCreateFundingInstructionResponse{Id: instruction.Id}, nil
+}
+
+// CancelFundingInstruction cancels a recurring funding instruction. The grants
+// that have already been executed are not modified. This can only be executed
+// by the funder of the instruction.
+func (k Keeper) CancelFundingInstruction(
+	goCtx context.Context,
+	msg *types.MsgCancelFundingInstruction,
+) (*types.MsgCancelFundingInstructionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	instruction, found := k.GetFundingInstruction(ctx, msg.Id)
+	if !found {
+		return nil, errorsmod.Wrapf(errortypes.ErrNotFound, "funding instruction %d does not exist", msg.Id)
+	}
+
+	if instruction.FunderAddress != msg.FunderAddress {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized, "%s is not the funder of funding instruction %d", msg.FunderAddress, msg.Id)
+	}
+
+	k.DeleteFundingInstruction(ctx, instruction)
+
+	telemetry.IncrCounter(
+		float32(ctx.GasMeter().GasConsumed()),
+		"tx", "cancel_funding_instruction", "gas_used",
+	)
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeCancelFundingInstruction,
+				sdk.NewAttribute(types.AttributeKeyID, strconv.FormatUint(msg.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyFunder, msg.FunderAddress),
+			),
+		},
+	)
+
+	return &types.MsgCancelFundingInstructionResponse{}, nil
+}
+
+// CreateEscrowGrant funds a vesting grant whose coins are held in escrow by
+// the vesting module account, instead of the balance of the recipient. The
+// coins are released to the recipient through ClaimEscrowGrant as they vest
+// and unlock. The recipient can be any account that is allowed to receive
+// funds. No vesting hook is called, as no clawback vesting account is funded.
+//
+// Checks performed on the ValidateBasic include:
+//   - funder and recipient addresses are correct bech32 format
+//   - vesting and/or lockup schedules are present and describe the same total
+//     amount
+func (k Keeper) CreateEscrowGrant(
+	goCtx context.Context,
+	msg *types.MsgCreateEscrowGrant,
+) (*types.MsgCreateEscrowGrantResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// NOTE: errors checked during msg validation
+	funderAddr := sdk.MustAccAddressFromBech32(msg.FunderAddress)
+	recipientAddr := sdk.MustAccAddressFromBech32(msg.RecipientAddress)
+
+	if k.bankKeeper.BlockedAddr(recipientAddr) {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized,
+			"%s is not allowed to receive funds", msg.RecipientAddress,
+		)
+	}
+
+	lockupPeriods, vestingPeriods, _ := defaultGrantSchedule(msg.LockupPeriods, msg.VestingPeriods)
+
+	grant := types.NewEscrowGrant(
+		k.GetNextEscrowGrantID(ctx),
+		funderAddr, recipientAddr,
+		msg.StartTime.Unix(),
+		lockupPeriods, vestingPeriods,
+	)
+	if err := grant.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, funderAddr, types.ModuleName, grant.OriginalAmount); err != nil {
+		return nil, err
+	}
+
+	k.SetEscrowGrant(ctx, grant)
+
+	telemetry.IncrCounter(
+		float32(ctx.GasMeter().GasConsumed()),
+		"tx", "create_escrow_grant", "gas_used",
+	)
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeCreateEscrowGrant,
+				sdk.NewAttribute(types.AttributeKeyID, strconv.FormatUint(grant.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyFunder, msg.FunderAddress),
+				sdk.NewAttribute(types.AttributeKeyRecipient, msg.RecipientAddress),
+				sdk.NewAttribute(types.AttributeKeyCoins, grant.OriginalAmount.String()),
+				sdk.NewAttribute(types.AttributeKeyStartTime, msg.StartTime.String()),
+			),
+		},
+	)
+
+	return &types.MsgCreateEscrowGrantResponse{Id: grant.Id}, nil
+}
+
+// ClaimEscrowGrant releases the vested and unlocked coins of an escrow grant
+// that have not been claimed yet from the vesting module account to the
+// recipient. The grant is removed once all its coins have been claimed.
+func (k Keeper) ClaimEscrowGrant(
+	goCtx context.Context,
+	msg *types.MsgClaimEscrowGrant,
+) (*types.MsgClaimEscrowGrantResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	grant, found := k.GetEscrowGrant(ctx, msg.Id)
+	if !found {
+		return nil, errorsmod.Wrapf(errortypes.ErrNotFound, "escrow grant %d does not exist", msg.Id)
+	}
+
+	if grant.RecipientAddress != msg.RecipientAddress {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized, "%s is not the recipient of escrow grant %d", msg.RecipientAddress, msg.Id)
+	}
+
+	claimable := grant.GetClaimableCoins(ctx.BlockTime())
+	if claimable.IsZero() {
+		return nil, errorsmod.Wrapf(types.ErrInsufficientUnlockedCoins, "no vested and unlocked coins to claim from escrow grant %d", msg.Id)
+	}
+
+	// NOTE: errors checked during msg validation
+	recipientAddr := sdk.MustAccAddressFromBech32(msg.RecipientAddress)
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipientAddr, claimable); err != nil {
+		return nil, err
+	}
+
+	grant.Claimed = grant.Claimed.Add(claimable...)
+	if grant.IsFullyClaimed() {
+		k.DeleteEscrowGrant(ctx, grant)
+	} else {
+		k.SetEscrowGrant(ctx, grant)
+	}
+
+	telemetry.IncrCounter(
+		float32(ctx.GasMeter().GasConsumed()),
+		"tx", "claim_escrow_grant", "gas_used",
+	)
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeClaimEscrowGrant,
+				sdk.NewAttribute(types.AttributeKeyID, strconv.FormatUint(msg.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyRecipient, msg.RecipientAddress),
+				sdk.NewAttribute(types.AttributeKeyCoins, claimable.String()),
+			),
+		},
+	)
+
+	return &types.MsgClaimEscrowGrantResponse{Coins: claimable}, nil
+}
+
+// ClawbackEscrowGrant transfers the unvested coins of an escrow grant from the
+// vesting module account to the destination address, or to the funder if not
+// provided. The vested coins remain in escrow and can still be claimed by the
+// recipient as they unlock. This can only be executed by the funder of the
+// grant.
+func (k Keeper) ClawbackEscrowGrant(
+	goCtx context.Context,
+	msg *types.MsgClawbackEscrowGrant,
+) (*types.MsgClawbackEscrowGrantResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	grant, found := k.GetEscrowGrant(ctx, msg.Id)
+	if !found {
+		return nil, errorsmod.Wrapf(errortypes.ErrNotFound, "escrow grant %d does not exist", msg.Id)
+	}
+
+	if grant.FunderAddress != msg.FunderAddress {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized, "%s is not the funder of escrow grant %d", msg.FunderAddress, msg.Id)
+	}
+
+	if grant.IsClawedBack() {
+		return nil, errorsmod.Wrapf(types.ErrNothingToClawback, "escrow grant %d was already clawed back", msg.Id)
+	}
+
+	// NOTE: errors checked during msg validation
+	destAddr := sdk.MustAccAddressFromBech32(msg.FunderAddress)
+	if msg.DestAddress != "" {
+		destAddr = sdk.MustAccAddressFromBech32(msg.DestAddress)
+	}
+
+	if k.bankKeeper.BlockedAddr(destAddr) {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized,
+			"account is not allowed to receive funds: %s", destAddr,
+		)
+	}
+
+	clawedBack := grant.Clawback(ctx.BlockTime())
+	if clawedBack.IsZero() {
+		return nil, errorsmod.Wrapf(types.ErrNothingToClawback, "escrow grant %d is fully vested", msg.Id)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, destAddr, clawedBack); err != nil {
+		return nil, err
+	}
+
+	if grant.IsFullyClaimed() {
+		k.DeleteEscrowGrant(ctx, grant)
+	} else {
+		k.SetEscrowGrant(ctx, grant)
+	}
+
+	telemetry.IncrCounter(
+		float32(ctx.GasMeter().GasConsumed()),
+		"tx", "clawback_escrow_grant", "gas_used",
+	)
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeClawbackEscrowGrant,
+				sdk.NewAttribute(types.AttributeKeyID, strconv.FormatUint(msg.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyFunder, msg.FunderAddress),
+				sdk.NewAttribute(types.AttributeKeyDestination, destAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyCoins, clawedBack.String()),
+			),
+		},
+	)
+
+	return &types.MsgClawbackEscrowGrantResponse{Coins: clawedBack}, nil
+}
+
+// CreateFunderCommittee creates a funder committee, whose address is derived
+// from the vesting module address. The committee address can be set as the
+// funder of clawback vesting accounts, whose clawback, funder update and
+// vesting acceleration are then executed through proposals approved by the
+// threshold of its members. As no private key exists for the committee
+// address, the committee cannot sign a MsgFundVestingAccount or a
+// MsgSetVestingManager.
+//
+// Checks performed on the ValidateBasic include:
+//   - creator and member addresses are correct bech32 format
+//   - members are not empty nor duplicated
+//   - threshold is between 1 and the number of members
+//   - proposal lifetime is positive
+func (k Keeper) CreateFunderCommittee(
+	goCtx context.Context,
+	msg *types.MsgCreateFunderCommittee,
+) (*types.MsgCreateFunderCommitteeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	committeeAddr := types.NewFunderCommitteeAddress(k.GetNextFunderCommitteeID(ctx))
+	if k.accountKeeper.GetAccount(ctx, committeeAddr) != nil {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "account %s already exists", committeeAddr)
+	}
+
+	committee := types.FunderCommittee{
+		Address:          committeeAddr.String(),
+		Members:          msg.Members,
+		Threshold:        msg.Threshold,
+		ProposalLifetime: msg.ProposalLifetime,
+	}
+	k.SetFunderCommittee(ctx, committee)
+
+	telemetry.IncrCounter(
+		float32(ctx.GasMeter().GasConsumed()),
+		"tx", "create_funder_committee", "gas_used",
+	)
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeCreateFunderCommittee,
+				sdk.NewAttribute(types.AttributeKeyCommittee, committee.Address),
+				sdk.NewAttribute(types.AttributeKeyMembers, strings.Join(msg.Members, ",")),
+				sdk.NewAttribute(types.AttributeKeyThreshold, strconv.FormatUint(uint64(msg.Threshold), 10)),
+			),
+		},
+	)
+
+	return &types.MsgCreateFunderCommitteeResponse{Address: committee.Address}, nil
+}
+
+// SubmitCommitteeProposal proposes an action on a clawback vesting account
+// funded by a funder committee. The proposal counts as approved by the
+// proposer, and is executed immediately if the threshold of the committee is
+// reached. This can only be executed by a member of the committee.
+//
+// Checks performed on the ValidateBasic include:
+//   - proposer, committee and vesting addresses are correct bech32 format
+//   - the fields required by the action are present
+func (k Keeper) SubmitCommitteeProposal(
+	goCtx context.Context,
+	msg *types.MsgSubmitCommitteeProposal,
+) (*types.MsgSubmitCommitteeProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// NOTE: errors checked during msg validation
+	committeeAddr := sdk.MustAccAddressFromBech32(msg.CommitteeAddress)
+	vestingAddr := sdk.MustAccAddressFromBech32(msg.VestingAddress)
+
+	committee, found := k.GetFunderCommittee(ctx, committeeAddr)
+	if !found {
+		return nil, errorsmod.Wrapf(errortypes.ErrNotFound, "funder committee %s does not exist", msg.CommitteeAddress)
+	}
+
+	if !committee.IsMember(msg.Proposer) {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized, "%s is not a member of funder committee %s", msg.Proposer, msg.CommitteeAddress)
+	}
+
+	va, err := k.GetClawbackVestingAccount(ctx, vestingAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	if va.FunderAddress != msg.CommitteeAddress {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized, "funder committee %s is not the funder of account %s", msg.CommitteeAddress, msg.VestingAddress)
+	}
+
+	proposal := types.CommitteeProposal{
+		Id:               k.GetNextCommitteeProposalID(ctx),
+		CommitteeAddress: msg.CommitteeAddress,
+		Proposer:         msg.Proposer,
+		Action:           msg.Action,
+		VestingAddress:   msg.VestingAddress,
+		DestAddress:      msg.DestAddress,
+		NewFunderAddress: msg.NewFunderAddress,
+		Approvals:        []string{msg.Proposer},
+		ExpiryTime:       ctx.BlockTime().Unix() + committee.ProposalLifetime,
+	}
+
+	telemetry.IncrCounter(
+		float32(ctx.GasMeter().GasConsumed()),
+		"tx", "submit_committee_proposal", "gas_used",
+	)
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeSubmitCommitteeProposal,
+				sdk.NewAttribute(types.AttributeKeyID, strconv.FormatUint(proposal.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyCommittee, msg.CommitteeAddress),
+				sdk.NewAttribute(types.AttributeKeyProposer, msg.Proposer),
+				sdk.NewAttribute(types.AttributeKeyAction, msg.Action.String()),
+				sdk.NewAttribute(types.AttributeKeyAccount, msg.VestingAddress),
+			),
+		},
+	)
+
+	executed, err := k.tryExecuteCommitteeProposal(ctx, committee, proposal)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubmitCommitteeProposalResponse{Id: proposal.Id, Executed: executed}, nil
+}
+
+// ApproveCommitteeProposal approves a funder committee proposal, which is
+// executed once the threshold of the committee is reached. A failed execution
+// reverts the approval. This can only be executed by a member of the
+// committee, before the proposal expires.
+func (k Keeper) ApproveCommitteeProposal(
+	goCtx context.Context,
+	msg *types.MsgApproveCommitteeProposal,
+) (*types.MsgApproveCommitteeProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	proposal, found := k.GetCommitteeProposal(ctx, msg.Id)
+	if !found {
+		return nil, errorsmod.Wrapf(errortypes.ErrNotFound, "committee proposal %d does not exist", msg.Id)
+	}
+
+	if proposal.IsExpired(ctx.BlockTime()) {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "committee proposal %d has expired", msg.Id)
+	}
+
+	committee, found := k.GetFunderCommittee(ctx, sdk.MustAccAddressFromBech32(proposal.CommitteeAddress))
+	if !found {
+		return nil, errorsmod.Wrapf(errortypes.ErrNotFound, "funder committee %s does not exist", proposal.CommitteeAddress)
+	}
+
+	if !committee.IsMember(msg.Member) {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized, "%s is not a member of funder committee %s", msg.Member, proposal.CommitteeAddress)
+	}
+
+	if proposal.HasApproved(msg.Member) {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "%s already approved committee proposal %d", msg.Member, msg.Id)
+	}
+
+	proposal.Approvals = append(proposal.Approvals, msg.Member)
+
+	telemetry.IncrCounter(
+		float32(ctx.GasMeter().GasConsumed()),
+		"tx", "approve_committee_proposal", "gas_used",
+	)
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeApproveCommitteeProposal,
+				sdk.NewAttribute(types.AttributeKeyID, strconv.FormatUint(msg.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyMember, msg.Member),
+				sdk.NewAttribute(types.AttributeKeyApprovals, strconv.Itoa(len(proposal.Approvals))),
+			),
+		},
+	)
+
+	executed, err := k.tryExecuteCommitteeProposal(ctx, committee, proposal)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgApproveCommitteeProposalResponse{Executed: executed}, nil
+}
+
+// RecoverVestingAccount migrates the balance, delegations and full schedule of
+// a ClawbackVestingAccount to an existing account at a new address (e.g. after
+// the loss of the original keys). The coins that are unvested or locked remain
+// so in the recovered account, and the escrow grants and recurring funding
+// instructions of the account are migrated to the new address. The old account
+// is converted into the chain's default account type. This can only be
+// executed by the governance module account.
+//
+// Checks performed on the ValidateBasic include:
+//   - authority, vesting and new addresses are correct bech32 format
+//   - new address is different from the vesting address
+func (k Keeper) RecoverVestingAccount(
+	goCtx context.Context,
+	msg *types.MsgRecoverVestingAccount,
+) (*types.MsgRecoverVestingAccountResponse, error) {
+	if k.authority.String() != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ak := k.accountKeeper
+	bk := k.bankKeeper
+
+	// NOTE: errors checked during msg validation
+	vestingAddr := sdk.MustAccAddressFromBech32(msg.VestingAddress)
+	newAddr := sdk.MustAccAddressFromBech32(msg.NewAddress)
+
+	va, err := k.GetClawbackVestingAccount(ctx, vestingAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	if bk.BlockedAddr(newAddr) {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized,
+			"%s is not allowed to receive funds", msg.NewAddress,
+		)
+	}
+
+	newAcc := ak.GetAccount(ctx, newAddr)
+	if newAcc == nil {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "account %s does not exist", msg.NewAddress)
+	}
+
+	if _, isClawback := newAcc.(*types.ClawbackVestingAccount); isClawback || types.IsSDKVestingAccount(newAcc) {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "account %s is already a vesting account", msg.NewAddress)
+	}
+
+	// NOTE: the delegation tracking of the vesting account is copied to the new
+	// account, which would not account for the existing delegations of the latter
+	if k.stakingKeeper.GetDelegatorBonded(ctx, newAddr).IsPositive() || k.stakingKeeper.GetDelegatorUnbonding(ctx, newAddr).IsPositive() {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "account %s has delegations or unbonding delegations", msg.NewAddress)
+	}
+
+	baseAcc, err := k.unwrapAccount(ctx, newAcc)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "cannot convert %s into a clawback vesting account", msg.NewAddress)
+	}
+
+	// the recovered account keeps the schedule, funder, manager and pause state
+	// of the vesting account
+	recovered := *va
+	recovered.BaseVestingAccount = &sdkvesting.BaseVestingAccount{
+		BaseAccount:      baseAcc,
+		OriginalVesting:  va.OriginalVesting,
+		DelegatedFree:    va.DelegatedFree,
+		DelegatedVesting: va.DelegatedVesting,
+		EndTime:          va.EndTime,
+	}
+
+	govClawbackDisabled := k.HasGovClawbackDisabled(ctx, vestingAddr)
+	clawbackRenounced := k.HasClawbackRenounced(ctx, vestingAddr)
+	amendment, hasAmendment := k.GetScheduleAmendment(ctx, vestingAddr)
+
+	// convert the vesting account first, so that its locked coins can be
+	// transferred
+	if err := k.convertVestingAccount(ctx, va); err != nil {
+		return nil, err
+	}
+
+	delegated, err := k.transferAllDelegations(ctx, vestingAddr, newAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	balance := bk.GetAllBalances(ctx, vestingAddr)
+	if !balance.IsZero() {
+		if err := bk.SendCoins(ctx, vestingAddr, newAddr, balance); err != nil {
+			return nil, err
+		}
+	}
+
+	ak.SetAccount(ctx, &recovered)
+	k.setVestingIndexes(ctx, &recovered)
+
+	if govClawbackDisabled {
+		k.SetGovClawbackDisabled(ctx, newAddr)
+	}
+	if clawbackRenounced {
+		k.SetClawbackRenounced(ctx, newAddr)
+	}
+	if recovered.IsVestingPaused() {
+		k.SetVestingPaused(ctx, newAddr, recovered.VestingPausedAt)
+	}
+	if hasAmendment {
+		k.SetScheduleAmendment(ctx, newAddr, amendment)
+	}
+	k.retargetEscrowGrants(ctx, vestingAddr, newAddr)
+	k.retargetFundingInstructions(ctx, vestingAddr, newAddr)
+
+	funderAddr := sdk.MustAccAddressFromBech32(recovered.FunderAddress)
+	if err := k.Hooks().AfterVestingAccountCreated(ctx, newAddr, funderAddr); err != nil {
+		return nil, err
+	}
+
+	telemetry.IncrCounter(
+		float32(ctx.GasMeter().GasConsumed()),
+		"tx", "recover_vesting_account", "gas_used",
+	)
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeRecoverVestingAccount,
+				sdk.NewAttribute(types.AttributeKeyFunder, recovered.FunderAddress),
+				sdk.NewAttribute(types.AttributeKeyAccount, msg.VestingAddress),
+				sdk.NewAttribute(types.AttributeKeyNewAddress, msg.NewAddress),
+				sdk.NewAttribute(types.AttributeKeyCoins, balance.String()),
+				sdk.NewAttribute(types.AttributeKeyDelegated, delegated.String()),
+			),
+		},
+	)
+
+	return &types.MsgRecoverVestingAccountResponse{Coins: balance}, nil
+}
+
+// MergeVestingAccounts merges the schedule of a ClawbackVestingAccount into
+// another one with the same funder, and transfers its balance and delegations.
+// The escrow grants and recurring funding instructions of the source account
+// are migrated to the target account, and the source account is converted into
+// the chain's default account type. The merge must be signed by both accounts
+// and the funder.
+//
+// Checks performed on the ValidateBasic include:
+//   - funder, source and target addresses are correct bech32 format
+//   - source and target addresses are different
+func (k Keeper) MergeVestingAccounts(
+	goCtx context.Context,
+	msg *types.MsgMergeVestingAccounts,
+) (*types.MsgMergeVestingAccountsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ak := k.accountKeeper
+	bk := k.bankKeeper
+
+	// NOTE: errors checked during msg validation
+	sourceAddr := sdk.MustAccAddressFromBech32(msg.SourceAddress)
+	targetAddr := sdk.MustAccAddressFromBech32(msg.TargetAddress)
+
+	source, err := k.GetClawbackVestingAccount(ctx, sourceAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := k.GetClawbackVestingAccount(ctx, targetAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	if source.FunderAddress != msg.FunderAddress || target.FunderAddress != msg.FunderAddress {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized, "accounts %s and %s must both be funded by %s", msg.SourceAddress, msg.TargetAddress, msg.FunderAddress)
+	}
+
+	if source.IsVestingPaused() || target.IsVestingPaused() {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "cannot merge accounts with paused vesting")
+	}
+
+	// the merged coins must remain subject to the same clawback rights
+	if k.HasGovClawbackDisabled(ctx, sourceAddr) != k.HasGovClawbackDisabled(ctx, targetAddr) {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "accounts %s and %s have different governance clawback settings", msg.SourceAddress, msg.TargetAddress)
+	}
+
+	if k.HasClawbackRenounced(ctx, sourceAddr) != k.HasClawbackRenounced(ctx, targetAddr) {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "accounts %s and %s have different clawback renouncements", msg.SourceAddress, msg.TargetAddress)
+	}
+
+	// a pending amendment would replace the merged future periods
+	for _, addr := range []sdk.AccAddress{sourceAddr, targetAddr} {
+		if _, found := k.GetScheduleAmendment(ctx, addr); found {
+			return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "account %s has a pending schedule amendment", addr)
+		}
+	}
+
+	// convert the source account first, so that its locked coins can be
+	// transferred
+	if err := k.convertVestingAccount(ctx, source); err != nil {
+		return nil, err
+	}
+
+	delegated, err := k.transferAllDelegations(ctx, sourceAddr, targetAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	balance := bk.GetAllBalances(ctx, sourceAddr)
+	if !balance.IsZero() {
+		if err := bk.SendCoins(ctx, sourceAddr, targetAddr, balance); err != nil {
+			return nil, err
+		}
+	}
+
+	// NOTE: the delegated vesting and free coins of the target account are
+	// recomputed from its delegations, including the transferred ones
+	k.deleteVestingIndexes(ctx, target)
+	if err := k.addGrant(ctx, target, source.StartTime.Unix(), source.LockupPeriods, source.VestingPeriods, source.OriginalVesting); err != nil {
+		return nil, err
+	}
+	ak.SetAccount(ctx, target)
+	k.setVestingIndexes(ctx, target)
+
+	k.retargetEscrowGrants(ctx, sourceAddr, targetAddr)
+	k.retargetFundingInstructions(ctx, sourceAddr, targetAddr)
+
+	funderAddr := sdk.MustAccAddressFromBech32(msg.FunderAddress)
+	if err := k.Hooks().AfterVestingAccountFunded(ctx, targetAddr, funderAddr, source.OriginalVesting); err != nil {
+		return nil, err
+	}
+
+	telemetry.IncrCounter(
+		float32(ctx.GasMeter().GasConsumed()),
+		"tx", "merge_vesting_accounts", "gas_used",
+	)
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeMergeVestingAccounts,
+				sdk.NewAttribute(types.AttributeKeyFunder, msg.FunderAddress),
+				sdk.NewAttribute(types.AttributeKeySource, msg.SourceAddress),
+				sdk.NewAttribute(types.AttributeKeyTarget, msg.TargetAddress),
+				sdk.NewAttribute(types.AttributeKeyCoins, balance.String()),
+				sdk.NewAttribute(types.AttributeKeyAmount, source.OriginalVesting.String()),
+				sdk.NewAttribute(types.AttributeKeyDelegated, delegated.String()),
+			),
+		},
+	)
+
+	return &types.MsgMergeVestingAccountsResponse{Coins: balance}, nil
+}
+
+// ConvertVestingAccount converts a ClawbackVestingAccount to the default chain account
+// after its lockup and vesting periods have concluded.
+func (k Keeper) ConvertVestingAccount(
+	goCtx context.Context,
+	msg *types.MsgConvertVestingAccount,
+) (*types.MsgConvertVestingAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	address := sdk.MustAccAddressFromBech32(msg.VestingAddress)
+
+	vestingAcc, err := k.GetClawbackVestingAccount(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	// check if account has any vesting coins left
+	if !vestingAcc.GetVestingCoins(ctx.BlockTime()).IsZero() {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "vesting coins still left in account: %s", msg.VestingAddress)
+	}
+
+	// check if account has any locked up coins left
+	if vestingAcc.HasLockedCoins(ctx.BlockTime()) {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "locked up coins still left in account: %s", msg.VestingAddress)
+	}
+
+	if err = k.convertVestingAccount(ctx, vestingAcc); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgConvertVestingAccountResponse{}, nil
+}
+
+// MigrateVestingAccount converts an existing x/auth/vesting account into a
+// ClawbackVestingAccount. This can only be executed by the governance module account.
+//
+// Checks performed on the ValidateBasic include:
+//   - authority, vesting and funder addresses are correct bech32 format
+func (k Keeper) MigrateVestingAccount(
+	goCtx context.Context,
+	msg *types.MsgMigrateVestingAccount,
+) (*types.MsgMigrateVestingAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.authority.String() != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), msg.Authority)
+	}
+
+	// NOTE: errors checked during msg validation
+	vestingAddr := sdk.MustAccAddressFromBech32(msg.VestingAddress)
+	funderAddr := sdk.MustAccAddressFromBech32(msg.FunderAddress)
+
+	if k.bankKeeper.BlockedAddr(funderAddr) {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized,
+			"%s is not allowed to receive funds", msg.FunderAddress,
+		)
+	}
+
+	if _, err := k.ConvertSDKVestingAccount(ctx, vestingAddr, funderAddr, msg.EnableGovClawback); err != nil {
+		return nil, err
+	}
+
+	telemetry.IncrCounter(
+		float32(ctx.GasMeter().GasConsumed()),
+		"tx", "migrate_vesting_account", "gas_used",
+	)
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeMigrateVestingAccount,
+				sdk.NewAttribute(types.AttributeKeyFunder, msg.FunderAddress),
+				sdk.NewAttribute(types.AttributeKeyAccount, msg.VestingAddress),
+			),
+		},
+	)
+
+	return &types.MsgMigrateVestingAccountResponse{}, nil
+}
+
+// UpdateParams updates the vesting module parameters. This can only be executed
+// by the governance module account.
+func (k Keeper) UpdateParams(
+	goCtx context.Context,
+	msg *types.MsgUpdateParams,
+) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority.String() != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// convertVestingAccount converts the given ClawbackVestingAccount into the
+// chain's default account type and removes its entries from the module store.
+func (k Keeper) convertVestingAccount(ctx sdk.Context, va *types.ClawbackVestingAccount) error {
+	k.removeVestingAccount(ctx, va)
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeConvertVestingAccount,
+				sdk.NewAttribute(types.AttributeKeyAccount, va.GetAddress().String()),
+			),
+		},
+	)
+
+	return k.Hooks().AfterVestingAccountConverted(ctx, va.GetAddress())
+}
+
+// removeVestingAccount replaces the given ClawbackVestingAccount with the
+// chain's default account type and removes its entries from the module store.
+func (k Keeper) removeVestingAccount(ctx sdk.Context, va *types.ClawbackVestingAccount) {
+	// if gov clawback is disabled, remove the entry from the store.
+	// if no entry is found for the address, this will no-op
+	k.DeleteGovClawbackDisabled(ctx, va.GetAddress())
+	k.DeleteClawbackRenounced(ctx, va.GetAddress())
+	k.DeleteVestingPaused(ctx, va.GetAddress())
+	k.DeleteScheduleAmendment(ctx, va.GetAddress())
+	k.deleteVestingIndexes(ctx, va)
+
+	k.accountKeeper.SetAccount(ctx, k.wrapAccount(ctx, va.BaseAccount))
+}
+
+// addGrant merges a new clawback vesting grant into an existing
+// ClawbackVestingAccount.
+func (k Keeper) addGrant(
+	ctx sdk.Context,
+	va *types.ClawbackVestingAccount,
+	grantStartTime int64,
+	grantLockupPeriods, grantVestingPeriods sdkvesting.Periods,
+	grantCoins sdk.Coins,
+) error {
+	// check if the clawback vesting account has only been initialized and not yet funded --
+	// in that case it's necessary to update the vesting account with the given start time because this is set to zero in the initialization
+	if len(va.LockupPeriods) == 0 && len(va.VestingPeriods) == 0 {
+		va.StartTime = time.Unix(grantStartTime, 0).UTC()
+	}
+
+	// how much is really delegated?
+	bondedAmt := k.stakingKeeper.GetDelegatorBonded(ctx, va.GetAddress())
+	unbondingAmt := k.stakingKeeper.GetDelegatorUnbonding(ctx, va.GetAddress())
+	delegatedAmt := bondedAmt.Add(unbondingAmt)
+	delegated := sdk.NewCoins(sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), delegatedAmt))
+
+	// modify schedules for the new grant
+	newLockupStart, newLockupEnd, newLockupPeriods := types.DisjunctPeriods(va.GetStartTime(), grantStartTime, va.LockupPeriods, grantLockupPeriods)
+	newVestingStart, newVestingEnd, newVestingPeriods := types.DisjunctPeriods(
+		va.GetStartTime(),
+		grantStartTime,
+		va.GetVestingPeriods(),
+		grantVestingPeriods,
+	)
+
+	if newLockupStart != newVestingStart {
+		return errorsmod.Wrapf(
+			types.ErrVestingLockup,
+			"vesting start time calculation should match lockup start (%d ≠ %d)",
+			newVestingStart, newLockupStart,
+		)
+	}
+
+	va.StartTime = time.Unix(newLockupStart, 0).UTC()
+	va.EndTime = types.Max64(newLockupEnd, newVestingEnd)
+	va.LockupPeriods = newLockupPeriods
+	va.VestingPeriods = newVestingPeriods
+	va.OriginalVesting = va.OriginalVesting.Add(grantCoins...)
+
+	// cap DV at the current unvested amount, DF rounds out to current delegated
+	unvested := va.GetVestingCoins(ctx.BlockTime())
+	va.DelegatedVesting = delegated.Min(unvested)
+	va.DelegatedFree = delegated.Sub(va.DelegatedVesting...)
+	return nil
+}
+
+// defaultGrantSchedule returns the lockup and vesting periods of a new grant,
+// defaulting to an instant unlock schedule if the lockup periods are absent and
+// to an instant vesting schedule if the vesting periods are absent, along with
+// the total amount of the grant.
+func defaultGrantSchedule(lockupPeriods, vestingPeriods sdkvesting.Periods) (sdkvesting.Periods, sdkvesting.Periods, sdk.Coins) {
+	vestingCoins := vestingPeriods.TotalAmount()
+	lockupCoins := lockupPeriods.TotalAmount()
+
+	// If lockup absent, default to an instant unlock schedule
+	if !vestingCoins.IsZero() && len(lockupPeriods) == 0 {
+		lockupPeriods = sdkvesting.Periods{{Length: 0, Amount: vestingCoins}}
+		lockupCoins = vestingCoins
+	}
+
+	// If vesting absent, default to an instant vesting schedule
+	if !lockupCoins.IsZero() && len(vestingPeriods) == 0 {
+		vestingPeriods = sdkvesting.Periods{{Length: 0, Amount: lockupCoins}}
+		vestingCoins = lockupCoins
+	}
+
+	return lockupPeriods, vestingPeriods, vestingCoins
+}
+
+// newClawbackVestingAccount converts the given account of the chain's default
+// type into a ClawbackVestingAccount with the given funder and no schedule.
+// The account is not stored. x/auth/vesting accounts cannot be converted, as
+// they have to be migrated through governance.
+func (k Keeper) newClawbackVestingAccount(
+	ctx sdk.Context,
+	acc authtypes.AccountI,
+	funder sdk.AccAddress,
+) (*types.ClawbackVestingAccount, error) {
+	if types.IsSDKVestingAccount(acc) {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest,
+			"%s is an x/auth/vesting account and has to be migrated through governance", acc.GetAddress(),
+		)
+	}
+
+	baseAcc, err := k.unwrapAccount(ctx, acc)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "cannot convert %s into a clawback vesting account", acc.GetAddress())
+	}
+
+	return &types.ClawbackVestingAccount{
+		BaseVestingAccount: &sdkvesting.BaseVestingAccount{BaseAccount: baseAcc},
+		FunderAddress:      funder.String(),
+	}, nil
+}
+
+// clawback performs the clawback of the unvested tokens of the account in the
+// given message and returns the clawed back amount.
+func (k Keeper) clawback(ctx sdk.Context, msg *types.MsgClawback) (sdk.Coins, error) {
+	ak := k.accountKeeper
+	bk := k.bankKeeper
+
+	// NOTE: error checked during msg validation
+	addr := sdk.MustAccAddressFromBech32(msg.AccountAddress)
+
+	isGovClawback := k.authority.String() == msg.FunderAddress
+
+	// Default destination to funder address, or to the community pool for
+	// governance clawbacks. Burned coins are sent to the module account.
+	destinations := msg.Destinations
+	toModule := false
+	switch {
+	case len(destinations) > 0:
+	case msg.Burn:
+		toModule = true
+		destinations = []types.ClawbackDestination{types.NewClawbackDestination(ak.GetModuleAddress(types.ModuleName), 1)}
+	case msg.DestAddress != "":
+		destinations = []types.ClawbackDestination{{Address: msg.DestAddress, Weight: 1}}
+	case isGovClawback:
+		toModule = true
+		destinations = []types.ClawbackDestination{types.NewClawbackDestination(ak.GetModuleAddress(distributiontypes.ModuleName), 1)}
+	default:
+		destinations = []types.ClawbackDestination{{Address: msg.FunderAddress, Weight: 1}}
+	}
+
+	if !toModule {
+		for _, d := range destinations {
+			// NOTE: errors checked during msg validation
+			if bk.BlockedAddr(sdk.MustAccAddressFromBech32(d.Address)) {
+				return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized,
+					"account is not allowed to receive funds: %s", d.Address,
+				)
+			}
+		}
+	}
+
+	// Get clawback vesting account
+	va, err := k.GetClawbackVestingAccount(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if account has any vesting or lockup periods
+	if len(va.VestingPeriods) == 0 && len(va.LockupPeriods) == 0 {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "account %s has no vesting or lockup periods", msg.AccountAddress)
+	}
+
+	// Check to see if it's a governance proposal clawback
+	if isGovClawback {
+		if k.HasGovClawbackDisabled(ctx, addr) {
+			return nil, errorsmod.Wrap(types.ErrNotSubjectToGovClawback, addr.String())
+		}
+
+		// Check if account funder or manager is same as in msg
+	} else if !va.IsFunderOrManager(msg.FunderAddress) {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized, "clawback can only be requested by original funder or manager: %s", va.FunderAddress)
+	} else if k.HasClawbackRenounced(ctx, addr) {
+		return nil, errorsmod.Wrap(types.ErrClawbackRenounced, addr.String())
+	} else if va.FunderAddress != msg.FunderAddress {
+		// the manager cannot redirect the clawback proceeds away from the funder
+		if msg.Burn || len(msg.Destinations) > 0 || (msg.DestAddress != "" && msg.DestAddress != va.FunderAddress) {
+			return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized, "clawback requested by the manager can only be sent to the funder: %s", va.FunderAddress)
+		}
+
+		destinations = []types.ClawbackDestination{{Address: va.FunderAddress, Weight: 1}}
+	}
+
+	// Perform clawback transfer
+	shares, err := k.transferClawback(ctx, *va, destinations)
+	if err != nil {
+		return nil, err
+	}
+
+	clawedBack := sdk.NewCoins()
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyFunder, msg.FunderAddress),
+		sdk.NewAttribute(types.AttributeKeyAccount, msg.AccountAddress),
+	}
+	for i, d := range destinations {
+		clawedBack = clawedBack.Add(shares[i]...)
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyDestination, d.Address))
+
+		if shares[i].IsZero() {
+			continue
+		}
+
+		if err = k.Hooks().AfterClawback(ctx, addr, sdk.MustAccAddressFromBech32(d.Address), shares[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	// the clawback converts the account into the chain's default account type
+	if err = k.Hooks().AfterVestingAccountConverted(ctx, addr); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(types.EventTypeClawback, attributes...),
+		},
+	)
+
+	if msg.Burn {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeBurnClawback,
+				sdk.NewAttribute(types.AttributeKeyAccount, msg.AccountAddress),
+				sdk.NewAttribute(types.AttributeKeyCoins, clawedBack.String()),
+			),
+		)
+	}
+
+	return clawedBack, nil
+}
+
+// transferClawback transfers unvested tokens in a ClawbackVestingAccount to
+// the destination addresses, split according to their weights. The account is
+// converted back to the chain's default account type and its entries are removed
+// from the module store, including the governance clawback entry if it exists.
+// It returns the amount clawed back to each destination.
+func (k Keeper) transferClawback(
+	ctx sdk.Context,
+	vestingAccount types.ClawbackVestingAccount,
+	destinations []types.ClawbackDestination,
+) ([]sdk.Coins, error) {
+	// Compute clawback amount, unlock unvested tokens and remove future vesting events
+	updatedAcc, toClawBack := vestingAccount.ComputeClawback(ctx.BlockTime().Unix())
+	// Returns an error if there is nothing to clawback (e.g. all tokens are vested)
+	if toClawBack.IsZero() {
+		return nil, errorsmod.Wrapf(types.ErrNothingToClawback, "account %s", vestingAccount.GetAddress())
+	}
+
+	// convert the account back to the chain's default account type
+	//
+	// NOTE: this is necessary to allow the bank keeper to send the locked coins away to the
+	// destination address. If the account is not converted, the coins will still be seen as locked,
+	// and can therefore not be transferred.
+	k.removeVestingAccount(ctx, &vestingAccount)
+
+	address := updatedAcc.GetAddress()
+
+	shares := types.SplitClawback(toClawBack, destinations)
+	for i, d := range destinations {
+		if shares[i].IsZero() {
+			continue
+		}
+
+		// NOTE: don't use `SpendableCoins` to get the minimum value to clawback since
+		// the amount is retrieved from `ComputeClawback`, which ensures correctness.
+		// `SpendableCoins` can result in gas exhaustion if the user has too many
+		// different denoms (because of store iteration).
+
+		// Transfer clawback to the destination, including delegated and unbonding
+		// tokens if the balance is insufficient
+		if err := k.sendClawback(ctx, address, sdk.MustAccAddressFromBech32(d.Address), shares[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return shares, nil
+}
+
+// tryExecuteCommitteeProposal executes the given funder committee proposal and
+// removes it if the threshold of the committee is reached, and stores it
+// otherwise. It returns true if the proposal was executed.
+func (k Keeper) tryExecuteCommitteeProposal(
+	ctx sdk.Context,
+	committee types.FunderCommittee,
+	proposal types.CommitteeProposal,
+) (bool, error) {
+	if len(proposal.Approvals) < int(committee.Threshold) {
+		k.SetCommitteeProposal(ctx, proposal)
+		return false, nil
+	}
+
+	if err := k.executeCommitteeProposal(ctx, proposal); err != nil {
+		return false, errorsmod.Wrapf(err, "failed to execute committee proposal %d", proposal.Id)
+	}
+	k.DeleteCommitteeProposal(ctx, proposal)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExecuteCommitteeProposal,
+			sdk.NewAttribute(types.AttributeKeyID, strconv.FormatUint(proposal.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyCommittee, proposal.CommitteeAddress),
+			sdk.NewAttribute(types.AttributeKeyAction, proposal.Action.String()),
+			sdk.NewAttribute(types.AttributeKeyAccount, proposal.VestingAddress),
+		),
+	)
+
+	return true, nil
+}
+
+// burnClawback burns the clawed back coins of a vesting account by sending them
+// to the vesting module account.
+//
+// NOTE: the vesting module account requires the burner permission.
+func (k Keeper) burnClawback(ctx sdk.Context, address sdk.AccAddress, toBurn sdk.Coins) error {
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, address, types.ModuleName, toBurn); err != nil {
+		return err
+	}
 
-	// Transfer clawback to the destination (funder)
-	return k.bankKeeper.SendCoins(ctx, address, destinationAddr, toClawBack)
+	return k.bankKeeper.BurnCoins(ctx, types.ModuleName, toBurn)
 }
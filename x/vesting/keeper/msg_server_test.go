@@ -0,0 +1,498 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+
+	"github.com/evmos/vesting/x/vesting/types"
+)
+
+func (suite *KeeperTestSuite) TestClawbackSplitDestinations() {
+	funder := sdk.AccAddress("funder______________")
+	addr := sdk.AccAddress("vesting_account_____")
+	first := sdk.AccAddress("first_destination___")
+	second := sdk.AccAddress("second_destination__")
+
+	suite.createVestingAccount(funder, addr, testLockupPeriods, testVestingPeriods, false)
+	suite.delegate(addr, 600)
+
+	msg := types.NewMsgClawback(funder, addr, nil)
+	msg.Destinations = []types.ClawbackDestination{
+		types.NewClawbackDestination(first, 2),
+		types.NewClawbackDestination(second, 1),
+	}
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	_, err := suite.keeper.Clawback(suite.ctx, msg)
+	suite.Require().NoError(err)
+
+	// the first destination receives 2/3 of the unvested coins and the
+	// remainder, paid with the liquid balance before the delegations
+	suite.Require().Equal(stakeCoins(400), suite.bankKeeper.GetAllBalances(suite.ctx, first))
+	suite.Require().Equal(sdk.NewInt(267), suite.delegationTokens(first))
+	suite.Require().True(suite.bankKeeper.GetAllBalances(suite.ctx, second).IsZero())
+	suite.Require().Equal(sdk.NewInt(333), suite.delegationTokens(second))
+	suite.Require().True(suite.delegationTokens(addr).IsZero())
+	suite.Require().True(suite.bankKeeper.GetAllBalances(suite.ctx, funder).IsZero())
+
+	events := eventsOfType(suite.ctx.EventManager().Events(), types.EventTypeClawback)
+	suite.Require().Len(events, 1)
+	var destinations []string
+	for _, attr := range events[0].Attributes {
+		if attr.Key == types.AttributeKeyDestination {
+			destinations = append(destinations, attr.Value)
+		}
+	}
+	suite.Require().Equal([]string{first.String(), second.String()}, destinations)
+}
+
+func (suite *KeeperTestSuite) TestClawbackSplitDestinationsByManager() {
+	funder := sdk.AccAddress("funder______________")
+	manager := sdk.AccAddress("manager_____________")
+	addr := sdk.AccAddress("vesting_account_____")
+
+	suite.createVestingAccount(funder, addr, testLockupPeriods, testVestingPeriods, false)
+	_, err := suite.keeper.SetVestingManager(suite.ctx, types.NewMsgSetVestingManager(funder, addr, manager))
+	suite.Require().NoError(err)
+
+	msg := types.NewMsgClawback(manager, addr, nil)
+	msg.Destinations = []types.ClawbackDestination{types.NewClawbackDestination(manager, 1)}
+	_, err = suite.keeper.Clawback(suite.ctx, msg)
+	suite.Require().ErrorContains(err, "can only be sent to the funder")
+	suite.Require().Equal(stakeCoins(1000), suite.bankKeeper.GetAllBalances(suite.ctx, addr))
+}
+
+func (suite *KeeperTestSuite) TestBatchClawbackAllOrNothing() {
+	funder := sdk.AccAddress("funder______________")
+	addr1 := sdk.AccAddress("vesting_account_1___")
+	addr2 := sdk.AccAddress("vesting_account_2___")
+	disabled := sdk.AccAddress("gov_disabled________")
+
+	suite.createVestingAccount(funder, addr1, testLockupPeriods, testVestingPeriods, true)
+	suite.createVestingAccount(funder, disabled, testLockupPeriods, testVestingPeriods, false)
+	suite.createVestingAccount(funder, addr2, testLockupPeriods, testVestingPeriods, true)
+
+	_, err := suite.keeper.BatchClawback(suite.ctx, types.NewMsgBatchClawback(funder, []sdk.AccAddress{addr1}, nil, false, false))
+	suite.Require().ErrorContains(err, "invalid authority")
+
+	// the failure of the second account reverts the clawback of the first one
+	_, err = suite.keeper.BatchClawback(suite.ctx, types.NewMsgBatchClawback(
+		suite.authority, []sdk.AccAddress{addr1, disabled, addr2}, nil, false, false,
+	))
+	suite.Require().ErrorIs(err, types.ErrNotSubjectToGovClawback)
+	suite.Require().ErrorContains(err, "failed to clawback account "+disabled.String())
+
+	for _, addr := range []sdk.AccAddress{addr1, disabled, addr2} {
+		suite.Require().Equal(stakeCoins(1000), suite.getVestingAccount(addr).OriginalVesting)
+		suite.Require().Equal(stakeCoins(1000), suite.bankKeeper.GetAllBalances(suite.ctx, addr))
+	}
+	suite.Require().True(suite.distrKeeper.GetFeePoolCommunityCoins(suite.ctx).IsZero())
+
+	res, err := suite.keeper.BatchClawback(suite.ctx, types.NewMsgBatchClawback(
+		suite.authority, []sdk.AccAddress{addr1, addr2}, nil, false, false,
+	))
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.ClawbackResult{
+		{AccountAddress: addr1.String(), ClawedBack: stakeCoins(1000)},
+		{AccountAddress: addr2.String(), ClawedBack: stakeCoins(1000)},
+	}, res.Results)
+	suite.Require().Equal(sdk.NewDecCoinsFromCoins(stakeCoins(2000)...), suite.distrKeeper.GetFeePoolCommunityCoins(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestBatchClawbackBestEffort() {
+	funder := sdk.AccAddress("funder______________")
+	addr1 := sdk.AccAddress("vesting_account_1___")
+	addr2 := sdk.AccAddress("vesting_account_2___")
+	disabled := sdk.AccAddress("gov_disabled________")
+	dest := sdk.AccAddress("destination_________")
+
+	suite.createVestingAccount(funder, addr1, testLockupPeriods, testVestingPeriods, true)
+	suite.createVestingAccount(funder, disabled, testLockupPeriods, testVestingPeriods, false)
+	suite.createVestingAccount(funder, addr2, testLockupPeriods, testVestingPeriods, true)
+	suite.advanceTime(50 * time.Second)
+
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	res, err := suite.keeper.BatchClawback(suite.ctx, types.NewMsgBatchClawback(
+		suite.authority, []sdk.AccAddress{addr1, disabled, addr2}, dest, true, false,
+	))
+	suite.Require().NoError(err)
+
+	// the failed account is reported and skipped
+	suite.Require().Len(res.Results, 3)
+	suite.Require().Equal(types.ClawbackResult{AccountAddress: addr1.String(), ClawedBack: stakeCoins(500)}, res.Results[0])
+	suite.Require().Equal(disabled.String(), res.Results[1].AccountAddress)
+	suite.Require().Nil(res.Results[1].ClawedBack)
+	suite.Require().Contains(res.Results[1].Error, types.ErrNotSubjectToGovClawback.Error())
+	suite.Require().Equal(types.ClawbackResult{AccountAddress: addr2.String(), ClawedBack: stakeCoins(500)}, res.Results[2])
+
+	suite.Require().Equal(stakeCoins(1000), suite.bankKeeper.GetAllBalances(suite.ctx, dest))
+	suite.Require().Equal(stakeCoins(1000), suite.bankKeeper.GetAllBalances(suite.ctx, disabled))
+	suite.Require().Equal(stakeCoins(1000), suite.getVestingAccount(disabled).OriginalVesting)
+	for _, addr := range []sdk.AccAddress{addr1, addr2} {
+		suite.Require().Equal(stakeCoins(500), suite.bankKeeper.GetAllBalances(suite.ctx, addr))
+		_, err = suite.keeper.GetClawbackVestingAccount(suite.ctx, addr)
+		suite.Require().ErrorIs(err, types.ErrNotSubjectToClawback)
+	}
+
+	// only the events of the successful clawbacks are emitted, followed by the
+	// aggregate event of the batch
+	events := suite.ctx.EventManager().Events()
+	suite.Require().Len(eventsOfType(events, types.EventTypeClawback), 2)
+	batchEvents := eventsOfType(events, types.EventTypeBatchClawback)
+	suite.Require().Len(batchEvents, 1)
+	suite.Require().Equal([]abci.EventAttribute{
+		{Key: types.AttributeKeyDestination, Value: dest.String()},
+		{Key: types.AttributeKeyCoins, Value: "1000stake"},
+		{Key: types.AttributeKeySucceeded, Value: "2"},
+		{Key: types.AttributeKeyFailed, Value: "1"},
+	}, batchEvents[0].Attributes)
+}
+
+func (suite *KeeperTestSuite) TestSelfLockup() {
+	addr := sdk.AccAddress("vesting_account_____")
+	lockupPeriods := sdkvesting.Periods{{Length: 100, Amount: stakeCoins(600)}}
+
+	suite.fundAccount(addr, stakeCoins(1000))
+	_, err := suite.keeper.SelfLockup(suite.ctx, types.NewMsgSelfLockup(addr, lockupPeriods))
+	suite.Require().NoError(err)
+
+	// the account is converted into its own funder, with the coins vested but locked
+	va := suite.getVestingAccount(addr)
+	suite.Require().Equal(addr.String(), va.FunderAddress)
+	suite.Require().Equal(stakeCoins(600), va.OriginalVesting)
+	suite.Require().Equal([]sdk.AccAddress{addr}, suite.endTimeIndexEntries(va.EndTime))
+
+	suite.advanceTime(time.Second)
+	suite.Require().Equal(stakeCoins(600), va.GetVestedCoins(suite.ctx.BlockTime()))
+	suite.Require().Equal(stakeCoins(600), va.GetLockedUpCoins(suite.ctx.BlockTime()))
+	suite.Require().Equal(stakeCoins(400), suite.bankKeeper.SpendableCoins(suite.ctx, addr))
+
+	suite.advanceTime(99 * time.Second)
+	suite.Require().Equal(stakeCoins(1000), suite.bankKeeper.SpendableCoins(suite.ctx, addr))
+}
+
+func (suite *KeeperTestSuite) TestSelfLockupCannotBeClawedBack() {
+	addr := sdk.AccAddress("vesting_account_____")
+	lockupPeriods := sdkvesting.Periods{{Length: 100, Amount: stakeCoins(600)}}
+
+	suite.fundAccount(addr, stakeCoins(1000))
+	_, err := suite.keeper.SelfLockup(suite.ctx, types.NewMsgSelfLockup(addr, lockupPeriods))
+	suite.Require().NoError(err)
+
+	// the clawback and the governance clawback of the converted account are renounced
+	suite.Require().True(suite.keeper.HasClawbackRenounced(suite.ctx, addr))
+	suite.Require().True(suite.keeper.HasGovClawbackDisabled(suite.ctx, addr))
+
+	_, err = suite.keeper.Clawback(suite.ctx, types.NewMsgClawback(addr, addr, nil))
+	suite.Require().ErrorIs(err, types.ErrClawbackRenounced)
+	_, err = suite.keeper.Clawback(suite.ctx, types.NewMsgClawback(suite.authority, addr, nil))
+	suite.Require().ErrorIs(err, types.ErrNotSubjectToGovClawback)
+	suite.Require().Equal(stakeCoins(1000), suite.bankKeeper.GetAllBalances(suite.ctx, addr))
+}
+
+func (suite *KeeperTestSuite) TestSelfLockupSpendableBalance() {
+	addr := sdk.AccAddress("vesting_account_____")
+	lockupPeriods := func(amount int64) sdkvesting.Periods {
+		return sdkvesting.Periods{{Length: 100, Amount: stakeCoins(amount)}}
+	}
+
+	suite.fundAccount(addr, stakeCoins(1100))
+	suite.delegate(addr, 500)
+
+	// the delegated coins are not part of the spendable balance
+	_, err := suite.keeper.SelfLockup(suite.ctx, types.NewMsgSelfLockup(addr, lockupPeriods(700)))
+	suite.Require().ErrorIs(err, errortypes.ErrInsufficientFunds)
+	_, isBaseAccount := suite.accountKeeper.GetAccount(suite.ctx, addr).(*authtypes.BaseAccount)
+	suite.Require().True(isBaseAccount)
+
+	_, err = suite.keeper.SelfLockup(suite.ctx, types.NewMsgSelfLockup(addr, lockupPeriods(400)))
+	suite.Require().NoError(err)
+
+	// the locked coins cannot be locked again
+	_, err = suite.keeper.SelfLockup(suite.ctx, types.NewMsgSelfLockup(addr, lockupPeriods(300)))
+	suite.Require().ErrorIs(err, errortypes.ErrInsufficientFunds)
+
+	_, err = suite.keeper.SelfLockup(suite.ctx, types.NewMsgSelfLockup(addr, lockupPeriods(200)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(stakeCoins(600), suite.getVestingAccount(addr).OriginalVesting)
+	suite.Require().True(suite.bankKeeper.SpendableCoins(suite.ctx, addr).IsZero())
+}
+
+func (suite *KeeperTestSuite) TestFundVestingAccountFromCommunityPool() {
+	funder := sdk.AccAddress("funder______________")
+	addr := sdk.AccAddress("vesting_account_____")
+	other := sdk.AccAddress("other_account_______")
+	depositor := sdk.AccAddress("depositor___________")
+
+	suite.fundAccount(depositor, stakeCoins(2000))
+	suite.Require().NoError(suite.distrKeeper.FundCommunityPool(suite.ctx, stakeCoins(2000), depositor))
+	suite.accountKeeper.SetAccount(suite.ctx, suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr))
+	suite.createVestingAccount(funder, other, testLockupPeriods, testVestingPeriods, true)
+
+	msg := types.NewMsgFundVestingAccountFromCommunityPool(
+		suite.authority, addr, suite.ctx.BlockTime(), testLockupPeriods, testVestingPeriods,
+	)
+	_, err := suite.keeper.FundVestingAccountFromCommunityPool(suite.ctx, types.NewMsgFundVestingAccountFromCommunityPool(
+		funder, addr, suite.ctx.BlockTime(), testLockupPeriods, testVestingPeriods,
+	))
+	suite.Require().ErrorContains(err, "invalid authority")
+
+	// the account is converted with governance as its funder
+	_, err = suite.keeper.FundVestingAccountFromCommunityPool(suite.ctx, msg)
+	suite.Require().NoError(err)
+
+	va := suite.getVestingAccount(addr)
+	suite.Require().Equal(suite.authority.String(), va.FunderAddress)
+	suite.Require().Equal(stakeCoins(1000), va.OriginalVesting)
+	suite.Require().Equal(stakeCoins(1000), suite.bankKeeper.GetAllBalances(suite.ctx, addr))
+	suite.Require().Equal(sdk.NewDecCoinsFromCoins(stakeCoins(1000)...), suite.distrKeeper.GetFeePoolCommunityCoins(suite.ctx))
+	suite.Require().False(suite.keeper.HasGovClawbackDisabled(suite.ctx, addr))
+
+	// the existing account is funded again
+	_, err = suite.keeper.FundVestingAccountFromCommunityPool(suite.ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(stakeCoins(2000), suite.getVestingAccount(addr).OriginalVesting)
+	suite.Require().Equal(stakeCoins(2000), suite.bankKeeper.GetAllBalances(suite.ctx, addr))
+	suite.Require().True(suite.distrKeeper.GetFeePoolCommunityCoins(suite.ctx).IsZero())
+
+	// accounts of other funders cannot be funded
+	_, err = suite.keeper.FundVestingAccountFromCommunityPool(suite.ctx, types.NewMsgFundVestingAccountFromCommunityPool(
+		suite.authority, other, suite.ctx.BlockTime(), testLockupPeriods, testVestingPeriods,
+	))
+	suite.Require().ErrorContains(err, "can only accept grants from account "+funder.String())
+}
+
+func (suite *KeeperTestSuite) TestGovClawbackOfCommunityPoolFunding() {
+	addr := sdk.AccAddress("vesting_account_____")
+	depositor := sdk.AccAddress("depositor___________")
+
+	suite.fundAccount(depositor, stakeCoins(1000))
+	suite.Require().NoError(suite.distrKeeper.FundCommunityPool(suite.ctx, stakeCoins(1000), depositor))
+	suite.accountKeeper.SetAccount(suite.ctx, suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr))
+
+	_, err := suite.keeper.FundVestingAccountFromCommunityPool(suite.ctx, types.NewMsgFundVestingAccountFromCommunityPool(
+		suite.authority, addr, suite.ctx.BlockTime(), testLockupPeriods, testVestingPeriods,
+	))
+	suite.Require().NoError(err)
+
+	// the unvested coins are returned to the community pool
+	suite.advanceTime(50 * time.Second)
+	_, err = suite.keeper.Clawback(suite.ctx, types.NewMsgClawback(suite.authority, addr, nil))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDecCoinsFromCoins(stakeCoins(500)...), suite.distrKeeper.GetFeePoolCommunityCoins(suite.ctx))
+	suite.Require().Equal(stakeCoins(500), suite.bankKeeper.GetAllBalances(suite.ctx, addr))
+}
+
+func (suite *KeeperTestSuite) TestRecoverVestingAccount() {
+	funder := sdk.AccAddress("funder______________")
+	addr := sdk.AccAddress("vesting_account_____")
+	newAddr := sdk.AccAddress("new_address_________")
+
+	va := suite.createVestingAccount(funder, addr, testLockupPeriods, testVestingPeriods, false)
+	suite.delegate(addr, 400)
+	// keep the validator from being removed when the delegation is transferred
+	suite.fundAccount(funder, stakeCoins(100))
+	suite.delegate(funder, 100)
+	suite.advanceTime(60 * time.Second)
+	suite.accountKeeper.SetAccount(suite.ctx, suite.accountKeeper.NewAccountWithAddress(suite.ctx, newAddr))
+
+	_, err := suite.keeper.RecoverVestingAccount(suite.ctx, types.NewMsgRecoverVestingAccount(funder, addr, newAddr))
+	suite.Require().ErrorContains(err, "invalid authority")
+
+	res, err := suite.keeper.RecoverVestingAccount(suite.ctx, types.NewMsgRecoverVestingAccount(suite.authority, addr, newAddr))
+	suite.Require().NoError(err)
+	suite.Require().Equal(stakeCoins(600), res.Coins)
+
+	// the balance and delegations are transferred to the new address
+	suite.Require().True(suite.bankKeeper.GetAllBalances(suite.ctx, addr).IsZero())
+	suite.Require().True(suite.delegationTokens(addr).IsZero())
+	suite.Require().Equal(stakeCoins(600), suite.bankKeeper.GetAllBalances(suite.ctx, newAddr))
+	suite.Require().Equal(sdk.NewInt(400), suite.delegationTokens(newAddr))
+
+	// the new address keeps the schedule, so the coins remain unvested and locked
+	recovered := suite.getVestingAccount(newAddr)
+	suite.Require().Equal(funder.String(), recovered.FunderAddress)
+	suite.Require().Equal(va.GetStartTime(), recovered.GetStartTime())
+	suite.Require().Equal(va.EndTime, recovered.EndTime)
+	suite.Require().Equal(testLockupPeriods, recovered.LockupPeriods)
+	suite.Require().Equal(testVestingPeriods, recovered.VestingPeriods)
+	suite.Require().Equal(stakeCoins(1000), recovered.OriginalVesting)
+	suite.Require().Equal(stakeCoins(500), recovered.GetVestingCoins(suite.ctx.BlockTime()))
+	suite.Require().Equal(stakeCoins(1000), recovered.GetLockedUpCoins(suite.ctx.BlockTime()))
+	suite.Require().True(suite.keeper.HasGovClawbackDisabled(suite.ctx, newAddr))
+
+	_, err = suite.keeper.GetClawbackVestingAccount(suite.ctx, addr)
+	suite.Require().Error(err)
+	suite.Require().Equal([]sdk.AccAddress{newAddr}, suite.endTimeIndexEntries(recovered.EndTime))
+}
+
+func (suite *KeeperTestSuite) TestRecoverVestingAccountMigratesGrantsAndInstructions() {
+	funder := sdk.AccAddress("funder______________")
+	addr := sdk.AccAddress("vesting_account_____")
+	newAddr := sdk.AccAddress("new_address_________")
+
+	suite.createVestingAccount(funder, addr, testLockupPeriods, testVestingPeriods, false)
+	suite.fundAccount(funder, stakeCoins(1000))
+	grantRes, err := suite.keeper.CreateEscrowGrant(suite.ctx, types.NewMsgCreateEscrowGrant(
+		funder, addr, suite.ctx.BlockTime(), nil, testVestingPeriods,
+	))
+	suite.Require().NoError(err)
+	instruction := suite.createFundingInstruction(funder, addr)
+	suite.accountKeeper.SetAccount(suite.ctx, suite.accountKeeper.NewAccountWithAddress(suite.ctx, newAddr))
+
+	_, err = suite.keeper.RecoverVestingAccount(suite.ctx, types.NewMsgRecoverVestingAccount(suite.authority, addr, newAddr))
+	suite.Require().NoError(err)
+
+	grant, found := suite.keeper.GetEscrowGrant(suite.ctx, grantRes.Id)
+	suite.Require().True(found)
+	suite.Require().Equal(newAddr.String(), grant.RecipientAddress)
+
+	grants, err := suite.keeper.EscrowGrants(suite.ctx, &types.QueryEscrowGrantsRequest{Address: addr.String()})
+	suite.Require().NoError(err)
+	suite.Require().Empty(grants.Grants)
+	grants, err = suite.keeper.EscrowGrants(suite.ctx, &types.QueryEscrowGrantsRequest{Address: newAddr.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.EscrowGrant{grant}, grants.Grants)
+
+	// the funding instruction grants the coins to the new address
+	instruction, found = suite.keeper.GetFundingInstruction(suite.ctx, instruction.Id)
+	suite.Require().True(found)
+	suite.Require().Equal(newAddr.String(), instruction.VestingAddress)
+
+	suite.fundAccount(funder, stakeCoins(100))
+	suite.Require().Len(eventsOfType(suite.endBlock(), types.EventTypeExecuteFundingInstruction), 1)
+	suite.Require().Equal(stakeCoins(1100), suite.getVestingAccount(newAddr).OriginalVesting)
+}
+
+func (suite *KeeperTestSuite) TestRecoverVestingAccountInvalidNewAddress() {
+	funder := sdk.AccAddress("funder______________")
+	addr := sdk.AccAddress("vesting_account_____")
+	other := sdk.AccAddress("other_vesting_______")
+	newAddr := sdk.AccAddress("new_address_________")
+
+	suite.createVestingAccount(funder, addr, testLockupPeriods, testVestingPeriods, false)
+	suite.createVestingAccount(funder, other, testLockupPeriods, testVestingPeriods, false)
+
+	_, err := suite.keeper.RecoverVestingAccount(suite.ctx, types.NewMsgRecoverVestingAccount(suite.authority, addr, newAddr))
+	suite.Require().ErrorContains(err, "does not exist")
+
+	_, err = suite.keeper.RecoverVestingAccount(suite.ctx, types.NewMsgRecoverVestingAccount(suite.authority, addr, other))
+	suite.Require().ErrorContains(err, "is already a vesting account")
+
+	suite.accountKeeper.SetAccount(suite.ctx, suite.accountKeeper.NewAccountWithAddress(suite.ctx, newAddr))
+	suite.fundAccount(newAddr, stakeCoins(100))
+	suite.delegate(newAddr, 100)
+	_, err = suite.keeper.RecoverVestingAccount(suite.ctx, types.NewMsgRecoverVestingAccount(suite.authority, addr, newAddr))
+	suite.Require().ErrorContains(err, "has delegations")
+
+	suite.Require().Equal(stakeCoins(1000), suite.getVestingAccount(addr).OriginalVesting)
+}
+
+func (suite *KeeperTestSuite) TestMergeVestingAccounts() {
+	funder := sdk.AccAddress("funder______________")
+	source := sdk.AccAddress("source_account______")
+	target := sdk.AccAddress("target_account______")
+
+	targetAcc := suite.createVestingAccount(funder, target, testLockupPeriods, testVestingPeriods, false)
+	suite.createVestingAccount(funder, source, testLockupPeriods, testVestingPeriods, false)
+	suite.delegate(source, 400)
+	// keep the validator from being removed when the delegation is transferred
+	suite.fundAccount(funder, stakeCoins(100))
+	suite.delegate(funder, 100)
+
+	hooks := &mockHooks{}
+	suite.keeper.SetHooks(hooks)
+
+	res, err := suite.keeper.MergeVestingAccounts(suite.ctx, types.NewMsgMergeVestingAccounts(funder, source, target))
+	suite.Require().NoError(err)
+	suite.Require().Equal(stakeCoins(600), res.Coins)
+
+	// the balance and delegations of the source are transferred to the target
+	suite.Require().True(suite.bankKeeper.GetAllBalances(suite.ctx, source).IsZero())
+	suite.Require().True(suite.delegationTokens(source).IsZero())
+	suite.Require().Equal(stakeCoins(1600), suite.bankKeeper.GetAllBalances(suite.ctx, target))
+	suite.Require().Equal(sdk.NewInt(400), suite.delegationTokens(target))
+
+	// the schedules are merged
+	merged := suite.getVestingAccount(target)
+	startTime := targetAcc.GetStartTime()
+	suite.Require().Equal(stakeCoins(2000), merged.OriginalVesting)
+	suite.Require().Equal(stakeCoins(400), merged.DelegatedVesting)
+	suite.Require().Equal(targetAcc.EndTime, merged.EndTime)
+	suite.Require().Equal(stakeCoins(1000), merged.GetVestedCoins(time.Unix(startTime+50, 0)))
+	suite.Require().Equal(stakeCoins(2000), merged.GetVestedCoins(time.Unix(startTime+100, 0)))
+	suite.Require().Equal(stakeCoins(2000), merged.GetLockedUpCoins(time.Unix(startTime+99, 0)))
+
+	_, err = suite.keeper.GetClawbackVestingAccount(suite.ctx, source)
+	suite.Require().Error(err)
+
+	suite.Require().Equal([]string{
+		fmt.Sprintf("converted(%s)", source),
+		fmt.Sprintf("funded(%s,%s,1000stake)", target, funder),
+	}, hooks.calls)
+}
+
+func (suite *KeeperTestSuite) TestMergeVestingAccountsMigratesGrantsAndInstructions() {
+	funder := sdk.AccAddress("funder______________")
+	source := sdk.AccAddress("source_account______")
+	target := sdk.AccAddress("target_account______")
+
+	suite.createVestingAccount(funder, target, testLockupPeriods, testVestingPeriods, false)
+	suite.createVestingAccount(funder, source, testLockupPeriods, testVestingPeriods, false)
+	suite.fundAccount(funder, stakeCoins(1000))
+	grantRes, err := suite.keeper.CreateEscrowGrant(suite.ctx, types.NewMsgCreateEscrowGrant(
+		funder, source, suite.ctx.BlockTime(), nil, testVestingPeriods,
+	))
+	suite.Require().NoError(err)
+	instruction := suite.createFundingInstruction(funder, source)
+
+	_, err = suite.keeper.MergeVestingAccounts(suite.ctx, types.NewMsgMergeVestingAccounts(funder, source, target))
+	suite.Require().NoError(err)
+
+	grant, found := suite.keeper.GetEscrowGrant(suite.ctx, grantRes.Id)
+	suite.Require().True(found)
+	suite.Require().Equal(target.String(), grant.RecipientAddress)
+
+	grants, err := suite.keeper.EscrowGrants(suite.ctx, &types.QueryEscrowGrantsRequest{Address: target.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.EscrowGrant{grant}, grants.Grants)
+
+	// the funding instruction grants the coins to the target account
+	instruction, found = suite.keeper.GetFundingInstruction(suite.ctx, instruction.Id)
+	suite.Require().True(found)
+	suite.Require().Equal(target.String(), instruction.VestingAddress)
+
+	suite.fundAccount(funder, stakeCoins(100))
+	suite.Require().Len(eventsOfType(suite.endBlock(), types.EventTypeExecuteFundingInstruction), 1)
+	suite.Require().Equal(stakeCoins(2100), suite.getVestingAccount(target).OriginalVesting)
+}
+
+func (suite *KeeperTestSuite) TestMergeVestingAccountsRejected() {
+	funder := sdk.AccAddress("funder______________")
+	otherFunder := sdk.AccAddress("other_funder________")
+	source := sdk.AccAddress("source_account______")
+	target := sdk.AccAddress("target_account______")
+	other := sdk.AccAddress("other_account_______")
+
+	suite.createVestingAccount(funder, target, testLockupPeriods, testVestingPeriods, false)
+	suite.createVestingAccount(funder, source, testLockupPeriods, testVestingPeriods, false)
+	suite.createVestingAccount(otherFunder, other, testLockupPeriods, testVestingPeriods, false)
+
+	_, err := suite.keeper.MergeVestingAccounts(suite.ctx, types.NewMsgMergeVestingAccounts(funder, other, target))
+	suite.Require().ErrorContains(err, "must both be funded by")
+
+	_, err = suite.keeper.ProposeScheduleAmendment(suite.ctx, types.NewMsgProposeScheduleAmendment(
+		funder, source, nil, sdkvesting.Periods{{Length: 200, Amount: stakeCoins(1000)}},
+	))
+	suite.Require().NoError(err)
+	_, err = suite.keeper.MergeVestingAccounts(suite.ctx, types.NewMsgMergeVestingAccounts(funder, source, target))
+	suite.Require().ErrorContains(err, "pending schedule amendment")
+
+	suite.Require().Equal(stakeCoins(1000), suite.getVestingAccount(source).OriginalVesting)
+	suite.Require().Equal(stakeCoins(1000), suite.getVestingAccount(target).OriginalVesting)
+}
@@ -0,0 +1,36 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/vesting/x/vesting/types"
+)
+
+// SetVestingPaused records the pause time of the given vesting account.
+func (k Keeper) SetVestingPaused(ctx sdk.Context, addr sdk.AccAddress, pausedAt int64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixVestingPaused)
+	store.Set(addr.Bytes(), sdk.Uint64ToBigEndian(uint64(pausedAt)))
+}
+
+// DeleteVestingPaused removes the paused entry of the given vesting account address.
+func (k Keeper) DeleteVestingPaused(ctx sdk.Context, addr sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixVestingPaused)
+	store.Delete(addr.Bytes())
+}
+
+// IterateVestingPaused iterates over the paused vesting accounts and performs a
+// callback function with their address and pause time.
+func (k Keeper) IterateVestingPaused(ctx sdk.Context, cb func(addr sdk.AccAddress, pausedAt int64) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixVestingPaused)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(sdk.AccAddress(iterator.Key()), int64(sdk.BigEndianToUint64(iterator.Value()))) {
+			break
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+
+	"github.com/evmos/vesting/x/vesting/types"
+)
+
+func (suite *KeeperTestSuite) TestPauseRejectsScheduleChanges() {
+	funder := sdk.AccAddress("funder______________")
+	addr := sdk.AccAddress("vesting_account_____")
+
+	suite.createVestingAccount(funder, addr, testLockupPeriods, testVestingPeriods, false)
+	_, err := suite.keeper.PauseVesting(suite.ctx, types.NewMsgPauseVesting(suite.authority, addr))
+	suite.Require().NoError(err)
+
+	suite.fundAccount(funder, stakeCoins(1000))
+
+	_, err = suite.keeper.FundVestingAccount(suite.ctx, types.NewMsgFundVestingAccount(
+		funder, addr, suite.ctx.BlockTime(), testLockupPeriods, testVestingPeriods,
+	))
+	suite.Require().ErrorContains(err, "is paused")
+
+	_, err = suite.keeper.CreateFundingInstruction(suite.ctx, types.NewMsgCreateFundingInstruction(
+		funder, addr, suite.ctx.BlockTime(), 100, 2, testLockupPeriods,
+	))
+	suite.Require().ErrorContains(err, "is paused")
+
+	_, err = suite.keeper.ProposeScheduleAmendment(suite.ctx, types.NewMsgProposeScheduleAmendment(
+		funder, addr, testLockupPeriods, testVestingPeriods,
+	))
+	suite.Require().ErrorContains(err, "is paused")
+
+	va := suite.getVestingAccount(addr)
+	suite.Require().Equal(stakeCoins(1000), va.OriginalVesting)
+	suite.Require().Equal(stakeCoins(1000), suite.bankKeeper.GetAllBalances(suite.ctx, funder))
+}
+
+func (suite *KeeperTestSuite) TestPauseRejectsCommunityPoolFunding() {
+	addr := sdk.AccAddress("vesting_account_____")
+	depositor := sdk.AccAddress("depositor___________")
+
+	suite.fundAccount(depositor, stakeCoins(2000))
+	suite.Require().NoError(suite.distrKeeper.FundCommunityPool(suite.ctx, stakeCoins(2000), depositor))
+	suite.accountKeeper.SetAccount(suite.ctx, suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr))
+
+	msg := types.NewMsgFundVestingAccountFromCommunityPool(
+		suite.authority, addr, suite.ctx.BlockTime(), testLockupPeriods, testVestingPeriods,
+	)
+	_, err := suite.keeper.FundVestingAccountFromCommunityPool(suite.ctx, msg)
+	suite.Require().NoError(err)
+
+	_, err = suite.keeper.PauseVesting(suite.ctx, types.NewMsgPauseVesting(suite.authority, addr))
+	suite.Require().NoError(err)
+
+	_, err = suite.keeper.FundVestingAccountFromCommunityPool(suite.ctx, msg)
+	suite.Require().ErrorContains(err, "is paused")
+	suite.Require().Equal(stakeCoins(1000), suite.getVestingAccount(addr).OriginalVesting)
+}
+
+func (suite *KeeperTestSuite) TestUnlockVestingEndTime() {
+	funder := sdk.AccAddress("funder______________")
+	addr := sdk.AccAddress("vesting_account_____")
+
+	lockupPeriods := sdkvesting.Periods{{Length: 200, Amount: stakeCoins(1000)}}
+	va := suite.createVestingAccount(funder, addr, lockupPeriods, testVestingPeriods, false)
+	suite.Require().Equal(va.GetStartTime()+200, va.EndTime)
+	suite.advanceTime(10 * time.Second)
+
+	_, err := suite.keeper.UnlockVesting(suite.ctx, types.NewMsgUnlockVesting(suite.authority, []sdk.AccAddress{addr}))
+	suite.Require().NoError(err)
+
+	// the end time is the end of the vesting schedule once everything is unlocked
+	va = suite.getVestingAccount(addr)
+	suite.Require().Equal(va.GetStartTime()+100, va.EndTime)
+	suite.Require().True(va.GetLockedUpCoins(suite.ctx.BlockTime()).IsZero())
+	suite.Require().Equal(stakeCoins(1000), va.GetVestingCoins(suite.ctx.BlockTime()))
+}
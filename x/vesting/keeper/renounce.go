@@ -0,0 +1,50 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/vesting/x/vesting/types"
+)
+
+// HasClawbackRenounced checks if the funder of the given account renounced the clawback.
+//
+// If an entry exists in the KV store for the given account, the account is NOT subject
+// to clawback from its funder or manager.
+func (k Keeper) HasClawbackRenounced(ctx sdk.Context, addr sdk.AccAddress) bool {
+	//nolint:gocritic
+	key := append(types.KeyPrefixClawbackRenounced, addr.Bytes()...)
+	return ctx.KVStore(k.storeKey).Has(key)
+}
+
+// SetClawbackRenounced records that the funder of the given vesting account
+// renounced the clawback.
+func (k Keeper) SetClawbackRenounced(ctx sdk.Context, addr sdk.AccAddress) {
+	//nolint:gocritic
+	key := append(types.KeyPrefixClawbackRenounced, addr.Bytes()...)
+	ctx.KVStore(k.storeKey).Set(key, []byte{0x01})
+}
+
+// DeleteClawbackRenounced removes the clawback renounced entry of the given
+// vesting account address.
+func (k Keeper) DeleteClawbackRenounced(ctx sdk.Context, addr sdk.AccAddress) {
+	//nolint:gocritic
+	key := append(types.KeyPrefixClawbackRenounced, addr.Bytes()...)
+	ctx.KVStore(k.storeKey).Delete(key)
+}
+
+// IterateClawbackRenounced iterates over the addresses of the vesting accounts
+// whose funder renounced the clawback and performs a callback function.
+func (k Keeper) IterateClawbackRenounced(ctx sdk.Context, cb func(addr sdk.AccAddress) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixClawbackRenounced)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(sdk.AccAddress(iterator.Key())) {
+			break
+		}
+	}
+}
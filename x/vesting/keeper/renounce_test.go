@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/vesting/x/vesting/types"
+)
+
+func (suite *KeeperTestSuite) TestClawbackAfterRenounce() {
+	funder := sdk.AccAddress("funder______________")
+	manager := sdk.AccAddress("manager_____________")
+	addr := sdk.AccAddress("vesting_account_____")
+
+	suite.createVestingAccount(funder, addr, testLockupPeriods, testVestingPeriods, true)
+	_, err := suite.keeper.SetVestingManager(suite.ctx, types.NewMsgSetVestingManager(funder, addr, manager))
+	suite.Require().NoError(err)
+
+	_, err = suite.keeper.RenounceClawback(suite.ctx, types.NewMsgRenounceClawback(manager, addr, false))
+	suite.Require().ErrorContains(err, "is not the current funder")
+
+	_, err = suite.keeper.RenounceClawback(suite.ctx, types.NewMsgRenounceClawback(funder, addr, false))
+	suite.Require().NoError(err)
+
+	// neither the funder nor the manager can claw back the account
+	_, err = suite.keeper.Clawback(suite.ctx, types.NewMsgClawback(funder, addr, nil))
+	suite.Require().ErrorIs(err, types.ErrClawbackRenounced)
+	_, err = suite.keeper.Clawback(suite.ctx, types.NewMsgClawback(manager, addr, nil))
+	suite.Require().ErrorIs(err, types.ErrClawbackRenounced)
+
+	suite.Require().Equal(stakeCoins(1000), suite.bankKeeper.GetAllBalances(suite.ctx, addr))
+	suite.Require().Equal(stakeCoins(1000), suite.getVestingAccount(addr).OriginalVesting)
+
+	_, err = suite.keeper.RenounceClawback(suite.ctx, types.NewMsgRenounceClawback(funder, addr, false))
+	suite.Require().ErrorIs(err, types.ErrClawbackRenounced)
+}
+
+func (suite *KeeperTestSuite) TestGovClawbackAfterRenounce() {
+	funder := sdk.AccAddress("funder______________")
+	addr := sdk.AccAddress("vesting_account_____")
+
+	suite.createVestingAccount(funder, addr, testLockupPeriods, testVestingPeriods, true)
+	_, err := suite.keeper.RenounceClawback(suite.ctx, types.NewMsgRenounceClawback(funder, addr, false))
+	suite.Require().NoError(err)
+
+	// governance keeps its clawback when only the funder's clawback is renounced
+	_, err = suite.keeper.Clawback(suite.ctx, types.NewMsgClawback(suite.authority, addr, nil))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDecCoinsFromCoins(stakeCoins(1000)...), suite.distrKeeper.GetFeePoolCommunityCoins(suite.ctx))
+	suite.Require().True(suite.bankKeeper.GetAllBalances(suite.ctx, addr).IsZero())
+	suite.Require().False(suite.keeper.HasClawbackRenounced(suite.ctx, addr))
+}
+
+func (suite *KeeperTestSuite) TestGovClawbackAfterRenounceWithGov() {
+	funder := sdk.AccAddress("funder______________")
+	addr := sdk.AccAddress("vesting_account_____")
+
+	suite.createVestingAccount(funder, addr, testLockupPeriods, testVestingPeriods, true)
+	_, err := suite.keeper.RenounceClawback(suite.ctx, types.NewMsgRenounceClawback(funder, addr, false))
+	suite.Require().NoError(err)
+
+	// the governance clawback can still be renounced after the funder's one
+	_, err = suite.keeper.RenounceClawback(suite.ctx, types.NewMsgRenounceClawback(funder, addr, true))
+	suite.Require().NoError(err)
+
+	_, err = suite.keeper.Clawback(suite.ctx, types.NewMsgClawback(suite.authority, addr, nil))
+	suite.Require().ErrorIs(err, types.ErrNotSubjectToGovClawback)
+	_, err = suite.keeper.Clawback(suite.ctx, types.NewMsgClawback(funder, addr, nil))
+	suite.Require().ErrorIs(err, types.ErrClawbackRenounced)
+
+	suite.Require().Equal(stakeCoins(1000), suite.bankKeeper.GetAllBalances(suite.ctx, addr))
+	suite.Require().True(suite.distrKeeper.GetFeePoolCommunityCoins(suite.ctx).IsZero())
+}
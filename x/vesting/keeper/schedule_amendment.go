@@ -0,0 +1,56 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/vesting/x/vesting/types"
+)
+
+// GetScheduleAmendment returns the pending schedule amendment of the given
+// vesting account, if any.
+func (k Keeper) GetScheduleAmendment(ctx sdk.Context, addr sdk.AccAddress) (amendment types.ScheduleAmendment, found bool) {
+	//nolint:gocritic
+	key := append(types.KeyPrefixScheduleAmendment, addr.Bytes()...)
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if bz == nil {
+		return amendment, false
+	}
+	k.cdc.MustUnmarshal(bz, &amendment)
+	return amendment, true
+}
+
+// SetScheduleAmendment stores the pending schedule amendment of a vesting
+// account, replacing the previous one.
+func (k Keeper) SetScheduleAmendment(ctx sdk.Context, addr sdk.AccAddress, amendment types.ScheduleAmendment) {
+	//nolint:gocritic
+	key := append(types.KeyPrefixScheduleAmendment, addr.Bytes()...)
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshal(&amendment))
+}
+
+// DeleteScheduleAmendment removes the pending schedule amendment of the given
+// vesting account address.
+func (k Keeper) DeleteScheduleAmendment(ctx sdk.Context, addr sdk.AccAddress) {
+	//nolint:gocritic
+	key := append(types.KeyPrefixScheduleAmendment, addr.Bytes()...)
+	ctx.KVStore(k.storeKey).Delete(key)
+}
+
+// IterateScheduleAmendments iterates over the pending schedule amendments and
+// performs a callback function.
+func (k Keeper) IterateScheduleAmendments(ctx sdk.Context, cb func(amendment types.ScheduleAmendment) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixScheduleAmendment)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var amendment types.ScheduleAmendment
+		k.cdc.MustUnmarshal(iterator.Value(), &amendment)
+
+		if cb(amendment) {
+			break
+		}
+	}
+}
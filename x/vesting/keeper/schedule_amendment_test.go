@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+
+	"github.com/evmos/vesting/x/vesting/types"
+)
+
+func (suite *KeeperTestSuite) TestAcceptScheduleAmendment() {
+	funder := sdk.AccAddress("funder______________")
+	addr := sdk.AccAddress("vesting_account_____")
+
+	va := suite.createVestingAccount(funder, addr, testLockupPeriods, testVestingPeriods, false)
+	startTime := va.GetStartTime()
+	suite.advanceTime(60 * time.Second)
+
+	// the remaining unvested coins vest 100 seconds after the amendment
+	amendedPeriods := sdkvesting.Periods{{Length: 100, Amount: stakeCoins(500)}}
+	_, err := suite.keeper.ProposeScheduleAmendment(suite.ctx, types.NewMsgProposeScheduleAmendment(funder, addr, nil, amendedPeriods))
+	suite.Require().NoError(err)
+
+	// the schedule is not amended until the vesting account accepts it
+	suite.Require().Equal(testVestingPeriods, suite.getVestingAccount(addr).VestingPeriods)
+
+	_, err = suite.keeper.AcceptScheduleAmendment(suite.ctx, types.NewMsgAcceptScheduleAmendment(addr))
+	suite.Require().NoError(err)
+
+	va = suite.getVestingAccount(addr)
+	suite.Require().Equal(sdkvesting.Periods{
+		{Length: 50, Amount: stakeCoins(500)},
+		{Length: 110, Amount: stakeCoins(500)},
+	}, va.VestingPeriods)
+	suite.Require().Equal(testLockupPeriods, va.LockupPeriods)
+	suite.Require().Equal(stakeCoins(1000), va.OriginalVesting)
+	suite.Require().Equal(startTime+160, va.EndTime)
+
+	suite.Require().Equal(stakeCoins(500), va.GetVestedCoins(time.Unix(startTime+159, 0)))
+	suite.Require().Equal(stakeCoins(1000), va.GetVestedCoins(time.Unix(startTime+160, 0)))
+
+	_, found := suite.keeper.GetScheduleAmendment(suite.ctx, addr)
+	suite.Require().False(found)
+	suite.Require().Empty(suite.endTimeIndexEntries(startTime + 159))
+	suite.Require().Equal([]sdk.AccAddress{addr}, suite.endTimeIndexEntries(startTime+160))
+}
+
+func (suite *KeeperTestSuite) TestProposeScheduleAmendmentAmountMismatch() {
+	funder := sdk.AccAddress("funder______________")
+	addr := sdk.AccAddress("vesting_account_____")
+
+	suite.createVestingAccount(funder, addr, testLockupPeriods, testVestingPeriods, false)
+	suite.advanceTime(60 * time.Second)
+
+	// the amendment must cover the 500 coins that are still unvested
+	_, err := suite.keeper.ProposeScheduleAmendment(suite.ctx, types.NewMsgProposeScheduleAmendment(
+		funder, addr, nil, sdkvesting.Periods{{Length: 100, Amount: stakeCoins(400)}},
+	))
+	suite.Require().ErrorContains(err, "must equal the remaining amount")
+
+	_, found := suite.keeper.GetScheduleAmendment(suite.ctx, addr)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestAcceptScheduleAmendmentAfterFunderUpdate() {
+	funder := sdk.AccAddress("funder______________")
+	newFunder := sdk.AccAddress("new_funder__________")
+	addr := sdk.AccAddress("vesting_account_____")
+
+	suite.createVestingAccount(funder, addr, testLockupPeriods, testVestingPeriods, false)
+	_, err := suite.keeper.ProposeScheduleAmendment(suite.ctx, types.NewMsgProposeScheduleAmendment(
+		funder, addr, nil, sdkvesting.Periods{{Length: 200, Amount: stakeCoins(1000)}},
+	))
+	suite.Require().NoError(err)
+
+	_, err = suite.keeper.UpdateVestingFunder(suite.ctx, types.NewMsgUpdateVestingFunder(funder, newFunder, addr))
+	suite.Require().NoError(err)
+
+	_, err = suite.keeper.AcceptScheduleAmendment(suite.ctx, types.NewMsgAcceptScheduleAmendment(addr))
+	suite.Require().ErrorContains(err, "is not the current funder")
+	suite.Require().Equal(testVestingPeriods, suite.getVestingAccount(addr).VestingPeriods)
+}
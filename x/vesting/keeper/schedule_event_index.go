@@ -0,0 +1,86 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/evmos/vesting/x/vesting/types"
+)
+
+// SetScheduleEventIndex adds the upcoming lockup and vesting events of the given
+// clawback vesting account to the schedule event index. Only the periods that
+// end at or after the current block time and have a non-zero amount are indexed.
+// The vesting events of an account whose vesting is paused are indexed when the
+// vesting is resumed.
+func (k Keeper) SetScheduleEventIndex(ctx sdk.Context, va *types.ClawbackVestingAccount) {
+	store := ctx.KVStore(k.storeKey)
+	blockTime := ctx.BlockTime().Unix()
+	addr := va.GetAddress()
+
+	setEvents := func(eventType byte, periods sdkvesting.Periods) {
+		for i, eventTime := range periodEndTimes(va.GetStartTime(), periods) {
+			if eventTime < blockTime || periods[i].Amount.IsZero() {
+				continue
+			}
+			store.Set(types.ScheduleEventIndexKey(eventTime, addr, eventType, uint64(i)), []byte{0x01})
+		}
+	}
+
+	setEvents(types.ScheduleEventTypeUnlock, va.LockupPeriods)
+	if !va.IsVestingPaused() {
+		setEvents(types.ScheduleEventTypeVest, va.VestingPeriods)
+	}
+}
+
+// DeleteScheduleEventIndex removes all the lockup and vesting events of the
+// given clawback vesting account from the schedule event index.
+func (k Keeper) DeleteScheduleEventIndex(ctx sdk.Context, va *types.ClawbackVestingAccount) {
+	store := ctx.KVStore(k.storeKey)
+	addr := va.GetAddress()
+
+	deleteEvents := func(eventType byte, periods sdkvesting.Periods) {
+		for i, eventTime := range periodEndTimes(va.GetStartTime(), periods) {
+			store.Delete(types.ScheduleEventIndexKey(eventTime, addr, eventType, uint64(i)))
+		}
+	}
+
+	deleteEvents(types.ScheduleEventTypeUnlock, va.LockupPeriods)
+	deleteEvents(types.ScheduleEventTypeVest, va.VestingPeriods)
+}
+
+// IterateScheduleEventIndex iterates over the schedule event index entries with
+// an event time lower than or equal to the given one, in ascending order of
+// event time. The iteration stops when the callback returns true.
+func (k Keeper) IterateScheduleEventIndex(
+	ctx sdk.Context,
+	eventTime int64,
+	cb func(eventTime int64, addr sdk.AccAddress, eventType byte, periodIndex uint64) (stop bool),
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixScheduleEventIndex)
+	// NOTE: the end of the range is exclusive, so use the time of the next second
+	end := sdk.Uint64ToBigEndian(uint64(eventTime + 1))
+
+	iterator := store.Iterator(nil, end)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(types.SplitScheduleEventIndexKey(iterator.Key())) {
+			break
+		}
+	}
+}
+
+// periodEndTimes returns the absolute end time of each of the given periods
+// starting at startTime.
+func periodEndTimes(startTime int64, periods sdkvesting.Periods) []int64 {
+	endTimes := make([]int64, len(periods))
+	eventTime := startTime
+	for i, period := range periods {
+		eventTime += period.Length
+		endTimes[i] = eventTime
+	}
+	return endTimes
+}
@@ -0,0 +1,89 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+
+	"github.com/evmos/vesting/x/vesting/types"
+)
+
+// ConvertSDKVestingAccount converts the x/auth/vesting account (continuous,
+// delayed or periodic) at the given address into a ClawbackVestingAccount
+// with an equivalent schedule and the given funder.
+func (k Keeper) ConvertSDKVestingAccount(
+	ctx sdk.Context,
+	addr, funder sdk.AccAddress,
+	enableGovClawback bool,
+) (*types.ClawbackVestingAccount, error) {
+	acc := k.accountKeeper.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest,
+			"account %s does not exist", addr,
+		)
+	}
+
+	if _, isClawback := acc.(*types.ClawbackVestingAccount); isClawback {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest,
+			"%s is already a clawback vesting account", addr,
+		)
+	}
+
+	vestingAcc, isVesting := acc.(vestexported.VestingAccount)
+	if !isVesting || !types.IsSDKVestingAccount(acc) {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest,
+			"%s is not a continuous, delayed or periodic vesting account", addr,
+		)
+	}
+
+	va, err := types.ConvertSDKVestingAccount(vestingAcc, funder, ctx.BlockTime())
+	if err != nil {
+		return nil, err
+	}
+
+	k.accountKeeper.SetAccount(ctx, va)
+	k.setVestingIndexes(ctx, va)
+
+	if !enableGovClawback {
+		k.SetGovClawbackDisabled(ctx, addr)
+	}
+
+	if err := k.Hooks().AfterVestingAccountCreated(ctx, addr, funder); err != nil {
+		return nil, err
+	}
+
+	return va, nil
+}
+
+// MigrateSDKVestingAccounts converts all the x/auth/vesting accounts stored in
+// the account keeper into ClawbackVestingAccounts with the given funder.
+// It is meant to be called from a chain upgrade handler and returns the number
+// of migrated accounts.
+func (k Keeper) MigrateSDKVestingAccounts(
+	ctx sdk.Context,
+	funder sdk.AccAddress,
+	enableGovClawback bool,
+) (int, error) {
+	// NOTE: collect the addresses first to avoid writing to the store while
+	// iterating over it
+	var addresses []sdk.AccAddress
+	k.accountKeeper.IterateAccounts(ctx, func(acc authtypes.AccountI) bool {
+		if types.IsSDKVestingAccount(acc) {
+			addresses = append(addresses, acc.GetAddress())
+		}
+		return false
+	})
+
+	for _, addr := range addresses {
+		if _, err := k.ConvertSDKVestingAccount(ctx, addr, funder, enableGovClawback); err != nil {
+			return 0, errorsmod.Wrapf(err, "failed to migrate vesting account %s", addr)
+		}
+	}
+
+	return len(addresses), nil
+}
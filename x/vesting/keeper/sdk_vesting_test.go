@@ -0,0 +1,173 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+
+	"github.com/evmos/vesting/x/vesting/types"
+)
+
+// setSDKVestingAccount stores the given x/auth/vesting account and funds it
+// with its original vesting coins.
+func (suite *KeeperTestSuite) setSDKVestingAccount(acc vestexported.VestingAccount) {
+	suite.accountKeeper.SetAccount(suite.ctx, suite.accountKeeper.NewAccount(suite.ctx, acc))
+	suite.fundAccount(acc.GetAddress(), acc.GetOriginalVesting())
+}
+
+func (suite *KeeperTestSuite) TestMigrateVestingAccount() {
+	funder := sdk.AccAddress("funder______________")
+	addr := sdk.AccAddress("vesting_account_____")
+	day := types.ContinuousVestingPeriodLength
+
+	testCases := []struct {
+		name       string
+		acc        func(now int64) vestexported.VestingAccount
+		expEndTime func(now int64) int64
+		expEvent   scheduleEvent
+	}{
+		{
+			name: "continuous vesting account",
+			acc: func(now int64) vestexported.VestingAccount {
+				return sdkvesting.NewContinuousVestingAccount(authtypes.NewBaseAccountWithAddress(addr), stakeCoins(1000), now, now+2*day)
+			},
+			// the coins of each day vest at its beginning
+			expEndTime: func(now int64) int64 { return now + day },
+			expEvent:   scheduleEvent{types.EventTypeVested, "1", "500stake"},
+		},
+		{
+			name: "delayed vesting account",
+			acc: func(now int64) vestexported.VestingAccount {
+				return sdkvesting.NewDelayedVestingAccount(authtypes.NewBaseAccountWithAddress(addr), stakeCoins(1000), now+100)
+			},
+			expEndTime: func(now int64) int64 { return now + 100 },
+			expEvent:   scheduleEvent{types.EventTypeVested, "0", "1000stake"},
+		},
+		{
+			name: "periodic vesting account",
+			acc: func(now int64) vestexported.VestingAccount {
+				return sdkvesting.NewPeriodicVestingAccount(authtypes.NewBaseAccountWithAddress(addr), stakeCoins(1000), now, testVestingPeriods)
+			},
+			expEndTime: func(now int64) int64 { return now + 100 },
+			expEvent:   scheduleEvent{types.EventTypeVested, "1", "500stake"},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			now := suite.ctx.BlockTime().Unix()
+			suite.setSDKVestingAccount(tc.acc(now))
+
+			_, err := suite.keeper.MigrateVestingAccount(suite.ctx, types.NewMsgMigrateVestingAccount(suite.authority, addr, funder, true))
+			suite.Require().NoError(err)
+
+			va := suite.getVestingAccount(addr)
+			suite.Require().Equal(funder.String(), va.FunderAddress)
+			suite.Require().Equal(stakeCoins(1000), va.OriginalVesting)
+			suite.Require().Equal(tc.expEndTime(now), va.EndTime)
+			suite.Require().False(suite.keeper.HasGovClawbackDisabled(suite.ctx, addr))
+
+			// the end time and schedule event indexes are written
+			suite.Require().Empty(suite.endTimeIndexEntries(va.EndTime - 1))
+			suite.Require().Equal([]sdk.AccAddress{addr}, suite.endTimeIndexEntries(va.EndTime))
+
+			suite.advanceTime(time.Duration(va.EndTime-now) * time.Second)
+			suite.Require().Contains(scheduleEvents(suite.endBlock(), addr), tc.expEvent)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMigrateVestingAccountGovClawback() {
+	funder := sdk.AccAddress("funder______________")
+	addr := sdk.AccAddress("vesting_account_____")
+	disabled := sdk.AccAddress("gov_disabled________")
+	now := suite.ctx.BlockTime().Unix()
+
+	suite.setSDKVestingAccount(sdkvesting.NewPeriodicVestingAccount(authtypes.NewBaseAccountWithAddress(addr), stakeCoins(1000), now, testVestingPeriods))
+	suite.setSDKVestingAccount(sdkvesting.NewPeriodicVestingAccount(authtypes.NewBaseAccountWithAddress(disabled), stakeCoins(1000), now, testVestingPeriods))
+
+	_, err := suite.keeper.MigrateVestingAccount(suite.ctx, types.NewMsgMigrateVestingAccount(suite.authority, addr, funder, true))
+	suite.Require().NoError(err)
+	_, err = suite.keeper.MigrateVestingAccount(suite.ctx, types.NewMsgMigrateVestingAccount(suite.authority, disabled, funder, false))
+	suite.Require().NoError(err)
+
+	// the unvested coins of the migrated account are returned to the community pool
+	suite.advanceTime(50 * time.Second)
+	_, err = suite.keeper.Clawback(suite.ctx, types.NewMsgClawback(suite.authority, addr, nil))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDecCoinsFromCoins(stakeCoins(500)...), suite.distrKeeper.GetFeePoolCommunityCoins(suite.ctx))
+	suite.Require().Equal(stakeCoins(500), suite.bankKeeper.GetAllBalances(suite.ctx, addr))
+
+	_, err = suite.keeper.Clawback(suite.ctx, types.NewMsgClawback(suite.authority, disabled, nil))
+	suite.Require().ErrorIs(err, types.ErrNotSubjectToGovClawback)
+	suite.Require().Equal(stakeCoins(1000), suite.bankKeeper.GetAllBalances(suite.ctx, disabled))
+}
+
+func (suite *KeeperTestSuite) TestMigrateVestingAccountRejected() {
+	funder := sdk.AccAddress("funder______________")
+	addr := sdk.AccAddress("vesting_account_____")
+	base := sdk.AccAddress("base_account________")
+	clawback := sdk.AccAddress("clawback_account____")
+
+	suite.setSDKVestingAccount(sdkvesting.NewDelayedVestingAccount(
+		authtypes.NewBaseAccountWithAddress(addr), stakeCoins(1000), suite.ctx.BlockTime().Unix()+100,
+	))
+	suite.fundAccount(base, stakeCoins(1000))
+	suite.createVestingAccount(funder, clawback, testLockupPeriods, testVestingPeriods, false)
+
+	_, err := suite.keeper.MigrateVestingAccount(suite.ctx, types.NewMsgMigrateVestingAccount(funder, addr, funder, true))
+	suite.Require().ErrorContains(err, "invalid authority")
+
+	_, err = suite.keeper.MigrateVestingAccount(suite.ctx, types.NewMsgMigrateVestingAccount(suite.authority, base, funder, true))
+	suite.Require().ErrorContains(err, "is not a continuous, delayed or periodic vesting account")
+
+	_, err = suite.keeper.MigrateVestingAccount(suite.ctx, types.NewMsgMigrateVestingAccount(suite.authority, clawback, funder, true))
+	suite.Require().ErrorContains(err, "is already a clawback vesting account")
+
+	_, err = suite.keeper.MigrateVestingAccount(suite.ctx, types.NewMsgMigrateVestingAccount(
+		suite.authority, sdk.AccAddress("missing_account_____"), funder, true,
+	))
+	suite.Require().ErrorContains(err, "does not exist")
+
+	_, isDelayed := suite.accountKeeper.GetAccount(suite.ctx, addr).(*sdkvesting.DelayedVestingAccount)
+	suite.Require().True(isDelayed)
+}
+
+func (suite *KeeperTestSuite) TestMigrateSDKVestingAccounts() {
+	funder := sdk.AccAddress("funder______________")
+	continuous := sdk.AccAddress("continuous_account__")
+	periodic := sdk.AccAddress("periodic_account____")
+	base := sdk.AccAddress("base_account________")
+	now := suite.ctx.BlockTime().Unix()
+
+	suite.setSDKVestingAccount(sdkvesting.NewContinuousVestingAccount(
+		authtypes.NewBaseAccountWithAddress(continuous), stakeCoins(1000), now, now+2*types.ContinuousVestingPeriodLength,
+	))
+	suite.setSDKVestingAccount(sdkvesting.NewPeriodicVestingAccount(
+		authtypes.NewBaseAccountWithAddress(periodic), stakeCoins(1000), now, testVestingPeriods,
+	))
+	suite.fundAccount(base, stakeCoins(1000))
+
+	migrated, err := suite.keeper.MigrateSDKVestingAccounts(suite.ctx, funder, false)
+	suite.Require().NoError(err)
+	suite.Require().Equal(2, migrated)
+
+	for _, addr := range []sdk.AccAddress{continuous, periodic} {
+		va := suite.getVestingAccount(addr)
+		suite.Require().Equal(funder.String(), va.FunderAddress)
+		suite.Require().True(suite.keeper.HasGovClawbackDisabled(suite.ctx, addr))
+		suite.Require().Contains(suite.endTimeIndexEntries(va.EndTime), addr)
+	}
+
+	_, isBaseAccount := suite.accountKeeper.GetAccount(suite.ctx, base).(*authtypes.BaseAccount)
+	suite.Require().True(isBaseAccount)
+
+	// the funder can claw back the unvested coins of the migrated accounts
+	_, err = suite.keeper.Clawback(suite.ctx, types.NewMsgClawback(funder, periodic, nil))
+	suite.Require().NoError(err)
+	suite.Require().Equal(stakeCoins(1000), suite.bankKeeper.GetAllBalances(suite.ctx, funder))
+}
@@ -0,0 +1,192 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/evmos/vesting/testutil"
+	"github.com/evmos/vesting/x/vesting/keeper"
+	"github.com/evmos/vesting/x/vesting/types"
+)
+
+// KeeperTestSuite is a test suite for the vesting keeper, wired with the
+// x/auth, x/bank, x/staking and x/distribution keepers on an in-memory store.
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx           sdk.Context
+	cdc           codec.Codec
+	keys          map[string]*storetypes.KVStoreKey
+	authority     sdk.AccAddress
+	accountKeeper authkeeper.AccountKeeper
+	bankKeeper    bankkeeper.BaseKeeper
+	stakingKeeper *stakingkeeper.Keeper
+	distrKeeper   distrkeeper.Keeper
+	keeper        keeper.Keeper
+	validator     stakingtypes.Validator
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+// SetupTest creates new keepers with an empty state and a single validator.
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.setupKeepers(nil)
+}
+
+// setupKeepers creates new keepers with an empty state and a single validator,
+// using the given account converter for the vesting keeper.
+func (suite *KeeperTestSuite) setupKeepers(ac types.AccountConverter) {
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	authtypes.RegisterInterfaces(registry)
+	sdkvesting.RegisterInterfaces(registry)
+	banktypes.RegisterInterfaces(registry)
+	stakingtypes.RegisterInterfaces(registry)
+	distrtypes.RegisterInterfaces(registry)
+	types.RegisterInterfaces(registry)
+	suite.cdc = codec.NewProtoCodec(registry)
+
+	suite.keys = sdk.NewKVStoreKeys(
+		authtypes.StoreKey, banktypes.StoreKey, stakingtypes.StoreKey, distrtypes.StoreKey, types.StoreKey,
+	)
+	cms := store.NewCommitMultiStore(dbm.NewMemDB())
+	for _, key := range suite.keys {
+		cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	}
+	suite.Require().NoError(cms.LoadLatestVersion())
+
+	suite.ctx = sdk.NewContext(cms, tmproto.Header{Height: 1, Time: time.Unix(1_000_000, 0).UTC()}, false, log.NewNopLogger())
+	suite.authority = authtypes.NewModuleAddress(govtypes.ModuleName)
+	authority := suite.authority.String()
+
+	maccPerms := map[string][]string{
+		authtypes.FeeCollectorName:     nil,
+		distrtypes.ModuleName:          nil,
+		minttypes.ModuleName:           {authtypes.Minter},
+		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
+		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
+		types.ModuleName:               {authtypes.Burner},
+	}
+	suite.accountKeeper = authkeeper.NewAccountKeeper(
+		suite.cdc, suite.keys[authtypes.StoreKey], authtypes.ProtoBaseAccount, maccPerms, sdk.Bech32MainPrefix, authority,
+	)
+	// module accounts are not allowed to receive funds, as in a chain app
+	blockedAddrs := make(map[string]bool, len(maccPerms))
+	for name := range maccPerms {
+		blockedAddrs[authtypes.NewModuleAddress(name).String()] = true
+	}
+	suite.bankKeeper = bankkeeper.NewBaseKeeper(suite.cdc, suite.keys[banktypes.StoreKey], suite.accountKeeper, blockedAddrs, authority)
+	suite.stakingKeeper = stakingkeeper.NewKeeper(suite.cdc, suite.keys[stakingtypes.StoreKey], suite.accountKeeper, suite.bankKeeper, authority)
+	suite.distrKeeper = distrkeeper.NewKeeper(
+		suite.cdc, suite.keys[distrtypes.StoreKey], suite.accountKeeper, suite.bankKeeper, suite.stakingKeeper, authtypes.FeeCollectorName, authority,
+	)
+	suite.keeper = keeper.NewKeeper(
+		suite.keys[types.StoreKey], suite.authority, suite.cdc,
+		suite.accountKeeper, suite.bankKeeper, suite.distrKeeper, suite.stakingKeeper, ac,
+	)
+
+	stakingParams := stakingtypes.DefaultParams()
+	stakingParams.BondDenom = testutil.StakeDenom
+	suite.Require().NoError(suite.stakingKeeper.SetParams(suite.ctx, stakingParams))
+	suite.distrKeeper.SetFeePool(suite.ctx, distrtypes.InitialFeePool())
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, types.DefaultParams()))
+
+	suite.validator = suite.createValidator()
+}
+
+// createValidator creates a new unbonded validator.
+func (suite *KeeperTestSuite) createValidator() stakingtypes.Validator {
+	pubKey := ed25519.GenPrivKey().PubKey()
+	validator, err := stakingtypes.NewValidator(sdk.ValAddress(pubKey.Address()), pubKey, stakingtypes.Description{})
+	suite.Require().NoError(err)
+
+	suite.stakingKeeper.SetValidator(suite.ctx, validator)
+	suite.Require().NoError(suite.stakingKeeper.SetValidatorByConsAddr(suite.ctx, validator))
+	suite.stakingKeeper.SetNewValidatorByPowerIndex(suite.ctx, validator)
+	return validator
+}
+
+// fundAccount mints the given coins and sends them to the given address,
+// creating the account if it doesn't exist.
+func (suite *KeeperTestSuite) fundAccount(addr sdk.AccAddress, coins sdk.Coins) {
+	if suite.accountKeeper.GetAccount(suite.ctx, addr) == nil {
+		suite.accountKeeper.SetAccount(suite.ctx, suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr))
+	}
+	suite.Require().NoError(suite.bankKeeper.MintCoins(suite.ctx, minttypes.ModuleName, coins))
+	suite.Require().NoError(suite.bankKeeper.SendCoinsFromModuleToAccount(suite.ctx, minttypes.ModuleName, addr, coins))
+}
+
+// createVestingAccount creates a clawback vesting account at the given address,
+// funded by the given funder with the given schedule starting at the current
+// block time.
+func (suite *KeeperTestSuite) createVestingAccount(
+	funder, addr sdk.AccAddress,
+	lockupPeriods, vestingPeriods sdkvesting.Periods,
+	enableGovClawback bool,
+) *types.ClawbackVestingAccount {
+	if suite.accountKeeper.GetAccount(suite.ctx, addr) == nil {
+		suite.accountKeeper.SetAccount(suite.ctx, suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr))
+	}
+	suite.fundAccount(funder, vestingPeriods.TotalAmount())
+
+	_, err := suite.keeper.CreateClawbackVestingAccount(suite.ctx, types.NewMsgCreateClawbackVestingAccount(funder, addr, enableGovClawback))
+	suite.Require().NoError(err)
+	_, err = suite.keeper.FundVestingAccount(suite.ctx, types.NewMsgFundVestingAccount(
+		funder, addr, suite.ctx.BlockTime(), lockupPeriods, vestingPeriods,
+	))
+	suite.Require().NoError(err)
+
+	return suite.getVestingAccount(addr)
+}
+
+// getVestingAccount returns the clawback vesting account at the given address.
+func (suite *KeeperTestSuite) getVestingAccount(addr sdk.AccAddress) *types.ClawbackVestingAccount {
+	va, err := suite.keeper.GetClawbackVestingAccount(suite.ctx, addr)
+	suite.Require().NoError(err)
+	return va
+}
+
+// delegate delegates the given amount of bond denom tokens of the delegator to
+// the test validator.
+func (suite *KeeperTestSuite) delegate(delegator sdk.AccAddress, amount int64) {
+	validator, found := suite.stakingKeeper.GetValidator(suite.ctx, suite.validator.GetOperator())
+	suite.Require().True(found)
+	_, err := suite.stakingKeeper.Delegate(suite.ctx, delegator, sdk.NewInt(amount), stakingtypes.Unbonded, validator, true)
+	suite.Require().NoError(err)
+}
+
+// advanceTime moves the block time forward by the given duration and
+// increments the block height.
+func (suite *KeeperTestSuite) advanceTime(d time.Duration) {
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(d)).WithBlockHeight(suite.ctx.BlockHeight() + 1)
+}
+
+// stakeCoins returns the given amount of bond denom coins.
+func stakeCoins(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(testutil.StakeDenom, amount))
+}
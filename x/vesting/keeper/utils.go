@@ -28,3 +28,18 @@ func (k Keeper) GetClawbackVestingAccount(ctx sdk.Context, addr sdk.AccAddress)
 
 	return clawbackAccount, nil
 }
+
+// setVestingIndexes adds the given clawback vesting account to the end time and
+// schedule event indexes.
+func (k Keeper) setVestingIndexes(ctx sdk.Context, va *types.ClawbackVestingAccount) {
+	k.SetEndTimeIndex(ctx, va)
+	k.SetScheduleEventIndex(ctx, va)
+}
+
+// deleteVestingIndexes removes the given clawback vesting account from the end
+// time and schedule event indexes. It must be called with the account as stored
+// before its schedule is updated.
+func (k Keeper) deleteVestingIndexes(ctx sdk.Context, va *types.ClawbackVestingAccount) {
+	k.DeleteEndTimeIndex(ctx, va)
+	k.DeleteScheduleEventIndex(ctx, va)
+}
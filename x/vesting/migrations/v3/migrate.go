@@ -0,0 +1,30 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package v3
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/vesting/x/vesting/types"
+)
+
+// VestingKeeper defines the keeper methods required by the v3 migration.
+type VestingKeeper interface {
+	SetParams(ctx sdk.Context, params types.Params) error
+	IndexClawbackVestingAccounts(ctx sdk.Context)
+}
+
+// MigrateStore migrates the x/vesting module state from the consensus version 2 to
+// version 3.
+// Specifically, it sets the default module parameters and indexes the end time
+// and the upcoming lockup and vesting events of the existing clawback vesting accounts.
+//
+// NOTE: the lockup and vesting periods that ended before the migration are not
+// indexed, so no unlocked or vested event is emitted for them.
+func MigrateStore(ctx sdk.Context, k VestingKeeper) error {
+	if err := k.SetParams(ctx, types.DefaultParams()); err != nil {
+		return err
+	}
+
+	k.IndexClawbackVestingAccounts(ctx)
+	return nil
+}
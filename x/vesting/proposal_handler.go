@@ -7,14 +7,16 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
-	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
-	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	"github.com/evmos/vesting/x/vesting/keeper"
 	"github.com/evmos/vesting/x/vesting/types"
 )
 
 // NewVestingProposalHandler creates a governance handler to manage new proposal types.
+//
+// Deprecated: submit MsgClawback with the governance authority as funder through
+// a gov v1 MsgSubmitProposal instead. This handler is only kept for backward
+// compatibility with legacy proposals.
 func NewVestingProposalHandler(k *keeper.Keeper) govv1beta1.Handler {
 	return func(ctx sdk.Context, content govv1beta1.Content) error {
 		switch c := content.(type) {
@@ -34,10 +36,8 @@ func handleClawbackProposal(
 	k *keeper.Keeper,
 	p *types.ClawbackProposal,
 ) error {
-	governanceAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
-
 	msg := &types.MsgClawback{
-		FunderAddress:  governanceAddr.String(),
+		FunderAddress:  k.GetAuthority().String(),
 		AccountAddress: p.Address,
 		DestAddress:    p.DestinationAddress,
 	}
@@ -0,0 +1,33 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+var _ AccountConverter = BaseAccountConverter{}
+
+// BaseAccountConverter is the default AccountConverter for chains that use the
+// x/auth BaseAccount as their default account type.
+type BaseAccountConverter struct{}
+
+// ToBaseAccount returns the given account if it is a BaseAccount.
+func (BaseAccountConverter) ToBaseAccount(acc authtypes.AccountI) (*authtypes.BaseAccount, error) {
+	baseAcc, ok := acc.(*authtypes.BaseAccount)
+	if !ok {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidType,
+			"account %s of type %T is not a base account", acc.GetAddress(), acc,
+		)
+	}
+	return baseAcc, nil
+}
+
+// FromBaseAccount returns the given BaseAccount, since a BaseAccount has no
+// fields to restore from the original account.
+func (BaseAccountConverter) FromBaseAccount(baseAcc *authtypes.BaseAccount, _ authtypes.AccountI) authtypes.AccountI {
+	return baseAcc
+}
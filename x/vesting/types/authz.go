@@ -0,0 +1,230 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+var (
+	_ authz.Authorization = &FundVestingAuthorization{}
+	_ authz.Authorization = &ClawbackAuthorization{}
+)
+
+// NewFundVestingAuthorization creates a new FundVestingAuthorization instance.
+// A zero period applies the spend limit to the whole lifetime of the
+// authorization, and a nil expiration never expires.
+func NewFundVestingAuthorization(
+	spendLimit sdk.Coins,
+	period time.Duration,
+	allowedAddresses []sdk.AccAddress,
+	expiration *time.Time,
+) *FundVestingAuthorization {
+	return &FundVestingAuthorization{
+		SpendLimit:       spendLimit,
+		Period:           period,
+		AllowedAddresses: addressesToStrings(allowedAddresses),
+		Expiration:       expiration,
+	}
+}
+
+// MsgTypeURL implements the Authorization interface.
+func (a FundVestingAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgFundVestingAccount{})
+}
+
+// Accept implements the Authorization interface. It accepts the funding if the
+// vesting account is allowed and the funded amount, added to the amount already
+// funded in the current period, doesn't exceed the spend limit.
+func (a FundVestingAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	fundMsg, ok := msg.(*MsgFundVestingAccount)
+	if !ok {
+		return authz.AcceptResponse{}, errorsmod.Wrap(errortypes.ErrInvalidType, "type mismatch")
+	}
+
+	if err := checkExpiration(ctx, a.Expiration); err != nil {
+		return authz.AcceptResponse{}, err
+	}
+
+	if !isAllowedAddress(a.AllowedAddresses, fundMsg.VestingAddress) {
+		return authz.AcceptResponse{}, errorsmod.Wrapf(errortypes.ErrUnauthorized,
+			"cannot fund vesting account %s", fundMsg.VestingAddress,
+		)
+	}
+
+	// NOTE: if the vesting periods are absent, the vesting account is funded
+	// with the amount of the lockup periods
+	amount := fundMsg.VestingPeriods.TotalAmount()
+	if amount.IsZero() {
+		amount = fundMsg.LockupPeriods.TotalAmount()
+	}
+
+	updated := a
+	updated.tryResetPeriod(ctx.BlockTime())
+
+	spent := updated.PeriodSpent.Add(amount...)
+	if !spent.IsAllLTE(updated.SpendLimit) {
+		return authz.AcceptResponse{}, errorsmod.Wrapf(errortypes.ErrInsufficientFunds,
+			"requested amount is more than spend limit (%s + %s > %s)", updated.PeriodSpent, amount, updated.SpendLimit,
+		)
+	}
+	updated.PeriodSpent = spent
+
+	// the authorization can't be used anymore if the spend limit doesn't reset
+	if updated.Period == 0 && spent.IsEqual(updated.SpendLimit) {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	return authz.AcceptResponse{Accept: true, Updated: &updated}, nil
+}
+
+// ValidateBasic implements the Authorization interface.
+func (a FundVestingAuthorization) ValidateBasic() error {
+	if !a.SpendLimit.IsValid() || a.SpendLimit.IsZero() {
+		return errorsmod.Wrapf(errortypes.ErrInvalidCoins, "spend limit must be positive: %s", a.SpendLimit)
+	}
+
+	if !a.PeriodSpent.IsValid() {
+		return errorsmod.Wrapf(errortypes.ErrInvalidCoins, "invalid period spent amount: %s", a.PeriodSpent)
+	}
+
+	if a.Period < 0 {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "period cannot be negative: %s", a.Period)
+	}
+
+	return validateAllowedAddresses(a.AllowedAddresses)
+}
+
+// tryResetPeriod resets the spent amount and moves the end of the period
+// forward if the current period has ended.
+func (a *FundVestingAuthorization) tryResetPeriod(blockTime time.Time) {
+	if a.Period == 0 || blockTime.Before(a.PeriodReset) {
+		return
+	}
+
+	a.PeriodSpent = sdk.NewCoins()
+	a.PeriodReset = a.PeriodReset.Add(a.Period)
+	// if the authorization wasn't used for more than a period, start the new
+	// period at the current block time
+	if blockTime.After(a.PeriodReset) {
+		a.PeriodReset = blockTime.Add(a.Period)
+	}
+}
+
+// NewClawbackAuthorization creates a new ClawbackAuthorization instance. A nil
+// expiration never expires.
+func NewClawbackAuthorization(allowedAddresses []sdk.AccAddress, expiration *time.Time) *ClawbackAuthorization {
+	return &ClawbackAuthorization{
+		AllowedAddresses: addressesToStrings(allowedAddresses),
+		Expiration:       expiration,
+	}
+}
+
+// MsgTypeURL implements the Authorization interface.
+func (a ClawbackAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgClawback{})
+}
+
+// Accept implements the Authorization interface. It accepts the clawback if the
+// vesting account is allowed and the clawed back coins are sent to the granter
+// (funder), so that the grantee can't redirect nor burn them.
+func (a ClawbackAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	clawbackMsg, ok := msg.(*MsgClawback)
+	if !ok {
+		return authz.AcceptResponse{}, errorsmod.Wrap(errortypes.ErrInvalidType, "type mismatch")
+	}
+
+	if err := checkExpiration(ctx, a.Expiration); err != nil {
+		return authz.AcceptResponse{}, err
+	}
+
+	if !isAllowedAddress(a.AllowedAddresses, clawbackMsg.AccountAddress) {
+		return authz.AcceptResponse{}, errorsmod.Wrapf(errortypes.ErrUnauthorized,
+			"cannot clawback vesting account %s", clawbackMsg.AccountAddress,
+		)
+	}
+
+	if clawbackMsg.Burn {
+		return authz.AcceptResponse{}, errorsmod.Wrap(errortypes.ErrUnauthorized, "cannot burn the clawed back coins")
+	}
+
+	if clawbackMsg.DestAddress != "" && clawbackMsg.DestAddress != clawbackMsg.FunderAddress {
+		return authz.AcceptResponse{}, errorsmod.Wrapf(errortypes.ErrUnauthorized,
+			"cannot clawback to %s: the destination must be the granter", clawbackMsg.DestAddress,
+		)
+	}
+
+	for _, d := range clawbackMsg.Destinations {
+		if d.Address != clawbackMsg.FunderAddress {
+			return authz.AcceptResponse{}, errorsmod.Wrapf(errortypes.ErrUnauthorized,
+				"cannot clawback to %s: the destination must be the granter", d.Address,
+			)
+		}
+	}
+
+	return authz.AcceptResponse{Accept: true}, nil
+}
+
+// ValidateBasic implements the Authorization interface.
+func (a ClawbackAuthorization) ValidateBasic() error {
+	return validateAllowedAddresses(a.AllowedAddresses)
+}
+
+// checkExpiration returns an error if the given expiration time has passed.
+func checkExpiration(ctx sdk.Context, expiration *time.Time) error {
+	if expiration != nil && !ctx.BlockTime().Before(*expiration) {
+		return errorsmod.Wrapf(errortypes.ErrUnauthorized, "authorization expired at %s", expiration)
+	}
+	return nil
+}
+
+// isAllowedAddress returns true if the list of allowed addresses is empty or
+// contains the given address.
+func isAllowedAddress(allowedAddresses []string, address string) bool {
+	if len(allowedAddresses) == 0 {
+		return true
+	}
+
+	for _, allowed := range allowedAddresses {
+		if allowed == address {
+			return true
+		}
+	}
+	return false
+}
+
+// validateAllowedAddresses checks that the given addresses are valid and unique.
+func validateAllowedAddresses(allowedAddresses []string) error {
+	seen := make(map[string]bool, len(allowedAddresses))
+	for _, address := range allowedAddresses {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return errorsmod.Wrapf(err, "invalid allowed address %s", address)
+		}
+
+		if seen[address] {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "duplicate allowed address %s", address)
+		}
+		seen[address] = true
+	}
+
+	return nil
+}
+
+// addressesToStrings returns the bech32 representation of the given addresses.
+func addressesToStrings(addresses []sdk.AccAddress) []string {
+	if len(addresses) == 0 {
+		return nil
+	}
+
+	strs := make([]string, len(addresses))
+	for i, addr := range addresses {
+		strs[i] = addr.String()
+	}
+	return strs
+}
@@ -0,0 +1,160 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/evmos/vesting/x/vesting/types"
+)
+
+type AuthzTestSuite struct {
+	suite.Suite
+}
+
+func TestAuthzTestSuite(t *testing.T) {
+	suite.Run(t, new(AuthzTestSuite))
+}
+
+func (suite *AuthzTestSuite) TestFundVestingAuthorization() {
+	var (
+		funder     = sdk.AccAddress("funder______________")
+		allowed    = sdk.AccAddress("allowed_____________")
+		notAllowed = sdk.AccAddress("not_allowed_________")
+		now        = time.Unix(1_000_000, 0).UTC()
+		expiration = now.Add(60 * 24 * time.Hour)
+		month      = 30 * 24 * time.Hour
+		spendLimit = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	)
+
+	fundMsg := func(vestingAddr sdk.AccAddress, amount int64) *types.MsgFundVestingAccount {
+		periods := sdkvesting.Periods{{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))}}
+		return types.NewMsgFundVestingAccount(funder, vestingAddr, now, nil, periods)
+	}
+
+	suite.Run("validate basic", func() {
+		suite.Require().NoError(types.NewFundVestingAuthorization(spendLimit, month, []sdk.AccAddress{allowed}, nil).ValidateBasic())
+		suite.Require().Error(types.NewFundVestingAuthorization(sdk.NewCoins(), month, nil, nil).ValidateBasic())
+		suite.Require().Error(types.NewFundVestingAuthorization(spendLimit, -month, nil, nil).ValidateBasic())
+		suite.Require().Error(types.NewFundVestingAuthorization(spendLimit, month, []sdk.AccAddress{allowed, allowed}, nil).ValidateBasic())
+		suite.Require().Equal(sdk.MsgTypeURL(&types.MsgFundVestingAccount{}), types.FundVestingAuthorization{}.MsgTypeURL())
+	})
+
+	suite.Run("spend limit per period", func() {
+		auth := types.NewFundVestingAuthorization(spendLimit, month, []sdk.AccAddress{allowed}, &expiration)
+		ctx := sdk.Context{}.WithBlockTime(now)
+
+		res, err := auth.Accept(ctx, fundMsg(allowed, 60))
+		suite.Require().NoError(err)
+		suite.Require().True(res.Accept)
+		suite.Require().False(res.Delete)
+		updated, ok := res.Updated.(*types.FundVestingAuthorization)
+		suite.Require().True(ok)
+		suite.Require().Equal(int64(60), updated.PeriodSpent.AmountOf(sdk.DefaultBondDenom).Int64())
+		suite.Require().Equal(now.Add(month), updated.PeriodReset)
+
+		// the spend limit is exceeded in the same period
+		_, err = updated.Accept(ctx, fundMsg(allowed, 50))
+		suite.Require().ErrorContains(err, "requested amount is more than spend limit")
+
+		// the spent amount is reset in the next period
+		res, err = updated.Accept(ctx.WithBlockTime(now.Add(month)), fundMsg(allowed, 50))
+		suite.Require().NoError(err)
+		suite.Require().True(res.Accept)
+		updated = res.Updated.(*types.FundVestingAuthorization)
+		suite.Require().Equal(int64(50), updated.PeriodSpent.AmountOf(sdk.DefaultBondDenom).Int64())
+		suite.Require().Equal(now.Add(2*month), updated.PeriodReset)
+
+		// the vesting account is not allowed
+		_, err = updated.Accept(ctx, fundMsg(notAllowed, 10))
+		suite.Require().ErrorContains(err, "cannot fund vesting account")
+
+		// the authorization is expired
+		_, err = updated.Accept(ctx.WithBlockTime(expiration), fundMsg(allowed, 10))
+		suite.Require().ErrorContains(err, "authorization expired")
+	})
+
+	suite.Run("spend limit without period", func() {
+		auth := types.NewFundVestingAuthorization(spendLimit, 0, nil, nil)
+		ctx := sdk.Context{}.WithBlockTime(now)
+
+		res, err := auth.Accept(ctx, fundMsg(notAllowed, 40))
+		suite.Require().NoError(err)
+		suite.Require().False(res.Delete)
+
+		updated := res.Updated.(*types.FundVestingAuthorization)
+		res, err = updated.Accept(ctx.WithBlockTime(now.Add(2*month)), fundMsg(allowed, 60))
+		suite.Require().NoError(err)
+		suite.Require().True(res.Accept)
+		suite.Require().True(res.Delete)
+	})
+
+	suite.Run("type mismatch", func() {
+		auth := types.NewFundVestingAuthorization(spendLimit, month, nil, nil)
+		_, err := auth.Accept(sdk.Context{}.WithBlockTime(now), &types.MsgClawback{})
+		suite.Require().ErrorContains(err, "type mismatch")
+	})
+}
+
+func (suite *AuthzTestSuite) TestClawbackAuthorization() {
+	var (
+		funder     = sdk.AccAddress("funder______________")
+		grantee    = sdk.AccAddress("grantee_____________")
+		allowed    = sdk.AccAddress("allowed_____________")
+		notAllowed = sdk.AccAddress("not_allowed_________")
+		now        = time.Unix(1_000_000, 0).UTC()
+		expiration = now.Add(time.Hour)
+		ctx        = sdk.Context{}.WithBlockTime(now)
+	)
+
+	auth := types.NewClawbackAuthorization([]sdk.AccAddress{allowed}, &expiration)
+	suite.Require().NoError(auth.ValidateBasic())
+	suite.Require().Equal(sdk.MsgTypeURL(&types.MsgClawback{}), auth.MsgTypeURL())
+
+	res, err := auth.Accept(ctx, types.NewMsgClawback(funder, allowed, nil))
+	suite.Require().NoError(err)
+	suite.Require().True(res.Accept)
+	suite.Require().False(res.Delete)
+
+	_, err = auth.Accept(ctx, types.NewMsgClawback(funder, notAllowed, nil))
+	suite.Require().ErrorContains(err, "cannot clawback vesting account")
+
+	_, err = auth.Accept(ctx.WithBlockTime(expiration), types.NewMsgClawback(funder, allowed, nil))
+	suite.Require().ErrorContains(err, "authorization expired")
+
+	// any vesting account can be clawed back without allowed addresses
+	res, err = types.NewClawbackAuthorization(nil, nil).Accept(ctx, types.NewMsgClawback(funder, notAllowed, nil))
+	suite.Require().NoError(err)
+	suite.Require().True(res.Accept)
+
+	// the clawed back coins can only be sent to the granter
+	res, err = auth.Accept(ctx, types.NewMsgClawback(funder, allowed, funder))
+	suite.Require().NoError(err)
+	suite.Require().True(res.Accept)
+
+	_, err = auth.Accept(ctx, types.NewMsgClawback(funder, allowed, grantee))
+	suite.Require().ErrorContains(err, "the destination must be the granter")
+
+	split := types.NewMsgClawback(funder, allowed, nil)
+	split.Destinations = []types.ClawbackDestination{
+		{Address: funder.String(), Weight: 1},
+		{Address: grantee.String(), Weight: 1},
+	}
+	_, err = auth.Accept(ctx, split)
+	suite.Require().ErrorContains(err, "the destination must be the granter")
+
+	split.Destinations = split.Destinations[:1]
+	res, err = auth.Accept(ctx, split)
+	suite.Require().NoError(err)
+	suite.Require().True(res.Accept)
+
+	burn := types.NewMsgClawback(funder, allowed, nil)
+	burn.Burn = true
+	_, err = auth.Accept(ctx, burn)
+	suite.Require().ErrorContains(err, "cannot burn")
+
+	suite.Require().Error((&types.ClawbackAuthorization{AllowedAddresses: []string{"invalid"}}).ValidateBasic())
+}
@@ -0,0 +1,68 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewClawbackDestination returns a new ClawbackDestination
+func NewClawbackDestination(address sdk.AccAddress, weight uint64) ClawbackDestination {
+	return ClawbackDestination{
+		Address: address.String(),
+		Weight:  weight,
+	}
+}
+
+// ValidateClawbackDestinations checks that the destination addresses are valid
+// and unique, and that their weights are positive.
+func ValidateClawbackDestinations(destinations []ClawbackDestination) error {
+	seen := make(map[string]bool, len(destinations))
+	for _, d := range destinations {
+		if _, err := sdk.AccAddressFromBech32(d.Address); err != nil {
+			return errorsmod.Wrapf(err, "invalid destination address %s", d.Address)
+		}
+
+		if d.Weight == 0 {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "weight of destination %s must be positive", d.Address)
+		}
+
+		if seen[d.Address] {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "duplicate destination address %s", d.Address)
+		}
+		seen[d.Address] = true
+	}
+
+	return nil
+}
+
+// SplitClawback splits the given amount between the destinations pro rata to
+// their weights. The shares are truncated and the remainder of each denom is
+// added to the share of the first destination. The destinations must be valid.
+func SplitClawback(amount sdk.Coins, destinations []ClawbackDestination) []sdk.Coins {
+	shares := make([]sdk.Coins, len(destinations))
+	if len(destinations) == 0 {
+		return shares
+	}
+
+	totalWeight := sdkmath.ZeroInt()
+	for _, d := range destinations {
+		totalWeight = totalWeight.Add(sdkmath.NewIntFromUint64(d.Weight))
+	}
+
+	for _, coin := range amount {
+		remainder := coin.Amount
+		for i, d := range destinations {
+			share := coin.Amount.Mul(sdkmath.NewIntFromUint64(d.Weight)).Quo(totalWeight)
+			shares[i] = shares[i].Add(sdk.NewCoin(coin.Denom, share))
+			remainder = remainder.Sub(share)
+		}
+		shares[0] = shares[0].Add(sdk.NewCoin(coin.Denom, remainder))
+	}
+
+	return shares
+}
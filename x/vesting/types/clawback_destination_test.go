@@ -0,0 +1,146 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/vesting/x/vesting/types"
+	"github.com/stretchr/testify/suite"
+)
+
+type ClawbackDestinationTestSuite struct {
+	suite.Suite
+}
+
+func TestClawbackDestinationTestSuite(t *testing.T) {
+	suite.Run(t, new(ClawbackDestinationTestSuite))
+}
+
+func (suite *ClawbackDestinationTestSuite) TestValidateClawbackDestinations() {
+	addr1 := sdk.AccAddress("destination_1")
+	addr2 := sdk.AccAddress("destination_2")
+
+	testCases := []struct {
+		name         string
+		destinations []types.ClawbackDestination
+		expPass      bool
+	}{
+		{
+			"pass - empty destinations",
+			nil,
+			true,
+		},
+		{
+			"pass - weighted destinations",
+			[]types.ClawbackDestination{
+				types.NewClawbackDestination(addr1, 2),
+				types.NewClawbackDestination(addr2, 1),
+			},
+			true,
+		},
+		{
+			"fail - invalid address",
+			[]types.ClawbackDestination{{Address: "invalid", Weight: 1}},
+			false,
+		},
+		{
+			"fail - zero weight",
+			[]types.ClawbackDestination{
+				types.NewClawbackDestination(addr1, 1),
+				types.NewClawbackDestination(addr2, 0),
+			},
+			false,
+		},
+		{
+			"fail - duplicate address",
+			[]types.ClawbackDestination{
+				types.NewClawbackDestination(addr1, 1),
+				types.NewClawbackDestination(addr1, 1),
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := types.ValidateClawbackDestinations(tc.destinations)
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *ClawbackDestinationTestSuite) TestSplitClawback() {
+	addr1 := sdk.AccAddress("destination_1")
+	addr2 := sdk.AccAddress("destination_2")
+	addr3 := sdk.AccAddress("destination_3")
+
+	testCases := []struct {
+		name         string
+		amount       sdk.Coins
+		destinations []types.ClawbackDestination
+		expShares    []sdk.Coins
+	}{
+		{
+			"single destination",
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			[]types.ClawbackDestination{types.NewClawbackDestination(addr1, 5)},
+			[]sdk.Coins{sdk.NewCoins(sdk.NewInt64Coin("stake", 100))},
+		},
+		{
+			"even split",
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			[]types.ClawbackDestination{
+				types.NewClawbackDestination(addr1, 1),
+				types.NewClawbackDestination(addr2, 1),
+			},
+			[]sdk.Coins{
+				sdk.NewCoins(sdk.NewInt64Coin("stake", 50)),
+				sdk.NewCoins(sdk.NewInt64Coin("stake", 50)),
+			},
+		},
+		{
+			"remainder goes to the first destination",
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("test", 10)),
+			[]types.ClawbackDestination{
+				types.NewClawbackDestination(addr1, 1),
+				types.NewClawbackDestination(addr2, 1),
+				types.NewClawbackDestination(addr3, 1),
+			},
+			[]sdk.Coins{
+				sdk.NewCoins(sdk.NewInt64Coin("stake", 34), sdk.NewInt64Coin("test", 4)),
+				sdk.NewCoins(sdk.NewInt64Coin("stake", 33), sdk.NewInt64Coin("test", 3)),
+				sdk.NewCoins(sdk.NewInt64Coin("stake", 33), sdk.NewInt64Coin("test", 3)),
+			},
+		},
+		{
+			"share truncated to zero",
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+			[]types.ClawbackDestination{
+				types.NewClawbackDestination(addr1, 100),
+				types.NewClawbackDestination(addr2, 1),
+			},
+			[]sdk.Coins{
+				sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+				sdk.NewCoins(),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			shares := types.SplitClawback(tc.amount, tc.destinations)
+			suite.Require().Len(shares, len(tc.expShares))
+
+			total := sdk.NewCoins()
+			for i, share := range shares {
+				suite.Require().True(tc.expShares[i].IsEqual(share), "expected %s, got %s", tc.expShares[i], share)
+				total = total.Add(share...)
+			}
+			suite.Require().True(tc.amount.IsEqual(total))
+		})
+	}
+}
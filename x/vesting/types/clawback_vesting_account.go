@@ -105,6 +105,11 @@ func (va ClawbackVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
 // The 'balance' input parameter is the delegator account balance.
 // The 'amount' input parameter are the delegated coins
 // Note that unvested coins cannot be delegated
+//
+// NOTE: undelegations are tracked by the embedded BaseVestingAccount, which
+// decreases the delegated free coins before the delegated vesting coins. The
+// latter are set when the account is funded or migrated while it has
+// delegations.
 func (va *ClawbackVestingAccount) TrackDelegation(_ time.Time, balance, amount sdk.Coins) {
 	// ClawbackVestingAccount cannot delegate vesting (unvested) coins.
 	// Can only delegate vested (free) coins
@@ -119,6 +124,12 @@ func (va *ClawbackVestingAccount) TrackDelegation(_ time.Time, balance, amount s
 	}
 }
 
+// IsFunderOrManager returns true if the given address is the funder or the
+// manager of the account.
+func (va ClawbackVestingAccount) IsFunderOrManager(address string) bool {
+	return address == va.FunderAddress || (va.ManagerAddress != "" && address == va.ManagerAddress)
+}
+
 // GetStartTime returns the time when vesting starts for a periodic vesting
 // account.
 func (va ClawbackVestingAccount) GetStartTime() int64 {
@@ -169,6 +180,16 @@ func (va ClawbackVestingAccount) Validate() error {
 		return errors.New("original vesting coins does not match the sum of all coins in vesting periods")
 	}
 
+	if va.VestingPausedAt < 0 {
+		return errors.New("vesting pause time cannot be negative")
+	}
+
+	if va.ManagerAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(va.ManagerAddress); err != nil {
+			return errors.New("invalid manager address")
+		}
+	}
+
 	return va.BaseVestingAccount.Validate()
 }
 
@@ -187,13 +208,88 @@ func (va ClawbackVestingAccount) GetLockedUpCoins(blockTime time.Time) sdk.Coins
 }
 
 // GetVestedCoins returns the vested coins at blockTime.
+// If the vesting is paused, the vested coins at the pause time are returned.
 func (va ClawbackVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
-	return ReadSchedule(va.GetStartTime(), va.EndTime, va.VestingPeriods, va.OriginalVesting, blockTime.Unix())
+	return ReadSchedule(va.GetStartTime(), va.EndTime, va.VestingPeriods, va.OriginalVesting, va.vestingTime(blockTime))
 }
 
 // GetPassedPeriodCount returns the amount of passed periods at blockTime.
+// If the vesting is paused, the passed periods at the pause time are returned.
 func (va ClawbackVestingAccount) GetPassedPeriodCount(blockTime time.Time) int {
-	return ReadPastPeriodCount(va.GetStartTime(), va.EndTime, va.VestingPeriods, blockTime.Unix())
+	return ReadPastPeriodCount(va.GetStartTime(), va.EndTime, va.VestingPeriods, va.vestingTime(blockTime))
+}
+
+// IsVestingPaused returns true if the vesting of the account is paused.
+func (va ClawbackVestingAccount) IsVestingPaused() bool {
+	return va.VestingPausedAt != 0
+}
+
+// vestingTime returns the unix time at which the vesting schedule is read for
+// the given block time, which is capped at the pause time if the vesting is
+// paused.
+func (va ClawbackVestingAccount) vestingTime(blockTime time.Time) int64 {
+	if va.IsVestingPaused() {
+		return Min64(blockTime.Unix(), va.VestingPausedAt)
+	}
+	return blockTime.Unix()
+}
+
+// ResumeVesting resumes the paused vesting of the account at resumeTime. The
+// vesting period in progress when the vesting was paused, and therefore all the
+// following ones, are extended by the pause duration. It returns the pause
+// duration.
+func (va *ClawbackVestingAccount) ResumeVesting(resumeTime int64) int64 {
+	pauseDuration := resumeTime - va.VestingPausedAt
+	va.VestingPausedAt = 0
+	if pauseDuration <= 0 {
+		return 0
+	}
+
+	// copy the periods to avoid mutating the slice of the previous account state
+	vp := make(sdkvesting.Periods, len(va.VestingPeriods))
+	copy(vp, va.VestingPeriods)
+
+	pausedAt := resumeTime - pauseDuration
+	endTime := va.GetStartTime()
+	for i := range vp {
+		endTime += vp[i].Length
+		if endTime > pausedAt {
+			vp[i].Length += pauseDuration
+			break
+		}
+	}
+
+	va.VestingPeriods = vp
+	va.EndTime = Max64(va.EndTime, va.GetStartTime()+vp.TotalLength())
+	return pauseDuration
+}
+
+// UnlockAll replaces the lockup schedule of the account with a single period
+// that unlocks all coins at the start time. It returns the coins that were
+// still locked up at blockTime.
+func (va *ClawbackVestingAccount) UnlockAll(blockTime time.Time) sdk.Coins {
+	lockedUp := va.GetLockedUpCoins(blockTime)
+
+	va.LockupPeriods = sdkvesting.Periods{{Length: 0, Amount: va.OriginalVesting}}
+	va.EndTime = va.GetStartTime() + va.VestingPeriods.TotalLength()
+	return lockedUp
+}
+
+// AccelerateVesting vests all the unvested coins of the account at
+// blockTime, keeping the passed vesting periods and the lockup schedule. It
+// returns the accelerated coins.
+func (va *ClawbackVestingAccount) AccelerateVesting(blockTime time.Time) (sdk.Coins, error) {
+	unvested := va.GetVestingCoins(blockTime)
+	if unvested.IsZero() {
+		return nil, errors.New("account has no unvested coins")
+	}
+
+	// a single period that ends at blockTime
+	if err := va.AmendSchedule(blockTime.Unix(), nil, sdkvesting.Periods{{Length: 0, Amount: unvested}}); err != nil {
+		return nil, err
+	}
+
+	return unvested, nil
 }
 
 // ComputeClawback returns an account with all future vesting events removed and
@@ -228,6 +324,8 @@ func (va ClawbackVestingAccount) ComputeClawback(
 	va.EndTime = Max64(newVestingEnd, newLockingEnd)
 	va.LockupPeriods = newLockupPeriods
 	va.VestingPeriods = newVestingPeriods
+	// all the remaining vesting periods have passed, so the pause is lifted
+	va.VestingPausedAt = 0
 
 	return va, totalUnvested
 }
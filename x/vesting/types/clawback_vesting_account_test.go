@@ -128,6 +128,23 @@ func (suite *VestingAccountTestSuite) TestClawbackAccountNew() {
 			expErr:    true,
 			expErrMsg: "original vesting coins does not match the sum of all coins in vesting periods",
 		},
+		{
+			name: "Clawback vesting account - invalid manager address",
+			acc: &types.ClawbackVestingAccount{
+				BaseVestingAccount: &sdkvesting.BaseVestingAccount{
+					BaseAccount:     baseAcc,
+					OriginalVesting: initialVesting,
+					EndTime:         120,
+				},
+				FunderAddress:  "funder",
+				ManagerAddress: "manager",
+				StartTime:      time.Unix(100, 0),
+				LockupPeriods:  sdkvesting.Periods{sdkvesting.Period{Length: 10, Amount: initialVesting}},
+				VestingPeriods: sdkvesting.Periods{sdkvesting.Period{Length: 10, Amount: initialVesting}},
+			},
+			expErr:    true,
+			expErrMsg: "invalid manager address",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -143,6 +160,36 @@ func (suite *VestingAccountTestSuite) TestClawbackAccountNew() {
 	}
 }
 
+func (suite *VestingAccountTestSuite) TestIsFunderOrManager() {
+	funder := sdk.AccAddress("funder_address").String()
+	manager := sdk.AccAddress("manager_address").String()
+	other := sdk.AccAddress("other_address").String()
+
+	testCases := []struct {
+		name    string
+		manager string
+		address string
+		expRes  bool
+	}{
+		{"funder without manager", "", funder, true},
+		{"other address without manager", "", other, false},
+		{"empty address without manager", "", "", false},
+		{"funder with manager", manager, funder, true},
+		{"manager", manager, manager, true},
+		{"other address with manager", manager, other, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			va := types.ClawbackVestingAccount{
+				FunderAddress:  funder,
+				ManagerAddress: tc.manager,
+			}
+			suite.Require().Equal(tc.expRes, va.IsFunderOrManager(tc.address))
+		})
+	}
+}
+
 func (suite *VestingAccountTestSuite) TestGetCoinsFunctions() {
 	var va *types.ClawbackVestingAccount
 	now := tmtime.Now()
@@ -411,6 +458,19 @@ func (suite *VestingAccountTestSuite) TestTrackDelegationUndelegation() {
 			false,
 			false,
 		},
+		{
+			"undelegate more than the delegated free coins",
+			func(va *types.ClawbackVestingAccount) {
+				va.TrackDelegation(now.Add(17*time.Hour), testutil.OrigCoins, sdk.Coins{sdk.NewInt64Coin(testutil.StakeDenom, 50)})
+			},
+			sdk.Coins{sdk.NewInt64Coin(testutil.StakeDenom, 50)},
+			func(va *types.ClawbackVestingAccount) {
+				va.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(testutil.StakeDenom, 51)})
+			},
+			sdk.Coins{},
+			false,
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -444,6 +504,33 @@ func (suite *VestingAccountTestSuite) TestTrackDelegationUndelegation() {
 	}
 }
 
+func (suite *VestingAccountTestSuite) TestTrackUndelegationDelegatedVesting() {
+	now := tmtime.Now()
+	stake := func(x int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin(testutil.StakeDenom, x)) }
+
+	addr := sdk.AccAddress("test_address")
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+	va := types.NewClawbackVestingAccount(bacc, sdk.AccAddress([]byte("funder")), testutil.OrigCoins, now, testutil.LockupPeriods, testutil.VestingPeriods)
+
+	// the delegated vesting coins are set when funding an account with delegations
+	va.DelegatedFree = stake(30)
+	va.DelegatedVesting = stake(50)
+
+	// the delegated free coins are decreased first
+	va.TrackUndelegation(stake(20))
+	suite.Require().Equal(stake(10), va.DelegatedFree)
+	suite.Require().Equal(stake(50), va.DelegatedVesting)
+
+	va.TrackUndelegation(stake(40))
+	suite.Require().True(va.DelegatedFree.IsZero())
+	suite.Require().Equal(stake(20), va.DelegatedVesting)
+
+	// the decrease is capped at the delegated coins
+	va.TrackUndelegation(stake(25))
+	suite.Require().True(va.DelegatedFree.IsZero())
+	suite.Require().True(va.DelegatedVesting.IsZero())
+}
+
 func (suite *VestingAccountTestSuite) TestComputeClawback() {
 	fee := func(x int64) sdk.Coin { return sdk.NewInt64Coin(testutil.FeeDenom, x) }
 	stake := func(x int64) sdk.Coin { return sdk.NewInt64Coin(testutil.StakeDenom, x) }
@@ -516,3 +603,101 @@ func (suite *VestingAccountTestSuite) TestComputeClawback() {
 		})
 	}
 }
+
+func (suite *VestingAccountTestSuite) TestPauseResumeVesting() {
+	fee := func(x int64) sdk.Coin { return sdk.NewInt64Coin(testutil.FeeDenom, x) }
+	vestingStart := tmtime.Now()
+	vestingPeriods := sdkvesting.Periods{
+		{Length: int64(8 * 3600), Amount: sdk.NewCoins(fee(200))}, // 8am
+		{Length: int64(4 * 3600), Amount: sdk.NewCoins(fee(200))}, // noon
+		{Length: int64(6 * 3600), Amount: sdk.NewCoins(fee(200))}, // 6pm
+	}
+	lockupPeriods := sdkvesting.Periods{{Length: int64(18 * 3600), Amount: sdk.NewCoins(fee(600))}}
+
+	addr := sdk.AccAddress("test_address")
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+	va := types.NewClawbackVestingAccount(bacc, sdk.AccAddress([]byte("funder")), sdk.NewCoins(fee(600)), vestingStart, lockupPeriods, vestingPeriods)
+	originalPeriods := va.VestingPeriods
+
+	// pause at 10am
+	va.VestingPausedAt = vestingStart.Add(10 * time.Hour).Unix()
+	suite.Require().True(va.IsVestingPaused())
+
+	// no coins vest while paused
+	suite.Require().Equal(sdk.NewCoins(fee(200)), va.GetVestedCoins(vestingStart.Add(20*time.Hour)))
+	suite.Require().Equal(1, va.GetPassedPeriodCount(vestingStart.Add(20*time.Hour)))
+	suite.Require().Equal(sdk.NewCoins(fee(400)), va.GetVestingCoins(vestingStart.Add(20*time.Hour)))
+	// the vesting before the pause is not affected
+	suite.Require().Empty(va.GetVestedCoins(vestingStart.Add(7 * time.Hour)))
+
+	// resume at 1pm
+	pauseDuration := va.ResumeVesting(vestingStart.Add(13 * time.Hour).Unix())
+	suite.Require().Equal(int64(3*3600), pauseDuration)
+	suite.Require().False(va.IsVestingPaused())
+
+	// the period in progress is extended by the pause duration
+	suite.Require().Equal(int64(8*3600), va.VestingPeriods[0].Length)
+	suite.Require().Equal(int64(7*3600), va.VestingPeriods[1].Length)
+	suite.Require().Equal(int64(6*3600), va.VestingPeriods[2].Length)
+	suite.Require().Equal(vestingStart.Add(21*time.Hour).Unix(), va.EndTime)
+	// the periods of the previous account state are not mutated
+	suite.Require().Equal(int64(4*3600), originalPeriods[1].Length)
+
+	suite.Require().Equal(sdk.NewCoins(fee(200)), va.GetVestedCoins(vestingStart.Add(14*time.Hour)))
+	suite.Require().Equal(sdk.NewCoins(fee(400)), va.GetVestedCoins(vestingStart.Add(15*time.Hour)))
+	suite.Require().Equal(sdk.NewCoins(fee(600)), va.GetVestedCoins(vestingStart.Add(21*time.Hour)))
+	suite.Require().NoError(va.Validate())
+}
+
+func (suite *VestingAccountTestSuite) TestUnlockAll() {
+	fee := func(x int64) sdk.Coin { return sdk.NewInt64Coin(testutil.FeeDenom, x) }
+	vestingStart := tmtime.Now()
+	vestingPeriods := sdkvesting.Periods{{Length: int64(8 * 3600), Amount: sdk.NewCoins(fee(600))}}
+	lockupPeriods := sdkvesting.Periods{
+		{Length: int64(12 * 3600), Amount: sdk.NewCoins(fee(300))},
+		{Length: int64(12 * 3600), Amount: sdk.NewCoins(fee(300))},
+	}
+
+	addr := sdk.AccAddress("test_address")
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+	va := types.NewClawbackVestingAccount(bacc, sdk.AccAddress([]byte("funder")), sdk.NewCoins(fee(600)), vestingStart, lockupPeriods, vestingPeriods)
+
+	blockTime := vestingStart.Add(13 * time.Hour)
+	unlocked := va.UnlockAll(blockTime)
+
+	suite.Require().Equal(sdk.NewCoins(fee(300)), unlocked)
+	suite.Require().Empty(va.GetLockedUpCoins(blockTime))
+	suite.Require().Equal(vestingStart.Add(8*time.Hour).Unix(), va.EndTime)
+	// the vesting schedule is not affected
+	suite.Require().Equal(sdk.NewCoins(fee(600)), va.GetVestingCoins(vestingStart.Add(time.Hour)))
+	suite.Require().NoError(va.Validate())
+}
+
+func (suite *VestingAccountTestSuite) TestAccelerateVesting() {
+	fee := func(x int64) sdk.Coin { return sdk.NewInt64Coin(testutil.FeeDenom, x) }
+	vestingStart := tmtime.Now()
+	vestingPeriods := sdkvesting.Periods{
+		{Length: int64(4 * 3600), Amount: sdk.NewCoins(fee(200))},
+		{Length: int64(4 * 3600), Amount: sdk.NewCoins(fee(200))},
+		{Length: int64(4 * 3600), Amount: sdk.NewCoins(fee(200))},
+	}
+	lockupPeriods := sdkvesting.Periods{{Length: int64(24 * 3600), Amount: sdk.NewCoins(fee(600))}}
+
+	addr := sdk.AccAddress("test_address")
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+	va := types.NewClawbackVestingAccount(bacc, sdk.AccAddress([]byte("funder")), sdk.NewCoins(fee(600)), vestingStart, lockupPeriods, vestingPeriods)
+
+	blockTime := vestingStart.Add(5 * time.Hour)
+	accelerated, err := va.AccelerateVesting(blockTime)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(sdk.NewCoins(fee(400)), accelerated)
+	suite.Require().Empty(va.GetVestingCoins(blockTime))
+	// the passed vesting periods and the lockup schedule are kept
+	suite.Require().Equal(sdk.NewCoins(fee(200)), va.GetVestedCoins(vestingStart.Add(4*time.Hour)))
+	suite.Require().Equal(sdk.NewCoins(fee(600)), va.GetLockedUpCoins(blockTime))
+	suite.Require().NoError(va.Validate())
+
+	_, err = va.AccelerateVesting(blockTime)
+	suite.Require().Error(err)
+}
@@ -11,6 +11,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 )
 
@@ -30,6 +31,30 @@ const (
 	updateVestingFunder          = "evmos/MsgUpdateVestingFunder"
 	convertVestingAccount        = "evmos/MsgConvertVestingAccount"
 	fundVestingAccount           = "evmos/MsgFundVestingAccount"
+	migrateVestingAccount        = "evmos/MsgMigrateVestingAccount"
+	updateParams                 = "evmos/vesting/MsgUpdateParams"
+	setVestingManager            = "evmos/MsgSetVestingManager"
+	batchClawback                = "evmos/vesting/MsgBatchClawback"
+	renounceClawback             = "evmos/MsgRenounceClawback"
+	pauseVesting                 = "evmos/vesting/MsgPauseVesting"
+	resumeVesting                = "evmos/vesting/MsgResumeVesting"
+	unlockVesting                = "evmos/vesting/MsgUnlockVesting"
+	proposeScheduleAmendment     = "evmos/vesting/MsgProposeScheduleAmendment"
+	acceptScheduleAmendment      = "evmos/vesting/MsgAcceptScheduleAmendment"
+	selfLockup                   = "evmos/vesting/MsgSelfLockup"
+	fundFromCommunityPool        = "evmos/vesting/MsgFundVestingAccountFromCommunityPool"
+	createFundingInstruction     = "evmos/vesting/MsgCreateFundingInstruction"
+	cancelFundingInstruction     = "evmos/vesting/MsgCancelFundingInstruction"
+	createEscrowGrant            = "evmos/vesting/MsgCreateEscrowGrant"
+	claimEscrowGrant             = "evmos/vesting/MsgClaimEscrowGrant"
+	clawbackEscrowGrant          = "evmos/vesting/MsgClawbackEscrowGrant"
+	createFunderCommittee        = "evmos/vesting/MsgCreateFunderCommittee"
+	submitCommitteeProposal      = "evmos/vesting/MsgSubmitCommitteeProposal"
+	approveCommitteeProposal     = "evmos/vesting/MsgApproveCommitteeProposal"
+	recoverVestingAccount        = "evmos/vesting/MsgRecoverVestingAccount"
+	mergeVestingAccounts         = "evmos/vesting/MsgMergeVestingAccounts"
+	fundVestingAuthorization     = "evmos/vesting/FundVestingAuthorization"
+	clawbackAuthorization        = "evmos/vesting/ClawbackAuthorization"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -68,6 +93,28 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgFundVestingAccount{},
 		&MsgConvertVestingAccount{},
 		&MsgClawback{},
+		&MsgMigrateVestingAccount{},
+		&MsgUpdateParams{},
+		&MsgSetVestingManager{},
+		&MsgBatchClawback{},
+		&MsgRenounceClawback{},
+		&MsgPauseVesting{},
+		&MsgResumeVesting{},
+		&MsgUnlockVesting{},
+		&MsgProposeScheduleAmendment{},
+		&MsgAcceptScheduleAmendment{},
+		&MsgSelfLockup{},
+		&MsgFundVestingAccountFromCommunityPool{},
+		&MsgCreateFundingInstruction{},
+		&MsgCancelFundingInstruction{},
+		&MsgCreateEscrowGrant{},
+		&MsgClaimEscrowGrant{},
+		&MsgClawbackEscrowGrant{},
+		&MsgCreateFunderCommittee{},
+		&MsgSubmitCommitteeProposal{},
+		&MsgApproveCommitteeProposal{},
+		&MsgRecoverVestingAccount{},
+		&MsgMergeVestingAccounts{},
 	)
 
 	registry.RegisterImplementations(
@@ -75,6 +122,12 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&ClawbackProposal{},
 	)
 
+	registry.RegisterImplementations(
+		(*authz.Authorization)(nil),
+		&FundVestingAuthorization{},
+		&ClawbackAuthorization{},
+	)
+
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
 
@@ -87,4 +140,28 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateVestingFunder{}, updateVestingFunder, nil)
 	cdc.RegisterConcrete(&MsgConvertVestingAccount{}, convertVestingAccount, nil)
 	cdc.RegisterConcrete(&MsgFundVestingAccount{}, fundVestingAccount, nil)
+	cdc.RegisterConcrete(&MsgMigrateVestingAccount{}, migrateVestingAccount, nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParams, nil)
+	cdc.RegisterConcrete(&MsgSetVestingManager{}, setVestingManager, nil)
+	cdc.RegisterConcrete(&MsgBatchClawback{}, batchClawback, nil)
+	cdc.RegisterConcrete(&MsgRenounceClawback{}, renounceClawback, nil)
+	cdc.RegisterConcrete(&MsgPauseVesting{}, pauseVesting, nil)
+	cdc.RegisterConcrete(&MsgResumeVesting{}, resumeVesting, nil)
+	cdc.RegisterConcrete(&MsgUnlockVesting{}, unlockVesting, nil)
+	cdc.RegisterConcrete(&MsgProposeScheduleAmendment{}, proposeScheduleAmendment, nil)
+	cdc.RegisterConcrete(&MsgAcceptScheduleAmendment{}, acceptScheduleAmendment, nil)
+	cdc.RegisterConcrete(&MsgSelfLockup{}, selfLockup, nil)
+	cdc.RegisterConcrete(&MsgFundVestingAccountFromCommunityPool{}, fundFromCommunityPool, nil)
+	cdc.RegisterConcrete(&MsgCreateFundingInstruction{}, createFundingInstruction, nil)
+	cdc.RegisterConcrete(&MsgCancelFundingInstruction{}, cancelFundingInstruction, nil)
+	cdc.RegisterConcrete(&MsgCreateEscrowGrant{}, createEscrowGrant, nil)
+	cdc.RegisterConcrete(&MsgClaimEscrowGrant{}, claimEscrowGrant, nil)
+	cdc.RegisterConcrete(&MsgClawbackEscrowGrant{}, clawbackEscrowGrant, nil)
+	cdc.RegisterConcrete(&MsgCreateFunderCommittee{}, createFunderCommittee, nil)
+	cdc.RegisterConcrete(&MsgSubmitCommitteeProposal{}, submitCommitteeProposal, nil)
+	cdc.RegisterConcrete(&MsgApproveCommitteeProposal{}, approveCommitteeProposal, nil)
+	cdc.RegisterConcrete(&MsgRecoverVestingAccount{}, recoverVestingAccount, nil)
+	cdc.RegisterConcrete(&MsgMergeVestingAccounts{}, mergeVestingAccounts, nil)
+	cdc.RegisterConcrete(&FundVestingAuthorization{}, fundVestingAuthorization, nil)
+	cdc.RegisterConcrete(&ClawbackAuthorization{}, clawbackAuthorization, nil)
 }
@@ -15,4 +15,5 @@ var (
 	ErrNothingToClawback         = errorsmod.Register(ModuleName, 5, "nothing to clawback from the account")
 	ErrNotSubjectToClawback      = errorsmod.Register(ModuleName, 6, "account is not subject to clawback vesting")
 	ErrNotSubjectToGovClawback   = errorsmod.Register(ModuleName, 7, "account does not have governance clawback enabled")
+	ErrClawbackRenounced         = errorsmod.Register(ModuleName, 8, "clawback of the account was renounced by the funder")
 )
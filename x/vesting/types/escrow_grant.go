@@ -0,0 +1,117 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// NewEscrowGrant returns a new EscrowGrant for the coins of the given vesting
+// periods
+func NewEscrowGrant(
+	id uint64,
+	funder, recipient sdk.AccAddress,
+	startTime int64,
+	lockupPeriods, vestingPeriods sdkvesting.Periods,
+) EscrowGrant {
+	return EscrowGrant{
+		Id:               id,
+		FunderAddress:    funder.String(),
+		RecipientAddress: recipient.String(),
+		StartTime:        startTime,
+		LockupPeriods:    lockupPeriods,
+		VestingPeriods:   vestingPeriods,
+		OriginalAmount:   vestingPeriods.TotalAmount(),
+		Claimed:          sdk.NewCoins(),
+	}
+}
+
+// Validate checks that the grant describes lockup and vesting schedules for
+// its original amount, and that the claimed coins do not exceed it.
+func (g EscrowGrant) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(g.FunderAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid funder address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(g.RecipientAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid recipient address")
+	}
+
+	if g.StartTime < 0 || g.ClawbackTime < 0 {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "negative start or clawback time")
+	}
+
+	if !g.OriginalAmount.IsValid() || g.OriginalAmount.IsZero() {
+		return errortypes.ErrInvalidCoins.Wrap(g.OriginalAmount.String())
+	}
+
+	if !CoinEq(g.LockupPeriods.TotalAmount(), g.OriginalAmount) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "original amount %s does not match total lockup amount %s", g.OriginalAmount, g.LockupPeriods.TotalAmount())
+	}
+
+	if !CoinEq(g.VestingPeriods.TotalAmount(), g.OriginalAmount) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "original amount %s does not match total vesting amount %s", g.OriginalAmount, g.VestingPeriods.TotalAmount())
+	}
+
+	if !g.Claimed.IsValid() || !g.OriginalAmount.IsAllGTE(g.Claimed) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "claimed coins %s exceed the original amount %s", g.Claimed, g.OriginalAmount)
+	}
+
+	return nil
+}
+
+// IsClawedBack returns true if the unvested coins of the grant were clawed
+// back.
+func (g EscrowGrant) IsClawedBack() bool {
+	return g.ClawbackTime != 0
+}
+
+// GetUnlockedCoins returns the unlocked coins at blockTime, which can be
+// vested or unvested.
+func (g EscrowGrant) GetUnlockedCoins(blockTime time.Time) sdk.Coins {
+	endTime := g.StartTime + g.LockupPeriods.TotalLength()
+	return ReadSchedule(g.StartTime, endTime, g.LockupPeriods, g.OriginalAmount, blockTime.Unix())
+}
+
+// GetVestedCoins returns the vested coins at blockTime. If the grant was
+// clawed back, the vested coins at the clawback time are returned.
+func (g EscrowGrant) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	readTime := blockTime.Unix()
+	if g.IsClawedBack() {
+		readTime = Min64(readTime, g.ClawbackTime)
+	}
+
+	endTime := g.StartTime + g.VestingPeriods.TotalLength()
+	return ReadSchedule(g.StartTime, endTime, g.VestingPeriods, g.OriginalAmount, readTime)
+}
+
+// GetClaimableCoins returns the vested and unlocked coins at blockTime that
+// have not been claimed yet.
+func (g EscrowGrant) GetClaimableCoins(blockTime time.Time) sdk.Coins {
+	released := g.GetUnlockedCoins(blockTime).Min(g.GetVestedCoins(blockTime))
+	return released.Sub(g.Claimed...)
+}
+
+// Clawback marks the grant as clawed back at blockTime and returns the
+// unvested coins, which are no longer held in escrow for the recipient.
+func (g *EscrowGrant) Clawback(blockTime time.Time) sdk.Coins {
+	unvested := g.OriginalAmount.Sub(g.GetVestedCoins(blockTime)...)
+	g.ClawbackTime = blockTime.Unix()
+	return unvested
+}
+
+// IsFullyClaimed returns true if all the coins that the recipient can receive
+// from the grant have been claimed.
+func (g EscrowGrant) IsFullyClaimed() bool {
+	total := g.OriginalAmount
+	if g.IsClawedBack() {
+		total = g.GetVestedCoins(time.Unix(g.ClawbackTime, 0))
+	}
+	return CoinEq(g.Claimed, total)
+}
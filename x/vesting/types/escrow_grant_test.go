@@ -0,0 +1,129 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/evmos/vesting/testutil"
+	"github.com/evmos/vesting/x/vesting/types"
+	"github.com/stretchr/testify/suite"
+)
+
+type EscrowGrantTestSuite struct {
+	suite.Suite
+}
+
+func TestEscrowGrantTestSuite(t *testing.T) {
+	suite.Run(t, new(EscrowGrantTestSuite))
+}
+
+func (suite *EscrowGrantTestSuite) newGrant() types.EscrowGrant {
+	coins := sdk.NewCoins(sdk.NewInt64Coin(testutil.FeeDenom, 100))
+	funder := sdk.AccAddress([]byte("funder______________"))
+	recipient := sdk.AccAddress([]byte("recipient___________"))
+
+	// vests 100 every 10 seconds and unlocks everything after 25 seconds
+	return types.NewEscrowGrant(
+		1, funder, recipient, 1000,
+		sdkvesting.Periods{{Length: 25, Amount: coins.MulInt(sdk.NewInt(4))}},
+		sdkvesting.Periods{
+			{Length: 10, Amount: coins},
+			{Length: 10, Amount: coins},
+			{Length: 10, Amount: coins},
+			{Length: 10, Amount: coins},
+		},
+	)
+}
+
+func (suite *EscrowGrantTestSuite) TestValidate() {
+	testCases := []struct {
+		name     string
+		malleate func(grant *types.EscrowGrant)
+		expPass  bool
+	}{
+		{
+			"pass - new grant",
+			func(_ *types.EscrowGrant) {},
+			true,
+		},
+		{
+			"fail - invalid recipient",
+			func(grant *types.EscrowGrant) {
+				grant.RecipientAddress = "invalid"
+			},
+			false,
+		},
+		{
+			"fail - lockup amount mismatch",
+			func(grant *types.EscrowGrant) {
+				grant.LockupPeriods = grant.LockupPeriods[:0]
+			},
+			false,
+		},
+		{
+			"fail - claimed exceeds original amount",
+			func(grant *types.EscrowGrant) {
+				grant.Claimed = grant.OriginalAmount.Add(grant.OriginalAmount...)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			grant := suite.newGrant()
+			tc.malleate(&grant)
+
+			err := grant.Validate()
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *EscrowGrantTestSuite) TestClaimableCoins() {
+	grant := suite.newGrant()
+	coin := func(amt int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(testutil.FeeDenom, amt))
+	}
+
+	// nothing is claimable before the end of the lockup
+	suite.Require().True(grant.GetClaimableCoins(time.Unix(1020, 0)).IsZero())
+	suite.Require().Equal(coin(200), grant.GetVestedCoins(time.Unix(1020, 0)))
+
+	// the vested coins are claimable once unlocked
+	suite.Require().Equal(coin(200), grant.GetClaimableCoins(time.Unix(1025, 0)))
+
+	grant.Claimed = coin(200)
+	suite.Require().True(grant.GetClaimableCoins(time.Unix(1025, 0)).IsZero())
+	suite.Require().Equal(coin(100), grant.GetClaimableCoins(time.Unix(1030, 0)))
+	suite.Require().False(grant.IsFullyClaimed())
+
+	grant.Claimed = coin(400)
+	suite.Require().True(grant.IsFullyClaimed())
+}
+
+func (suite *EscrowGrantTestSuite) TestClawback() {
+	grant := suite.newGrant()
+	coin := func(amt int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(testutil.FeeDenom, amt))
+	}
+
+	clawedBack := grant.Clawback(time.Unix(1015, 0))
+	suite.Require().Equal(coin(300), clawedBack)
+	suite.Require().True(grant.IsClawedBack())
+
+	// no more coins vest after the clawback
+	suite.Require().Equal(coin(100), grant.GetVestedCoins(time.Unix(2000, 0)))
+	suite.Require().True(grant.GetClaimableCoins(time.Unix(1020, 0)).IsZero())
+	suite.Require().Equal(coin(100), grant.GetClaimableCoins(time.Unix(1025, 0)))
+
+	suite.Require().False(grant.IsFullyClaimed())
+	grant.Claimed = coin(100)
+	suite.Require().True(grant.IsFullyClaimed())
+}
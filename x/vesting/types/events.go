@@ -9,11 +9,63 @@ const (
 	EventTypeFundVestingAccount           = "fund_vesting_account"
 	EventTypeClawback                     = "clawback"
 	EventTypeUpdateVestingFunder          = "update_vesting_funder"
+	EventTypeMigrateVestingAccount        = "migrate_vesting_account"
+	EventTypeConvertVestingAccount        = "convert_vesting_account"
+	EventTypeVested                       = "vested"
+	EventTypeUnlocked                     = "unlocked"
+	EventTypeSetVestingManager            = "set_vesting_manager"
+	EventTypeBatchClawback                = "batch_clawback"
+	EventTypeBurnClawback                 = "burn_clawback"
+	EventTypeRenounceClawback             = "renounce_clawback"
+	EventTypePauseVesting                 = "pause_vesting"
+	EventTypeResumeVesting                = "resume_vesting"
+	EventTypeUnlockVesting                = "unlock_vesting"
+	EventTypeProposeScheduleAmendment     = "propose_schedule_amendment"
+	EventTypeAcceptScheduleAmendment      = "accept_schedule_amendment"
+	EventTypeSelfLockup                   = "self_lockup"
+	EventTypeFundFromCommunityPool        = "fund_from_community_pool"
+	EventTypeCreateFundingInstruction     = "create_funding_instruction"
+	EventTypeCancelFundingInstruction     = "cancel_funding_instruction"
+	EventTypeExecuteFundingInstruction    = "execute_funding_instruction"
+	EventTypeFundingInstructionFailed     = "funding_instruction_failed"
+	EventTypeCreateEscrowGrant            = "create_escrow_grant"
+	EventTypeClaimEscrowGrant             = "claim_escrow_grant"
+	EventTypeClawbackEscrowGrant          = "clawback_escrow_grant"
+	EventTypeCreateFunderCommittee        = "create_funder_committee"
+	EventTypeSubmitCommitteeProposal      = "submit_committee_proposal"
+	EventTypeApproveCommitteeProposal     = "approve_committee_proposal"
+	EventTypeExecuteCommitteeProposal     = "execute_committee_proposal"
+	EventTypeAccelerateVesting            = "accelerate_vesting"
+	EventTypeRecoverVestingAccount        = "recover_vesting_account"
+	EventTypeMergeVestingAccounts         = "merge_vesting_accounts"
 
-	AttributeKeyCoins       = "coins"
-	AttributeKeyStartTime   = "start_time"
-	AttributeKeyAccount     = "account"
-	AttributeKeyFunder      = "funder"
-	AttributeKeyNewFunder   = "new_funder"
-	AttributeKeyDestination = "destination"
+	AttributeKeyCoins         = "coins"
+	AttributeKeyStartTime     = "start_time"
+	AttributeKeyAccount       = "account"
+	AttributeKeyFunder        = "funder"
+	AttributeKeyNewFunder     = "new_funder"
+	AttributeKeyDestination   = "destination"
+	AttributeKeyAmount        = "amount"
+	AttributeKeyPeriodIndex   = "period_index"
+	AttributeKeyManager       = "manager"
+	AttributeKeySucceeded     = "succeeded"
+	AttributeKeyFailed        = "failed"
+	AttributeKeyGovClawback   = "gov_clawback"
+	AttributeKeyPausedAt      = "paused_at"
+	AttributeKeyPauseDuration = "pause_duration"
+	AttributeKeyID            = "id"
+	AttributeKeyRemaining     = "remaining"
+	AttributeKeyError         = "error"
+	AttributeKeyRecipient     = "recipient"
+	AttributeKeyCommittee     = "committee"
+	AttributeKeyMembers       = "members"
+	AttributeKeyThreshold     = "threshold"
+	AttributeKeyProposer      = "proposer"
+	AttributeKeyMember        = "member"
+	AttributeKeyAction        = "action"
+	AttributeKeyApprovals     = "approvals"
+	AttributeKeyNewAddress    = "new_address"
+	AttributeKeyDelegated     = "delegated"
+	AttributeKeySource        = "source"
+	AttributeKeyTarget        = "target"
 )
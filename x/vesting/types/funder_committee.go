@@ -0,0 +1,159 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// funderCommitteeKey is the derivation key of the funder committee addresses
+const funderCommitteeKey = "funder_committee"
+
+// NewFunderCommitteeAddress returns the address of the funder committee with
+// the given id, derived from the vesting module address. No private key
+// exists for the address.
+func NewFunderCommitteeAddress(id uint64) sdk.AccAddress {
+	return address.Module(ModuleName, []byte(funderCommitteeKey), sdk.Uint64ToBigEndian(id))
+}
+
+// ValidateCommitteeMembers checks that the members of a funder committee are
+// valid, non-duplicated addresses and that the threshold can be reached.
+func ValidateCommitteeMembers(members []string, threshold uint32) error {
+	if len(members) == 0 {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "members cannot be empty")
+	}
+
+	seen := make(map[string]bool, len(members))
+	for _, member := range members {
+		if _, err := sdk.AccAddressFromBech32(member); err != nil {
+			return errorsmod.Wrapf(err, "invalid member address %s", member)
+		}
+		if seen[member] {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "duplicate member %s", member)
+		}
+		seen[member] = true
+	}
+
+	if threshold == 0 || int(threshold) > len(members) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "threshold must be between 1 and the number of members (%d), got %d", len(members), threshold)
+	}
+
+	return nil
+}
+
+// Validate checks that the committee has a valid address and members, a
+// reachable threshold and a positive proposal lifetime.
+func (c FunderCommittee) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(c.Address); err != nil {
+		return errorsmod.Wrapf(err, "invalid committee address")
+	}
+
+	if err := ValidateCommitteeMembers(c.Members, c.Threshold); err != nil {
+		return err
+	}
+
+	if c.ProposalLifetime <= 0 {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "proposal lifetime must be positive, got %d", c.ProposalLifetime)
+	}
+
+	return nil
+}
+
+// IsMember returns true if the given address is a member of the committee.
+func (c FunderCommittee) IsMember(address string) bool {
+	for _, member := range c.Members {
+		if member == address {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks that the proposal has a valid id and addresses, the fields
+// required by its action and at least one approval, without duplicates.
+func (p CommitteeProposal) Validate() error {
+	if p.Id == 0 {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "id must be greater than 0")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(p.CommitteeAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid committee address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(p.Proposer); err != nil {
+		return errorsmod.Wrapf(err, "invalid proposer address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(p.VestingAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid vesting address")
+	}
+
+	if err := ValidateCommitteeAction(p.Action, p.DestAddress, p.NewFunderAddress); err != nil {
+		return err
+	}
+
+	if len(p.Approvals) == 0 {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "approvals cannot be empty")
+	}
+
+	seen := make(map[string]bool, len(p.Approvals))
+	for _, approval := range p.Approvals {
+		if seen[approval] {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "duplicate approval %s", approval)
+		}
+		seen[approval] = true
+	}
+
+	return nil
+}
+
+// HasApproved returns true if the given member approved the proposal.
+func (p CommitteeProposal) HasApproved(member string) bool {
+	for _, approval := range p.Approvals {
+		if approval == member {
+			return true
+		}
+	}
+	return false
+}
+
+// IsExpired returns true if the proposal has expired at blockTime.
+func (p CommitteeProposal) IsExpired(blockTime time.Time) bool {
+	return blockTime.Unix() >= p.ExpiryTime
+}
+
+// ValidateCommitteeAction checks that the fields required by the given action
+// of a funder committee proposal are present.
+func ValidateCommitteeAction(action CommitteeAction, destAddress, newFunderAddress string) error {
+	switch action {
+	case COMMITTEE_ACTION_CLAWBACK:
+		// NOTE: the committee address cannot spend the clawed back coins
+		if _, err := sdk.AccAddressFromBech32(destAddress); err != nil {
+			return errorsmod.Wrapf(err, "invalid dest address")
+		}
+		if newFunderAddress != "" {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "new funder address must be empty for a clawback")
+		}
+	case COMMITTEE_ACTION_UPDATE_FUNDER:
+		if _, err := sdk.AccAddressFromBech32(newFunderAddress); err != nil {
+			return errorsmod.Wrapf(err, "invalid new funder address")
+		}
+		if destAddress != "" {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "dest address must be empty for a funder update")
+		}
+	case COMMITTEE_ACTION_ACCELERATE:
+		if destAddress != "" || newFunderAddress != "" {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "dest and new funder addresses must be empty for an acceleration")
+		}
+	default:
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid committee action %s", action)
+	}
+
+	return nil
+}
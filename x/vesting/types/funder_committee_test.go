@@ -0,0 +1,175 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/vesting/x/vesting/types"
+	"github.com/stretchr/testify/suite"
+)
+
+type FunderCommitteeTestSuite struct {
+	suite.Suite
+}
+
+func TestFunderCommitteeTestSuite(t *testing.T) {
+	suite.Run(t, new(FunderCommitteeTestSuite))
+}
+
+func (suite *FunderCommitteeTestSuite) TestNewFunderCommitteeAddress() {
+	suite.Require().Equal(types.NewFunderCommitteeAddress(1), types.NewFunderCommitteeAddress(1))
+	suite.Require().NotEqual(types.NewFunderCommitteeAddress(1), types.NewFunderCommitteeAddress(2))
+}
+
+func (suite *FunderCommitteeTestSuite) TestValidateCommitteeMembers() {
+	member1 := sdk.AccAddress([]byte("member1_____________")).String()
+	member2 := sdk.AccAddress([]byte("member2_____________")).String()
+
+	testCases := []struct {
+		name      string
+		members   []string
+		threshold uint32
+		expPass   bool
+	}{
+		{
+			"pass - threshold of all members",
+			[]string{member1, member2},
+			2,
+			true,
+		},
+		{
+			"pass - threshold of one member",
+			[]string{member1, member2},
+			1,
+			true,
+		},
+		{
+			"fail - no members",
+			nil,
+			1,
+			false,
+		},
+		{
+			"fail - invalid member",
+			[]string{member1, "invalid"},
+			1,
+			false,
+		},
+		{
+			"fail - duplicate member",
+			[]string{member1, member1},
+			1,
+			false,
+		},
+		{
+			"fail - zero threshold",
+			[]string{member1, member2},
+			0,
+			false,
+		},
+		{
+			"fail - threshold above the number of members",
+			[]string{member1, member2},
+			3,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := types.ValidateCommitteeMembers(tc.members, tc.threshold)
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *FunderCommitteeTestSuite) TestValidateCommitteeAction() {
+	addr := sdk.AccAddress([]byte("address_____________")).String()
+
+	testCases := []struct {
+		name      string
+		action    types.CommitteeAction
+		dest      string
+		newFunder string
+		expPass   bool
+	}{
+		{
+			"pass - clawback",
+			types.COMMITTEE_ACTION_CLAWBACK,
+			addr,
+			"",
+			true,
+		},
+		{
+			"fail - clawback without dest",
+			types.COMMITTEE_ACTION_CLAWBACK,
+			"",
+			"",
+			false,
+		},
+		{
+			"pass - update funder",
+			types.COMMITTEE_ACTION_UPDATE_FUNDER,
+			"",
+			addr,
+			true,
+		},
+		{
+			"fail - update funder without new funder",
+			types.COMMITTEE_ACTION_UPDATE_FUNDER,
+			"",
+			"",
+			false,
+		},
+		{
+			"pass - accelerate",
+			types.COMMITTEE_ACTION_ACCELERATE,
+			"",
+			"",
+			true,
+		},
+		{
+			"fail - accelerate with dest",
+			types.COMMITTEE_ACTION_ACCELERATE,
+			addr,
+			"",
+			false,
+		},
+		{
+			"fail - unspecified action",
+			types.COMMITTEE_ACTION_UNSPECIFIED,
+			"",
+			"",
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := types.ValidateCommitteeAction(tc.action, tc.dest, tc.newFunder)
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *FunderCommitteeTestSuite) TestCommitteeProposal() {
+	member := sdk.AccAddress([]byte("member______________")).String()
+	proposal := types.CommitteeProposal{
+		Approvals:  []string{member},
+		ExpiryTime: 1000,
+	}
+
+	suite.Require().True(proposal.HasApproved(member))
+	suite.Require().False(proposal.HasApproved(sdk.AccAddress([]byte("other_______________")).String()))
+	suite.Require().False(proposal.IsExpired(time.Unix(999, 0)))
+	suite.Require().True(proposal.IsExpired(time.Unix(1000, 0)))
+}
@@ -0,0 +1,49 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Validate checks that the instruction has valid addresses, a positive
+// interval, remaining grants and a valid lockup periods template.
+func (fi FundingInstruction) Validate() error {
+	if fi.Id == 0 {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "id must be greater than 0")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(fi.FunderAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid funder address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(fi.VestingAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid vesting address")
+	}
+
+	if fi.Interval < 1 {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid interval of %d, interval must be greater than 0", fi.Interval)
+	}
+
+	if fi.RemainingCount == 0 {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "remaining count must be greater than 0")
+	}
+
+	if len(fi.LockupPeriods) == 0 {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "lockup periods cannot be empty")
+	}
+
+	for i, period := range fi.LockupPeriods {
+		if period.Length < 1 {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid period length of %d in period %d, length must be greater than 0", period.Length, i)
+		}
+		if !period.Amount.IsValid() || period.Amount.IsZero() {
+			return errortypes.ErrInvalidCoins.Wrap(period.Amount.String())
+		}
+	}
+
+	return nil
+}
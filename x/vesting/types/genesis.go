@@ -0,0 +1,181 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+var _ codectypes.UnpackInterfacesMessage = GenesisState{}
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params) GenesisState {
+	return GenesisState{
+		Params: params,
+	}
+}
+
+// DefaultGenesisState sets default vesting genesis state.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seenWrapped := make(map[string]bool)
+	for _, accAny := range gs.WrappedAccounts {
+		acc, ok := accAny.GetCachedValue().(authtypes.AccountI)
+		if !ok {
+			return fmt.Errorf("expected wrapped account, got %T", accAny.GetCachedValue())
+		}
+		if acc.GetAddress().Empty() {
+			return fmt.Errorf("wrapped account has an empty address")
+		}
+		if seenWrapped[acc.GetAddress().String()] {
+			return fmt.Errorf("duplicate wrapped account %s", acc.GetAddress())
+		}
+		seenWrapped[acc.GetAddress().String()] = true
+	}
+
+	if err := validateAccountAddresses(gs.GovClawbackDisabledAccounts); err != nil {
+		return fmt.Errorf("invalid gov clawback disabled accounts: %w", err)
+	}
+
+	if err := validateAccountAddresses(gs.ClawbackRenouncedAccounts); err != nil {
+		return fmt.Errorf("invalid clawback renounced accounts: %w", err)
+	}
+
+	pausedAddresses := make([]string, len(gs.PausedAccounts))
+	for i, paused := range gs.PausedAccounts {
+		if paused.PausedAt <= 0 {
+			return fmt.Errorf("invalid pause time %d of account %s", paused.PausedAt, paused.Address)
+		}
+		pausedAddresses[i] = paused.Address
+	}
+	if err := validateAccountAddresses(pausedAddresses); err != nil {
+		return fmt.Errorf("invalid paused accounts: %w", err)
+	}
+
+	amendedAddresses := make([]string, len(gs.ScheduleAmendments))
+	for i, amendment := range gs.ScheduleAmendments {
+		if _, err := sdk.AccAddressFromBech32(amendment.FunderAddress); err != nil {
+			return fmt.Errorf("invalid funder address %s of schedule amendment: %w", amendment.FunderAddress, err)
+		}
+		if err := ValidateAmendmentPeriods(amendment.LockupPeriods, amendment.VestingPeriods); err != nil {
+			return fmt.Errorf("invalid schedule amendment of account %s: %w", amendment.VestingAddress, err)
+		}
+		amendedAddresses[i] = amendment.VestingAddress
+	}
+	if err := validateAccountAddresses(amendedAddresses); err != nil {
+		return fmt.Errorf("invalid schedule amendments: %w", err)
+	}
+
+	seenInstructions := make(map[uint64]bool, len(gs.FundingInstructions))
+	for _, instruction := range gs.FundingInstructions {
+		if err := instruction.Validate(); err != nil {
+			return fmt.Errorf("invalid funding instruction %d: %w", instruction.Id, err)
+		}
+		if seenInstructions[instruction.Id] {
+			return fmt.Errorf("duplicate funding instruction %d", instruction.Id)
+		}
+		if instruction.Id >= gs.NextFundingInstructionId {
+			return fmt.Errorf("funding instruction %d is not lower than the next id %d", instruction.Id, gs.NextFundingInstructionId)
+		}
+		seenInstructions[instruction.Id] = true
+	}
+
+	seenGrants := make(map[uint64]bool, len(gs.EscrowGrants))
+	for _, grant := range gs.EscrowGrants {
+		if err := grant.Validate(); err != nil {
+			return fmt.Errorf("invalid escrow grant %d: %w", grant.Id, err)
+		}
+		if grant.Id == 0 {
+			return fmt.Errorf("invalid escrow grant id 0")
+		}
+		if seenGrants[grant.Id] {
+			return fmt.Errorf("duplicate escrow grant %d", grant.Id)
+		}
+		if grant.Id >= gs.NextEscrowGrantId {
+			return fmt.Errorf("escrow grant %d is not lower than the next id %d", grant.Id, gs.NextEscrowGrantId)
+		}
+		seenGrants[grant.Id] = true
+	}
+
+	committees := make(map[string]FunderCommittee, len(gs.FunderCommittees))
+	for _, committee := range gs.FunderCommittees {
+		if err := committee.Validate(); err != nil {
+			return fmt.Errorf("invalid funder committee %s: %w", committee.Address, err)
+		}
+		if _, ok := committees[committee.Address]; ok {
+			return fmt.Errorf("duplicate funder committee %s", committee.Address)
+		}
+		committees[committee.Address] = committee
+	}
+	// NOTE: the committee addresses are derived from sequential ids starting at 1
+	if len(gs.FunderCommittees) > 0 && uint64(len(gs.FunderCommittees)) >= gs.NextFunderCommitteeId {
+		return fmt.Errorf("next funder committee id %d must be greater than the number of committees %d", gs.NextFunderCommitteeId, len(gs.FunderCommittees))
+	}
+
+	seenProposals := make(map[uint64]bool, len(gs.CommitteeProposals))
+	for _, proposal := range gs.CommitteeProposals {
+		if err := proposal.Validate(); err != nil {
+			return fmt.Errorf("invalid committee proposal %d: %w", proposal.Id, err)
+		}
+		if seenProposals[proposal.Id] {
+			return fmt.Errorf("duplicate committee proposal %d", proposal.Id)
+		}
+		if proposal.Id >= gs.NextCommitteeProposalId {
+			return fmt.Errorf("committee proposal %d is not lower than the next id %d", proposal.Id, gs.NextCommitteeProposalId)
+		}
+		committee, ok := committees[proposal.CommitteeAddress]
+		if !ok {
+			return fmt.Errorf("funder committee %s of committee proposal %d does not exist", proposal.CommitteeAddress, proposal.Id)
+		}
+		for _, approval := range proposal.Approvals {
+			if !committee.IsMember(approval) {
+				return fmt.Errorf("approval %s of committee proposal %d is not a member of the committee", approval, proposal.Id)
+			}
+		}
+		seenProposals[proposal.Id] = true
+	}
+
+	return nil
+}
+
+// validateAccountAddresses checks that the given addresses are valid and unique.
+func validateAccountAddresses(addresses []string) error {
+	seen := make(map[string]bool, len(addresses))
+	for _, address := range addresses {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return fmt.Errorf("invalid address %s: %w", address, err)
+		}
+		if seen[address] {
+			return fmt.Errorf("duplicate address %s", address)
+		}
+		seen[address] = true
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (gs GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, accAny := range gs.WrappedAccounts {
+		var acc authtypes.AccountI
+		if err := unpacker.UnpackAny(accAny, &acc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,1549 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: vesting/v1/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the module's genesis state.
+type GenesisState struct {
+	// params defines all the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// wrapped_accounts defines the accounts of the chain's default type that
+	// were unwrapped into clawback vesting accounts, whose fields are restored
+	// when the vesting accounts are converted back.
+	WrappedAccounts []*types.Any `protobuf:"bytes,2,rep,name=wrapped_accounts,json=wrappedAccounts,proto3" json:"wrapped_accounts,omitempty"`
+	// gov_clawback_disabled_accounts defines the addresses of the vesting
+	// accounts that are not subject to governance clawback.
+	GovClawbackDisabledAccounts []string `protobuf:"bytes,3,rep,name=gov_clawback_disabled_accounts,json=govClawbackDisabledAccounts,proto3" json:"gov_clawback_disabled_accounts,omitempty"`
+	// clawback_renounced_accounts defines the addresses of the vesting accounts
+	// whose funder renounced the clawback.
+	ClawbackRenouncedAccounts []string `protobuf:"bytes,4,rep,name=clawback_renounced_accounts,json=clawbackRenouncedAccounts,proto3" json:"clawback_renounced_accounts,omitempty"`
+	// paused_accounts defines the vesting accounts whose vesting is paused.
+	PausedAccounts []PausedAccount `protobuf:"bytes,5,rep,name=paused_accounts,json=pausedAccounts,proto3" json:"paused_accounts"`
+	// schedule_amendments defines the pending schedule amendments of the vesting
+	// accounts.
+	ScheduleAmendments []ScheduleAmendment `protobuf:"bytes,6,rep,name=schedule_amendments,json=scheduleAmendments,proto3" json:"schedule_amendments"`
+	// funding_instructions defines the pending recurring funding instructions.
+	FundingInstructions []FundingInstruction `protobuf:"bytes,7,rep,name=funding_instructions,json=fundingInstructions,proto3" json:"funding_instructions"`
+	// next_funding_instruction_id defines the id of the next recurring funding
+	// instruction.
+	NextFundingInstructionId uint64 `protobuf:"varint,8,opt,name=next_funding_instruction_id,json=nextFundingInstructionId,proto3" json:"next_funding_instruction_id,omitempty"`
+	// escrow_grants defines the escrow grants held by the vesting module account.
+	EscrowGrants []EscrowGrant `protobuf:"bytes,9,rep,name=escrow_grants,json=escrowGrants,proto3" json:"escrow_grants"`
+	// next_escrow_grant_id defines the id of the next escrow grant.
+	NextEscrowGrantId uint64 `protobuf:"varint,10,opt,name=next_escrow_grant_id,json=nextEscrowGrantId,proto3" json:"next_escrow_grant_id,omitempty"`
+	// funder_committees defines the funder committees.
+	FunderCommittees []FunderCommittee `protobuf:"bytes,11,rep,name=funder_committees,json=funderCommittees,proto3" json:"funder_committees"`
+	// next_funder_committee_id defines the id used to derive the address of the
+	// next funder committee.
+	NextFunderCommitteeId uint64 `protobuf:"varint,12,opt,name=next_funder_committee_id,json=nextFunderCommitteeId,proto3" json:"next_funder_committee_id,omitempty"`
+	// committee_proposals defines the pending funder committee proposals.
+	CommitteeProposals []CommitteeProposal `protobuf:"bytes,13,rep,name=committee_proposals,json=committeeProposals,proto3" json:"committee_proposals"`
+	// next_committee_proposal_id defines the id of the next funder committee
+	// proposal.
+	NextCommitteeProposalId uint64 `protobuf:"varint,14,opt,name=next_committee_proposal_id,json=nextCommitteeProposalId,proto3" json:"next_committee_proposal_id,omitempty"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b0e52020fd2bc94, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetWrappedAccounts() []*types.Any {
+	if m != nil {
+		return m.WrappedAccounts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGovClawbackDisabledAccounts() []string {
+	if m != nil {
+		return m.GovClawbackDisabledAccounts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetClawbackRenouncedAccounts() []string {
+	if m != nil {
+		return m.ClawbackRenouncedAccounts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPausedAccounts() []PausedAccount {
+	if m != nil {
+		return m.PausedAccounts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetScheduleAmendments() []ScheduleAmendment {
+	if m != nil {
+		return m.ScheduleAmendments
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFundingInstructions() []FundingInstruction {
+	if m != nil {
+		return m.FundingInstructions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextFundingInstructionId() uint64 {
+	if m != nil {
+		return m.NextFundingInstructionId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetEscrowGrants() []EscrowGrant {
+	if m != nil {
+		return m.EscrowGrants
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextEscrowGrantId() uint64 {
+	if m != nil {
+		return m.NextEscrowGrantId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetFunderCommittees() []FunderCommittee {
+	if m != nil {
+		return m.FunderCommittees
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextFunderCommitteeId() uint64 {
+	if m != nil {
+		return m.NextFunderCommitteeId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetCommitteeProposals() []CommitteeProposal {
+	if m != nil {
+		return m.CommitteeProposals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextCommitteeProposalId() uint64 {
+	if m != nil {
+		return m.NextCommitteeProposalId
+	}
+	return 0
+}
+
+// PausedAccount defines a vesting account whose vesting is paused.
+type PausedAccount struct {
+	// address is the address of the vesting account
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// paused_at is the unix time at which the vesting of the account was paused
+	PausedAt int64 `protobuf:"varint,2,opt,name=paused_at,json=pausedAt,proto3" json:"paused_at,omitempty"`
+}
+
+func (m *PausedAccount) Reset()         { *m = PausedAccount{} }
+func (m *PausedAccount) String() string { return proto.CompactTextString(m) }
+func (*PausedAccount) ProtoMessage()    {}
+func (*PausedAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b0e52020fd2bc94, []int{1}
+}
+func (m *PausedAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PausedAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PausedAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PausedAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PausedAccount.Merge(m, src)
+}
+func (m *PausedAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *PausedAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_PausedAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PausedAccount proto.InternalMessageInfo
+
+func (m *PausedAccount) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PausedAccount) GetPausedAt() int64 {
+	if m != nil {
+		return m.PausedAt
+	}
+	return 0
+}
+
+// Params defines the parameters of the vesting module.
+type Params struct {
+	// enable_auto_convert enables the automatic conversion in EndBlock of
+	// clawback vesting accounts whose vesting and lockup periods have ended.
+	EnableAutoConvert bool `protobuf:"varint,1,opt,name=enable_auto_convert,json=enableAutoConvert,proto3" json:"enable_auto_convert,omitempty"`
+	// max_conversions_per_block defines the maximum number of accounts that are
+	// converted in a single block.
+	MaxConversionsPerBlock uint32 `protobuf:"varint,2,opt,name=max_conversions_per_block,json=maxConversionsPerBlock,proto3" json:"max_conversions_per_block,omitempty"`
+	// max_schedule_events_per_block defines the maximum number of vesting and
+	// unlocking events that are emitted in a single block. Events that exceed
+	// the budget are emitted in the following blocks. It must be positive.
+	MaxScheduleEventsPerBlock uint32 `protobuf:"varint,3,opt,name=max_schedule_events_per_block,json=maxScheduleEventsPerBlock,proto3" json:"max_schedule_events_per_block,omitempty"`
+	// max_funding_instructions_per_block defines the maximum number of
+	// recurring funding instructions that are executed in a single block.
+	// Instructions that exceed the budget are executed in the following blocks.
+	// It must be positive.
+	MaxFundingInstructionsPerBlock uint32 `protobuf:"varint,4,opt,name=max_funding_instructions_per_block,json=maxFundingInstructionsPerBlock,proto3" json:"max_funding_instructions_per_block,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b0e52020fd2bc94, []int{2}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetEnableAutoConvert() bool {
+	if m != nil {
+		return m.EnableAutoConvert
+	}
+	return false
+}
+
+func (m *Params) GetMaxConversionsPerBlock() uint32 {
+	if m != nil {
+		return m.MaxConversionsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetMaxScheduleEventsPerBlock() uint32 {
+	if m != nil {
+		return m.MaxScheduleEventsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetMaxFundingInstructionsPerBlock() uint32 {
+	if m != nil {
+		return m.MaxFundingInstructionsPerBlock
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "vesting.v1.GenesisState")
+	proto.RegisterType((*PausedAccount)(nil), "vesting.v1.PausedAccount")
+	proto.RegisterType((*Params)(nil), "vesting.v1.Params")
+}
+
+func init() { proto.RegisterFile("vesting/v1/genesis.proto", fileDescriptor_6b0e52020fd2bc94) }
+
+var fileDescriptor_6b0e52020fd2bc94 = []byte{
+	// 759 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x94, 0xcf, 0x4e, 0xdb, 0x4a,
+	0x14, 0xc6, 0x13, 0x92, 0x1b, 0xc8, 0x40, 0xf8, 0x33, 0xe4, 0x5e, 0x1c, 0x72, 0x71, 0xa3, 0xac,
+	0xb2, 0xa9, 0xdd, 0xd0, 0x45, 0x55, 0x55, 0xad, 0x9a, 0xa4, 0x40, 0xd3, 0x45, 0x85, 0x4c, 0xa5,
+	0x4a, 0xdd, 0x58, 0x13, 0x7b, 0x62, 0x2c, 0xe2, 0x19, 0xcb, 0x33, 0x36, 0x61, 0xd9, 0x37, 0xe8,
+	0xc3, 0xf4, 0x21, 0x50, 0x57, 0x2c, 0xbb, 0xaa, 0x2a, 0x78, 0x82, 0xbe, 0x41, 0x35, 0xe3, 0xb1,
+	0x63, 0x08, 0x3b, 0x7b, 0xbe, 0xef, 0xfc, 0xce, 0xe7, 0xf1, 0x99, 0x01, 0x5a, 0x82, 0x19, 0xf7,
+	0x89, 0x67, 0x26, 0x7d, 0xd3, 0xc3, 0x04, 0x33, 0x9f, 0x19, 0x61, 0x44, 0x39, 0x85, 0x40, 0x29,
+	0x46, 0xd2, 0xdf, 0x6f, 0x39, 0x94, 0x05, 0x94, 0xd9, 0x52, 0x31, 0xd3, 0x97, 0xd4, 0xb6, 0xdf,
+	0xf4, 0xa8, 0x47, 0xd3, 0x75, 0xf1, 0xa4, 0x56, 0x5b, 0x1e, 0xa5, 0xde, 0x0c, 0x9b, 0xf2, 0x6d,
+	0x12, 0x4f, 0x4d, 0x44, 0xae, 0x94, 0x54, 0xec, 0x98, 0xb5, 0x90, 0x4a, 0xf7, 0xcf, 0x2a, 0xd8,
+	0x38, 0x49, 0x33, 0x9c, 0x71, 0xc4, 0x31, 0x7c, 0x06, 0x6a, 0x21, 0x8a, 0x50, 0xc0, 0xb4, 0x72,
+	0xa7, 0xdc, 0x5b, 0x3f, 0x84, 0xc6, 0x22, 0x93, 0x71, 0x2a, 0x95, 0x61, 0xf5, 0xfa, 0xd7, 0x93,
+	0x92, 0xa5, 0x7c, 0x10, 0x81, 0xed, 0xcb, 0x08, 0x85, 0x21, 0x76, 0x6d, 0xe4, 0x38, 0x34, 0x26,
+	0x9c, 0x69, 0x2b, 0x9d, 0x4a, 0x6f, 0xfd, 0xb0, 0x69, 0xa4, 0x91, 0x8c, 0x2c, 0x92, 0x31, 0x20,
+	0x57, 0xc3, 0xce, 0x8f, 0xef, 0x4f, 0xff, 0x57, 0xdf, 0x83, 0x62, 0x7e, 0x6e, 0x24, 0xfd, 0x09,
+	0xe6, 0xa8, 0x6f, 0x0c, 0xd2, 0xea, 0xb1, 0xb5, 0xa5, 0x78, 0x6a, 0x81, 0xc1, 0x11, 0xd0, 0x3d,
+	0x9a, 0xd8, 0xce, 0x0c, 0x5d, 0x4e, 0x90, 0x73, 0x61, 0xbb, 0x3e, 0x43, 0x93, 0x59, 0xb1, 0x61,
+	0xa5, 0x53, 0xe9, 0xd5, 0xad, 0xb6, 0x47, 0x93, 0x91, 0x32, 0xbd, 0x53, 0x9e, 0x1c, 0xf2, 0x06,
+	0xb4, 0x73, 0x40, 0x84, 0x09, 0x8d, 0x89, 0x53, 0x24, 0x54, 0x25, 0xa1, 0x95, 0x59, 0xac, 0xcc,
+	0x91, 0xd7, 0xbf, 0x07, 0x5b, 0x21, 0x8a, 0x59, 0xb1, 0xe6, 0x1f, 0xf9, 0x99, 0xad, 0xfb, 0x5b,
+	0x14, 0xb3, 0xbc, 0x48, 0xed, 0xd4, 0x66, 0x58, 0x5c, 0x64, 0xf0, 0x13, 0xd8, 0x65, 0xce, 0x39,
+	0x76, 0xe3, 0x19, 0xb6, 0x51, 0x80, 0x89, 0x1b, 0x60, 0x41, 0xab, 0x49, 0xda, 0x41, 0x91, 0x76,
+	0xa6, 0x6c, 0x83, 0xcc, 0xa5, 0x88, 0x90, 0x3d, 0x14, 0x18, 0xfc, 0x0c, 0x9a, 0xd3, 0x98, 0xb8,
+	0x3e, 0xf1, 0x6c, 0x9f, 0x30, 0x1e, 0xc5, 0x0e, 0xf7, 0x29, 0x61, 0xda, 0xaa, 0xc4, 0xea, 0x45,
+	0xec, 0x71, 0xea, 0x1b, 0x2f, 0x6c, 0x8a, 0xbb, 0x3b, 0x5d, 0x52, 0x18, 0x7c, 0x0d, 0xda, 0x04,
+	0xcf, 0xb9, 0xfd, 0x08, 0xdd, 0xf6, 0x5d, 0x6d, 0xad, 0x53, 0xee, 0x55, 0x2d, 0x4d, 0x58, 0x96,
+	0xb9, 0x63, 0x17, 0x0e, 0x41, 0x03, 0x33, 0x27, 0xa2, 0x97, 0xb6, 0x17, 0x21, 0xf1, 0x9d, 0x75,
+	0x19, 0x68, 0xaf, 0x18, 0xe8, 0x48, 0x1a, 0x4e, 0x84, 0xae, 0x92, 0x6c, 0xe0, 0xc5, 0x12, 0x83,
+	0x26, 0x68, 0xca, 0x08, 0x45, 0x90, 0xe8, 0x0d, 0x64, 0xef, 0x1d, 0xa1, 0x15, 0x10, 0x63, 0x17,
+	0x7e, 0x04, 0x3b, 0x22, 0x2e, 0x8e, 0x6c, 0x87, 0x06, 0x81, 0xcf, 0x39, 0xc6, 0x4c, 0x5b, 0x97,
+	0x8d, 0xdb, 0x0f, 0x77, 0x02, 0x47, 0xa3, 0xcc, 0xa3, 0x9a, 0x6f, 0x4f, 0xef, 0x2f, 0x33, 0xf8,
+	0x02, 0x68, 0xf9, 0x1e, 0x14, 0xa1, 0x22, 0xc4, 0x86, 0x0c, 0xf1, 0x6f, 0xb6, 0x01, 0x85, 0xba,
+	0xb1, 0x2b, 0xfe, 0xf5, 0xc2, 0x1c, 0x46, 0x34, 0xa4, 0x0c, 0xcd, 0x98, 0xd6, 0x58, 0xfe, 0xd7,
+	0x79, 0xd5, 0xa9, 0x72, 0x65, 0xff, 0xda, 0x79, 0x28, 0x30, 0xf8, 0x0a, 0xec, 0xcb, 0x38, 0xcb,
+	0x68, 0x11, 0x68, 0x53, 0x06, 0xda, 0x13, 0x8e, 0x25, 0xe8, 0xd8, 0xed, 0x1e, 0x83, 0xc6, 0xbd,
+	0x29, 0x85, 0x1a, 0x58, 0x45, 0xae, 0x1b, 0x61, 0x96, 0x1e, 0xfa, 0xba, 0x95, 0xbd, 0xc2, 0x36,
+	0xa8, 0x67, 0x33, 0xcf, 0xb5, 0x95, 0x4e, 0xb9, 0x57, 0xb1, 0xd6, 0xd4, 0x30, 0xf3, 0xee, 0xd7,
+	0x15, 0x50, 0x4b, 0x6f, 0x04, 0x68, 0x80, 0x5d, 0x4c, 0xc4, 0x71, 0xb3, 0x51, 0xcc, 0xa9, 0xed,
+	0x50, 0x92, 0xe0, 0x88, 0x4b, 0xda, 0x9a, 0xb5, 0x93, 0x4a, 0x83, 0x98, 0xd3, 0x51, 0x2a, 0xc0,
+	0x97, 0xa0, 0x15, 0xa0, 0xb9, 0xf2, 0x31, 0x31, 0x65, 0x76, 0x88, 0x23, 0x7b, 0x32, 0xa3, 0xce,
+	0x85, 0xec, 0xd3, 0xb0, 0xfe, 0x0b, 0xd0, 0x7c, 0xb4, 0xd0, 0x4f, 0x71, 0x34, 0x14, 0x2a, 0x7c,
+	0x0b, 0x0e, 0x44, 0x69, 0x7e, 0x80, 0x70, 0x22, 0xa6, 0xbf, 0x50, 0x5e, 0x91, 0xe5, 0x82, 0x9f,
+	0x9d, 0x9e, 0x23, 0x69, 0xc9, 0x09, 0x1f, 0x40, 0x57, 0x10, 0x1e, 0x3b, 0x2c, 0x05, 0x4c, 0x55,
+	0x62, 0xf4, 0x00, 0xcd, 0x97, 0xa7, 0x3a, 0x67, 0x0d, 0x87, 0xd7, 0xb7, 0x7a, 0xf9, 0xe6, 0x56,
+	0x2f, 0xff, 0xbe, 0xd5, 0xcb, 0xdf, 0xee, 0xf4, 0xd2, 0xcd, 0x9d, 0x5e, 0xfa, 0x79, 0xa7, 0x97,
+	0xbe, 0xf4, 0x3c, 0x9f, 0x9f, 0xc7, 0x13, 0xc3, 0xa1, 0x81, 0x89, 0x93, 0x80, 0xb2, 0xec, 0xe6,
+	0x35, 0xe7, 0xf9, 0x13, 0xbf, 0x0a, 0x31, 0x9b, 0xd4, 0xe4, 0xf5, 0xf8, 0xfc, 0xef, 0x00, 0x7d,
+	0x7f, 0x29, 0xb8, 0x18, 0x06, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextCommitteeProposalId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextCommitteeProposalId))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.CommitteeProposals) > 0 {
+		for iNdEx := len(m.CommitteeProposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommitteeProposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.NextFunderCommitteeId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextFunderCommitteeId))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.FunderCommittees) > 0 {
+		for iNdEx := len(m.FunderCommittees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FunderCommittees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.NextEscrowGrantId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextEscrowGrantId))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.EscrowGrants) > 0 {
+		for iNdEx := len(m.EscrowGrants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EscrowGrants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.NextFundingInstructionId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextFundingInstructionId))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.FundingInstructions) > 0 {
+		for iNdEx := len(m.FundingInstructions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FundingInstructions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ScheduleAmendments) > 0 {
+		for iNdEx := len(m.ScheduleAmendments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduleAmendments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.PausedAccounts) > 0 {
+		for iNdEx := len(m.PausedAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PausedAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ClawbackRenouncedAccounts) > 0 {
+		for iNdEx := len(m.ClawbackRenouncedAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ClawbackRenouncedAccounts[iNdEx])
+			copy(dAtA[i:], m.ClawbackRenouncedAccounts[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.ClawbackRenouncedAccounts[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.GovClawbackDisabledAccounts) > 0 {
+		for iNdEx := len(m.GovClawbackDisabledAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.GovClawbackDisabledAccounts[iNdEx])
+			copy(dAtA[i:], m.GovClawbackDisabledAccounts[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.GovClawbackDisabledAccounts[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.WrappedAccounts) > 0 {
+		for iNdEx := len(m.WrappedAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WrappedAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PausedAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PausedAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PausedAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PausedAt != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PausedAt))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxFundingInstructionsPerBlock != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxFundingInstructionsPerBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxScheduleEventsPerBlock != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxScheduleEventsPerBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxConversionsPerBlock != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxConversionsPerBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EnableAutoConvert {
+		i--
+		if m.EnableAutoConvert {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.WrappedAccounts) > 0 {
+		for _, e := range m.WrappedAccounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.GovClawbackDisabledAccounts) > 0 {
+		for _, s := range m.GovClawbackDisabledAccounts {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ClawbackRenouncedAccounts) > 0 {
+		for _, s := range m.ClawbackRenouncedAccounts {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PausedAccounts) > 0 {
+		for _, e := range m.PausedAccounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ScheduleAmendments) > 0 {
+		for _, e := range m.ScheduleAmendments {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FundingInstructions) > 0 {
+		for _, e := range m.FundingInstructions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextFundingInstructionId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextFundingInstructionId))
+	}
+	if len(m.EscrowGrants) > 0 {
+		for _, e := range m.EscrowGrants {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextEscrowGrantId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextEscrowGrantId))
+	}
+	if len(m.FunderCommittees) > 0 {
+		for _, e := range m.FunderCommittees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextFunderCommitteeId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextFunderCommitteeId))
+	}
+	if len(m.CommitteeProposals) > 0 {
+		for _, e := range m.CommitteeProposals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextCommitteeProposalId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextCommitteeProposalId))
+	}
+	return n
+}
+
+func (m *PausedAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.PausedAt != 0 {
+		n += 1 + sovGenesis(uint64(m.PausedAt))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EnableAutoConvert {
+		n += 2
+	}
+	if m.MaxConversionsPerBlock != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxConversionsPerBlock))
+	}
+	if m.MaxScheduleEventsPerBlock != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxScheduleEventsPerBlock))
+	}
+	if m.MaxFundingInstructionsPerBlock != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxFundingInstructionsPerBlock))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WrappedAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WrappedAccounts = append(m.WrappedAccounts, &types.Any{})
+			if err := m.WrappedAccounts[len(m.WrappedAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovClawbackDisabledAccounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GovClawbackDisabledAccounts = append(m.GovClawbackDisabledAccounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawbackRenouncedAccounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClawbackRenouncedAccounts = append(m.ClawbackRenouncedAccounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedAccounts = append(m.PausedAccounts, PausedAccount{})
+			if err := m.PausedAccounts[len(m.PausedAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleAmendments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduleAmendments = append(m.ScheduleAmendments, ScheduleAmendment{})
+			if err := m.ScheduleAmendments[len(m.ScheduleAmendments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingInstructions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundingInstructions = append(m.FundingInstructions, FundingInstruction{})
+			if err := m.FundingInstructions[len(m.FundingInstructions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextFundingInstructionId", wireType)
+			}
+			m.NextFundingInstructionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextFundingInstructionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowGrants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowGrants = append(m.EscrowGrants, EscrowGrant{})
+			if err := m.EscrowGrants[len(m.EscrowGrants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEscrowGrantId", wireType)
+			}
+			m.NextEscrowGrantId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextEscrowGrantId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderCommittees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderCommittees = append(m.FunderCommittees, FunderCommittee{})
+			if err := m.FunderCommittees[len(m.FunderCommittees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextFunderCommitteeId", wireType)
+			}
+			m.NextFunderCommitteeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextFunderCommitteeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeProposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommitteeProposals = append(m.CommitteeProposals, CommitteeProposal{})
+			if err := m.CommitteeProposals[len(m.CommitteeProposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextCommitteeProposalId", wireType)
+			}
+			m.NextCommitteeProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextCommitteeProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PausedAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PausedAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PausedAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedAt", wireType)
+			}
+			m.PausedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PausedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableAutoConvert", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableAutoConvert = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConversionsPerBlock", wireType)
+			}
+			m.MaxConversionsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxConversionsPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxScheduleEventsPerBlock", wireType)
+			}
+			m.MaxScheduleEventsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxScheduleEventsPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFundingInstructionsPerBlock", wireType)
+			}
+			m.MaxFundingInstructionsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFundingInstructionsPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,69 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ VestingHooks = MultiVestingHooks{}
+
+// MultiVestingHooks combines multiple vesting hooks. All hook functions are run
+// in array sequence and the first error is returned.
+type MultiVestingHooks []VestingHooks
+
+// NewMultiVestingHooks creates a new MultiVestingHooks instance
+func NewMultiVestingHooks(hooks ...VestingHooks) MultiVestingHooks {
+	return hooks
+}
+
+// AfterVestingAccountCreated runs the AfterVestingAccountCreated hook of all the registered hooks
+func (mh MultiVestingHooks) AfterVestingAccountCreated(ctx sdk.Context, vestingAddr, funderAddr sdk.AccAddress) error {
+	for i := range mh {
+		if err := mh[i].AfterVestingAccountCreated(ctx, vestingAddr, funderAddr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterVestingAccountFunded runs the AfterVestingAccountFunded hook of all the registered hooks
+func (mh MultiVestingHooks) AfterVestingAccountFunded(ctx sdk.Context, vestingAddr, funderAddr sdk.AccAddress, coins sdk.Coins) error {
+	for i := range mh {
+		if err := mh[i].AfterVestingAccountFunded(ctx, vestingAddr, funderAddr, coins); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterClawback runs the AfterClawback hook of all the registered hooks
+func (mh MultiVestingHooks) AfterClawback(ctx sdk.Context, vestingAddr, destAddr sdk.AccAddress, clawedBack sdk.Coins) error {
+	for i := range mh {
+		if err := mh[i].AfterClawback(ctx, vestingAddr, destAddr, clawedBack); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterFunderUpdated runs the AfterFunderUpdated hook of all the registered hooks
+func (mh MultiVestingHooks) AfterFunderUpdated(ctx sdk.Context, vestingAddr, oldFunderAddr, newFunderAddr sdk.AccAddress) error {
+	for i := range mh {
+		if err := mh[i].AfterFunderUpdated(ctx, vestingAddr, oldFunderAddr, newFunderAddr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterVestingAccountConverted runs the AfterVestingAccountConverted hook of all the registered hooks
+func (mh MultiVestingHooks) AfterVestingAccountConverted(ctx sdk.Context, vestingAddr sdk.AccAddress) error {
+	for i := range mh {
+		if err := mh[i].AfterVestingAccountConverted(ctx, vestingAddr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package types_test
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/evmos/vesting/x/vesting/types"
+)
+
+var _ types.VestingHooks = &countingHooks{}
+
+// countingHooks counts the calls to each hook and returns the given error
+type countingHooks struct {
+	calls int
+	err   error
+}
+
+func (h *countingHooks) AfterVestingAccountCreated(_ sdk.Context, _, _ sdk.AccAddress) error {
+	h.calls++
+	return h.err
+}
+
+func (h *countingHooks) AfterVestingAccountFunded(_ sdk.Context, _, _ sdk.AccAddress, _ sdk.Coins) error {
+	h.calls++
+	return h.err
+}
+
+func (h *countingHooks) AfterClawback(_ sdk.Context, _, _ sdk.AccAddress, _ sdk.Coins) error {
+	h.calls++
+	return h.err
+}
+
+func (h *countingHooks) AfterFunderUpdated(_ sdk.Context, _, _, _ sdk.AccAddress) error {
+	h.calls++
+	return h.err
+}
+
+func (h *countingHooks) AfterVestingAccountConverted(_ sdk.Context, _ sdk.AccAddress) error {
+	h.calls++
+	return h.err
+}
+
+type HooksTestSuite struct {
+	suite.Suite
+}
+
+func TestHooksTestSuite(t *testing.T) {
+	suite.Run(t, new(HooksTestSuite))
+}
+
+func (suite *HooksTestSuite) TestMultiVestingHooks() {
+	var (
+		ctx   sdk.Context
+		addr  = sdk.AccAddress("test_address")
+		coins = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	)
+
+	calls := []func(types.VestingHooks) error{
+		func(h types.VestingHooks) error { return h.AfterVestingAccountCreated(ctx, addr, addr) },
+		func(h types.VestingHooks) error { return h.AfterVestingAccountFunded(ctx, addr, addr, coins) },
+		func(h types.VestingHooks) error { return h.AfterClawback(ctx, addr, addr, coins) },
+		func(h types.VestingHooks) error { return h.AfterFunderUpdated(ctx, addr, addr, addr) },
+		func(h types.VestingHooks) error { return h.AfterVestingAccountConverted(ctx, addr) },
+	}
+
+	suite.Run("all hooks are called", func() {
+		first, second := &countingHooks{}, &countingHooks{}
+		hooks := types.NewMultiVestingHooks(first, second)
+
+		for _, call := range calls {
+			suite.Require().NoError(call(hooks))
+		}
+		suite.Require().Equal(len(calls), first.calls)
+		suite.Require().Equal(len(calls), second.calls)
+	})
+
+	suite.Run("error aborts the remaining hooks", func() {
+		expErr := errors.New("hook failed")
+		first, second := &countingHooks{err: expErr}, &countingHooks{}
+		hooks := types.NewMultiVestingHooks(first, second)
+
+		for _, call := range calls {
+			suite.Require().ErrorIs(call(hooks), expErr)
+		}
+		suite.Require().Equal(len(calls), first.calls)
+		suite.Require().Zero(second.calls)
+	})
+
+	suite.Run("no hooks", func() {
+		for _, call := range calls {
+			suite.Require().NoError(call(types.MultiVestingHooks{}))
+		}
+	})
+}
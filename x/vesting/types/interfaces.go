@@ -4,9 +4,12 @@
 package types
 
 import (
+	"time"
+
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // AccountKeeper defines the expected interface contract the vesting module
@@ -22,7 +25,13 @@ type AccountKeeper interface {
 // for creating vesting accounts with funds.
 type BankKeeper interface {
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	SpendableCoin(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	UndelegateCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 	BlockedAddr(addr sdk.AccAddress) bool
 }
 
@@ -32,10 +41,49 @@ type StakingKeeper interface {
 	BondDenom(ctx sdk.Context) string
 	GetDelegatorBonded(ctx sdk.Context, delegator sdk.AccAddress) math.Int
 	GetDelegatorUnbonding(ctx sdk.Context, delegator sdk.AccAddress) math.Int
+	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (validator stakingtypes.Validator, found bool)
+	GetAllDelegatorDelegations(ctx sdk.Context, delegator sdk.AccAddress) []stakingtypes.Delegation
+	GetAllUnbondingDelegations(ctx sdk.Context, delegator sdk.AccAddress) []stakingtypes.UnbondingDelegation
+	ValidateUnbondAmount(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amt math.Int) (shares sdk.Dec, err error)
+	Unbond(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.Dec) (amount math.Int, err error)
+	Delegate(ctx sdk.Context, delAddr sdk.AccAddress, bondAmt math.Int, tokenSrc stakingtypes.BondStatus, validator stakingtypes.Validator, subtractAccount bool) (newShares sdk.Dec, err error)
+	SetUnbondingDelegation(ctx sdk.Context, ubd stakingtypes.UnbondingDelegation)
+	SetUnbondingDelegationEntry(ctx sdk.Context, delegatorAddr sdk.AccAddress, validatorAddr sdk.ValAddress, creationHeight int64, minTime time.Time, balance math.Int) stakingtypes.UnbondingDelegation
+	InsertUBDQueue(ctx sdk.Context, ubd stakingtypes.UnbondingDelegation, completionTime time.Time)
 }
 
 // DistributionKeeper defines the expected interface contract the vesting module
-// requires for clawing back unvested coins to the community pool.
+// requires for clawing back unvested coins to the community pool and funding
+// vesting accounts from it.
 type DistributionKeeper interface {
 	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+	DistributeFromFeePool(ctx sdk.Context, amount sdk.Coins, receiveAddr sdk.AccAddress) error
+}
+
+// AccountConverter defines the hooks the vesting module uses to unwrap the
+// chain's default account type into the BaseAccount embedded in clawback vesting
+// accounts, and to wrap it back when a vesting account is converted.
+type AccountConverter interface {
+	// ToBaseAccount returns the BaseAccount wrapped by the given account or an
+	// error if the account cannot be converted into a vesting account.
+	ToBaseAccount(acc authtypes.AccountI) (*authtypes.BaseAccount, error)
+	// FromBaseAccount wraps the given BaseAccount into the chain's default
+	// account type. The original account is the account that was unwrapped by
+	// ToBaseAccount when the vesting account was created, so that its fields
+	// that are not part of the BaseAccount can be restored. It is nil if the
+	// vesting account was not created from an account of the default type
+	// (e.g. migrated from an x/auth/vesting account) or if it was a BaseAccount.
+	FromBaseAccount(baseAcc *authtypes.BaseAccount, original authtypes.AccountI) authtypes.AccountI
+}
+
+// VestingHooks defines the hooks that other modules can register to be notified
+// of changes to clawback vesting accounts. An error returned by a hook aborts
+// the transaction. Escrow grants, which are held by the module account, and
+// manager updates do not call any hook.
+type VestingHooks interface {
+	AfterVestingAccountCreated(ctx sdk.Context, vestingAddr, funderAddr sdk.AccAddress) error
+	AfterVestingAccountFunded(ctx sdk.Context, vestingAddr, funderAddr sdk.AccAddress, coins sdk.Coins) error
+	AfterClawback(ctx sdk.Context, vestingAddr, destAddr sdk.AccAddress, clawedBack sdk.Coins) error
+	AfterFunderUpdated(ctx sdk.Context, vestingAddr, oldFunderAddr, newFunderAddr sdk.AccAddress) error
+	AfterVestingAccountConverted(ctx sdk.Context, vestingAddr sdk.AccAddress) error
 }
@@ -3,14 +3,141 @@
 
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// prefixGovClawbackDisabledKey to be used in the KVStore to track vesting accounts that are not subject
 	// to clawback from governance.
 	prefixGovClawbackDisabledKey = iota + 1
+	// prefixParamsKey to be used in the KVStore to store the module parameters.
+	prefixParamsKey
+	// prefixEndTimeIndexKey to be used in the KVStore to index the clawback vesting accounts
+	// by the end time of their vesting and lockup schedules.
+	prefixEndTimeIndexKey
+	// prefixScheduleEventIndexKey to be used in the KVStore to index the upcoming
+	// lockup and vesting events of the clawback vesting accounts by time.
+	prefixScheduleEventIndexKey
+	// prefixClawbackRenouncedKey to be used in the KVStore to track vesting accounts whose
+	// funder renounced the clawback.
+	prefixClawbackRenouncedKey
+	// prefixVestingPausedKey to be used in the KVStore to track vesting accounts whose
+	// vesting is paused.
+	prefixVestingPausedKey
+	// prefixScheduleAmendmentKey to be used in the KVStore to store the pending schedule
+	// amendments of vesting accounts.
+	prefixScheduleAmendmentKey
+	// prefixFundingInstructionKey to be used in the KVStore to store the recurring funding
+	// instructions by id.
+	prefixFundingInstructionKey
+	// prefixFundingInstructionQueueKey to be used in the KVStore to index the recurring
+	// funding instructions by the due time of their next grant.
+	prefixFundingInstructionQueueKey
+	// prefixNextFundingInstructionIDKey to be used in the KVStore to store the id of the
+	// next recurring funding instruction.
+	prefixNextFundingInstructionIDKey
+	// prefixEscrowGrantKey to be used in the KVStore to store the escrow grants by id.
+	prefixEscrowGrantKey
+	// prefixEscrowGrantByRecipientKey to be used in the KVStore to index the escrow grants
+	// by recipient.
+	prefixEscrowGrantByRecipientKey
+	// prefixNextEscrowGrantIDKey to be used in the KVStore to store the id of the next
+	// escrow grant.
+	prefixNextEscrowGrantIDKey
+	// prefixFunderCommitteeKey to be used in the KVStore to store the funder committees
+	// by address.
+	prefixFunderCommitteeKey
+	// prefixNextFunderCommitteeIDKey to be used in the KVStore to store the id used to
+	// derive the address of the next funder committee.
+	prefixNextFunderCommitteeIDKey
+	// prefixCommitteeProposalKey to be used in the KVStore to store the funder committee
+	// proposals by id.
+	prefixCommitteeProposalKey
+	// prefixCommitteeProposalByCommitteeKey to be used in the KVStore to index the funder
+	// committee proposals by committee.
+	prefixCommitteeProposalByCommitteeKey
+	// prefixCommitteeProposalExpiryKey to be used in the KVStore to index the funder
+	// committee proposals by expiry time.
+	prefixCommitteeProposalExpiryKey
+	// prefixNextCommitteeProposalIDKey to be used in the KVStore to store the id of the
+	// next funder committee proposal.
+	prefixNextCommitteeProposalIDKey
+	// prefixWrappedAccountKey to be used in the KVStore to store the accounts of the chain's
+	// default type that were unwrapped into clawback vesting accounts.
+	prefixWrappedAccountKey
+)
+
+// Types of the schedule events stored in the schedule event index
+const (
+	// ScheduleEventTypeUnlock is the end of a lockup period
+	ScheduleEventTypeUnlock byte = iota + 1
+	// ScheduleEventTypeVest is the end of a vesting period
+	ScheduleEventTypeVest
+)
+
+var (
+	// KeyPrefixGovClawbackDisabledKey is the slice of prefix bytes for storing the governance clawback enabled/disabled flag.
+	KeyPrefixGovClawbackDisabledKey = []byte{prefixGovClawbackDisabledKey}
+	// KeyPrefixParams is the slice of prefix bytes for storing the module parameters.
+	KeyPrefixParams = []byte{prefixParamsKey}
+	// KeyPrefixEndTimeIndex is the slice of prefix bytes for the end time index of clawback vesting accounts.
+	KeyPrefixEndTimeIndex = []byte{prefixEndTimeIndexKey}
+	// KeyPrefixScheduleEventIndex is the slice of prefix bytes for the schedule event index of clawback vesting accounts.
+	KeyPrefixScheduleEventIndex = []byte{prefixScheduleEventIndexKey}
+	// KeyPrefixClawbackRenounced is the slice of prefix bytes for storing the clawback renounced flag.
+	KeyPrefixClawbackRenounced = []byte{prefixClawbackRenouncedKey}
+	// KeyPrefixVestingPaused is the slice of prefix bytes for storing the pause time of paused vesting accounts.
+	KeyPrefixVestingPaused = []byte{prefixVestingPausedKey}
+	// KeyPrefixScheduleAmendment is the slice of prefix bytes for storing the pending schedule amendments.
+	KeyPrefixScheduleAmendment = []byte{prefixScheduleAmendmentKey}
+	// KeyPrefixFundingInstruction is the slice of prefix bytes for storing the recurring funding instructions.
+	KeyPrefixFundingInstruction = []byte{prefixFundingInstructionKey}
+	// KeyPrefixFundingInstructionQueue is the slice of prefix bytes for the due time index of funding instructions.
+	KeyPrefixFundingInstructionQueue = []byte{prefixFundingInstructionQueueKey}
+	// KeyNextFundingInstructionID is the key for storing the id of the next funding instruction.
+	KeyNextFundingInstructionID = []byte{prefixNextFundingInstructionIDKey}
+	// KeyPrefixEscrowGrant is the slice of prefix bytes for storing the escrow grants.
+	KeyPrefixEscrowGrant = []byte{prefixEscrowGrantKey}
+	// KeyPrefixEscrowGrantByRecipient is the slice of prefix bytes for the recipient index of escrow grants.
+	KeyPrefixEscrowGrantByRecipient = []byte{prefixEscrowGrantByRecipientKey}
+	// KeyNextEscrowGrantID is the key for storing the id of the next escrow grant.
+	KeyNextEscrowGrantID = []byte{prefixNextEscrowGrantIDKey}
+	// KeyPrefixFunderCommittee is the slice of prefix bytes for storing the funder committees.
+	KeyPrefixFunderCommittee = []byte{prefixFunderCommitteeKey}
+	// KeyNextFunderCommitteeID is the key for storing the id of the next funder committee.
+	KeyNextFunderCommitteeID = []byte{prefixNextFunderCommitteeIDKey}
+	// KeyPrefixCommitteeProposal is the slice of prefix bytes for storing the funder committee proposals.
+	KeyPrefixCommitteeProposal = []byte{prefixCommitteeProposalKey}
+	// KeyPrefixCommitteeProposalByCommittee is the slice of prefix bytes for the committee index of proposals.
+	KeyPrefixCommitteeProposalByCommittee = []byte{prefixCommitteeProposalByCommitteeKey}
+	// KeyPrefixCommitteeProposalExpiry is the slice of prefix bytes for the expiry time index of proposals.
+	KeyPrefixCommitteeProposalExpiry = []byte{prefixCommitteeProposalExpiryKey}
+	// KeyNextCommitteeProposalID is the key for storing the id of the next funder committee proposal.
+	KeyNextCommitteeProposalID = []byte{prefixNextCommitteeProposalIDKey}
+	// KeyPrefixWrappedAccount is the slice of prefix bytes for storing the unwrapped accounts of the chain's default type.
+	KeyPrefixWrappedAccount = []byte{prefixWrappedAccountKey}
 )
 
-// KeyPrefixGovClawbackDisabledKey is the slice of prefix bytes for storing the governance clawback enabled/disabled flag.
-var KeyPrefixGovClawbackDisabledKey = []byte{prefixGovClawbackDisabledKey}
+// EndTimeIndexKey returns the key of the end time index entry for the given
+// end time and vesting account address. Entries are ordered by end time.
+func EndTimeIndexKey(endTime int64, addr sdk.AccAddress) []byte {
+	return append(EndTimeIndexPrefix(endTime), addr.Bytes()...)
+}
+
+// EndTimeIndexPrefix returns the prefix of all the end time index entries with
+// the given end time.
+func EndTimeIndexPrefix(endTime int64) []byte {
+	//nolint:gocritic
+	return append(KeyPrefixEndTimeIndex, sdk.Uint64ToBigEndian(uint64(endTime))...)
+}
+
+// SplitEndTimeIndexKey returns the end time and address of the given end time
+// index key, without the store prefix.
+func SplitEndTimeIndexKey(key []byte) (int64, sdk.AccAddress) {
+	return int64(sdk.BigEndianToUint64(key[:8])), sdk.AccAddress(key[8:])
+}
 
 const (
 	// ModuleName defines the module's name.
@@ -22,3 +149,113 @@ const (
 	// RouterKey defines the module's message routing key
 	RouterKey = ModuleName
 )
+
+// ScheduleEventIndexKey returns the key of the schedule event index entry for
+// the given event time, vesting account address, event type and period index.
+// Entries are ordered by event time.
+func ScheduleEventIndexKey(eventTime int64, addr sdk.AccAddress, eventType byte, periodIndex uint64) []byte {
+	key := make([]byte, 0, len(KeyPrefixScheduleEventIndex)+8+1+len(addr)+1+8)
+	key = append(key, KeyPrefixScheduleEventIndex...)
+	key = append(key, sdk.Uint64ToBigEndian(uint64(eventTime))...)
+	key = append(key, address.MustLengthPrefix(addr)...)
+	key = append(key, eventType)
+	return append(key, sdk.Uint64ToBigEndian(periodIndex)...)
+}
+
+// SplitScheduleEventIndexKey returns the event time, address, event type and
+// period index of the given schedule event index key, without the store prefix.
+func SplitScheduleEventIndexKey(key []byte) (eventTime int64, addr sdk.AccAddress, eventType byte, periodIndex uint64) {
+	eventTime = int64(sdk.BigEndianToUint64(key[:8]))
+	addrLen := int(key[8])
+	addr = sdk.AccAddress(key[9 : 9+addrLen])
+	eventType = key[9+addrLen]
+	periodIndex = sdk.BigEndianToUint64(key[10+addrLen:])
+	return eventTime, addr, eventType, periodIndex
+}
+
+// FundingInstructionKey returns the key of the funding instruction with the
+// given id.
+func FundingInstructionKey(id uint64) []byte {
+	//nolint:gocritic
+	return append(KeyPrefixFundingInstruction, sdk.Uint64ToBigEndian(id)...)
+}
+
+// FundingInstructionQueueKey returns the key of the due time index entry for
+// the given due time and funding instruction id. Entries are ordered by due
+// time.
+func FundingInstructionQueueKey(dueTime int64, id uint64) []byte {
+	key := make([]byte, 0, len(KeyPrefixFundingInstructionQueue)+8+8)
+	key = append(key, KeyPrefixFundingInstructionQueue...)
+	key = append(key, sdk.Uint64ToBigEndian(uint64(dueTime))...)
+	return append(key, sdk.Uint64ToBigEndian(id)...)
+}
+
+// SplitFundingInstructionQueueKey returns the due time and funding instruction
+// id of the given due time index key, without the store prefix.
+func SplitFundingInstructionQueueKey(key []byte) (dueTime int64, id uint64) {
+	return int64(sdk.BigEndianToUint64(key[:8])), sdk.BigEndianToUint64(key[8:16])
+}
+
+// EscrowGrantKey returns the key of the escrow grant with the given id.
+func EscrowGrantKey(id uint64) []byte {
+	//nolint:gocritic
+	return append(KeyPrefixEscrowGrant, sdk.Uint64ToBigEndian(id)...)
+}
+
+// EscrowGrantByRecipientKey returns the key of the recipient index entry for
+// the given recipient address and escrow grant id.
+func EscrowGrantByRecipientKey(recipient sdk.AccAddress, id uint64) []byte {
+	return append(EscrowGrantByRecipientPrefix(recipient), sdk.Uint64ToBigEndian(id)...)
+}
+
+// EscrowGrantByRecipientPrefix returns the prefix of all the recipient index
+// entries of the given recipient address.
+func EscrowGrantByRecipientPrefix(recipient sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(KeyPrefixEscrowGrantByRecipient)+1+len(recipient)+8)
+	key = append(key, KeyPrefixEscrowGrantByRecipient...)
+	return append(key, address.MustLengthPrefix(recipient)...)
+}
+
+// FunderCommitteeKey returns the key of the funder committee with the given
+// address.
+func FunderCommitteeKey(addr sdk.AccAddress) []byte {
+	//nolint:gocritic
+	return append(KeyPrefixFunderCommittee, addr.Bytes()...)
+}
+
+// CommitteeProposalKey returns the key of the funder committee proposal with
+// the given id.
+func CommitteeProposalKey(id uint64) []byte {
+	//nolint:gocritic
+	return append(KeyPrefixCommitteeProposal, sdk.Uint64ToBigEndian(id)...)
+}
+
+// CommitteeProposalByCommitteeKey returns the key of the committee index entry
+// for the given committee address and proposal id.
+func CommitteeProposalByCommitteeKey(committee sdk.AccAddress, id uint64) []byte {
+	return append(CommitteeProposalByCommitteePrefix(committee), sdk.Uint64ToBigEndian(id)...)
+}
+
+// CommitteeProposalByCommitteePrefix returns the prefix of all the committee
+// index entries of the given committee address.
+func CommitteeProposalByCommitteePrefix(committee sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(KeyPrefixCommitteeProposalByCommittee)+1+len(committee)+8)
+	key = append(key, KeyPrefixCommitteeProposalByCommittee...)
+	return append(key, address.MustLengthPrefix(committee)...)
+}
+
+// CommitteeProposalExpiryKey returns the key of the expiry time index entry
+// for the given expiry time and proposal id. Entries are ordered by expiry
+// time.
+func CommitteeProposalExpiryKey(expiryTime int64, id uint64) []byte {
+	key := make([]byte, 0, len(KeyPrefixCommitteeProposalExpiry)+8+8)
+	key = append(key, KeyPrefixCommitteeProposalExpiry...)
+	key = append(key, sdk.Uint64ToBigEndian(uint64(expiryTime))...)
+	return append(key, sdk.Uint64ToBigEndian(id)...)
+}
+
+// SplitCommitteeProposalExpiryKey returns the expiry time and proposal id of
+// the given expiry time index key, without the store prefix.
+func SplitCommitteeProposalExpiryKey(key []byte) (expiryTime int64, id uint64) {
+	return int64(sdk.BigEndianToUint64(key[:8])), sdk.BigEndianToUint64(key[8:16])
+}
@@ -18,6 +18,28 @@ var (
 	_ sdk.Msg = &MsgClawback{}
 	_ sdk.Msg = &MsgConvertVestingAccount{}
 	_ sdk.Msg = &MsgUpdateVestingFunder{}
+	_ sdk.Msg = &MsgMigrateVestingAccount{}
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgSetVestingManager{}
+	_ sdk.Msg = &MsgBatchClawback{}
+	_ sdk.Msg = &MsgRenounceClawback{}
+	_ sdk.Msg = &MsgPauseVesting{}
+	_ sdk.Msg = &MsgResumeVesting{}
+	_ sdk.Msg = &MsgUnlockVesting{}
+	_ sdk.Msg = &MsgProposeScheduleAmendment{}
+	_ sdk.Msg = &MsgAcceptScheduleAmendment{}
+	_ sdk.Msg = &MsgSelfLockup{}
+	_ sdk.Msg = &MsgFundVestingAccountFromCommunityPool{}
+	_ sdk.Msg = &MsgCreateFundingInstruction{}
+	_ sdk.Msg = &MsgCancelFundingInstruction{}
+	_ sdk.Msg = &MsgCreateEscrowGrant{}
+	_ sdk.Msg = &MsgClaimEscrowGrant{}
+	_ sdk.Msg = &MsgClawbackEscrowGrant{}
+	_ sdk.Msg = &MsgCreateFunderCommittee{}
+	_ sdk.Msg = &MsgSubmitCommitteeProposal{}
+	_ sdk.Msg = &MsgApproveCommitteeProposal{}
+	_ sdk.Msg = &MsgRecoverVestingAccount{}
+	_ sdk.Msg = &MsgMergeVestingAccounts{}
 )
 
 const (
@@ -27,6 +49,27 @@ const (
 	TypeMsgUpdateVestingFunder          = "update_vesting_funder"
 	TypeMsgConvertVestingAccount        = "convert_vesting_account"
 	TypeMsgUpdateParams                 = "update_params"
+	TypeMsgMigrateVestingAccount        = "migrate_vesting_account"
+	TypeMsgSetVestingManager            = "set_vesting_manager"
+	TypeMsgBatchClawback                = "batch_clawback"
+	TypeMsgRenounceClawback             = "renounce_clawback"
+	TypeMsgPauseVesting                 = "pause_vesting"
+	TypeMsgResumeVesting                = "resume_vesting"
+	TypeMsgUnlockVesting                = "unlock_vesting"
+	TypeMsgProposeScheduleAmendment     = "propose_schedule_amendment"
+	TypeMsgAcceptScheduleAmendment      = "accept_schedule_amendment"
+	TypeMsgSelfLockup                   = "self_lockup"
+	TypeMsgFundFromCommunityPool        = "fund_vesting_account_from_community_pool"
+	TypeMsgCreateFundingInstruction     = "create_funding_instruction"
+	TypeMsgCancelFundingInstruction     = "cancel_funding_instruction"
+	TypeMsgCreateEscrowGrant            = "create_escrow_grant"
+	TypeMsgClaimEscrowGrant             = "claim_escrow_grant"
+	TypeMsgClawbackEscrowGrant          = "clawback_escrow_grant"
+	TypeMsgCreateFunderCommittee        = "create_funder_committee"
+	TypeMsgSubmitCommitteeProposal      = "submit_committee_proposal"
+	TypeMsgApproveCommitteeProposal     = "approve_committee_proposal"
+	TypeMsgRecoverVestingAccount        = "recover_vesting_account"
+	TypeMsgMergeVestingAccounts         = "merge_vesting_accounts"
 )
 
 // NewMsgCreateClawbackVestingAccount creates new instance of MsgCreateClawbackVestingAccount
@@ -104,8 +147,14 @@ func (msg MsgFundVestingAccount) ValidateBasic() error {
 		return errorsmod.Wrapf(err, "invalid vesting address")
 	}
 
+	return validateGrantPeriods(msg.LockupPeriods, msg.VestingPeriods)
+}
+
+// validateGrantPeriods checks that the lockup and vesting periods of a grant
+// have positive lengths and valid amounts, and describe the same total amount.
+func validateGrantPeriods(lockupPeriods, vestingPeriods sdkvesting.Periods) error {
 	lockupCoins := sdk.NewCoins()
-	for i, period := range msg.LockupPeriods {
+	for i, period := range lockupPeriods {
 		if period.Length < 1 {
 			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid period length of %d in period %d, length must be greater than 0", period.Length, i)
 		}
@@ -116,7 +165,7 @@ func (msg MsgFundVestingAccount) ValidateBasic() error {
 	}
 
 	vestingCoins := sdk.NewCoins()
-	for i, period := range msg.VestingPeriods {
+	for i, period := range vestingPeriods {
 		if period.Length < 1 {
 			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid period length of %d in period %d, length must be greater than 0", period.Length, i)
 		}
@@ -134,7 +183,7 @@ func (msg MsgFundVestingAccount) ValidateBasic() error {
 
 	// If both schedules are present, they must describe the same total amount.
 	// IsEqual can panic, so use (a == b) <=> (a <= b && b <= a).
-	if len(msg.LockupPeriods) > 0 && len(msg.VestingPeriods) > 0 && !CoinEq(lockupCoins, vestingCoins) {
+	if len(lockupPeriods) > 0 && len(vestingPeriods) > 0 && !CoinEq(lockupCoins, vestingCoins) {
 		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "vesting and lockup schedules must have same total coins")
 	}
 
@@ -184,11 +233,25 @@ func (msg MsgClawback) ValidateBasic() error {
 	}
 
 	if msg.GetDestAddress() != "" {
+		if msg.Burn {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "dest address must be empty when burning the clawed back coins")
+		}
+
 		if _, err := sdk.AccAddressFromBech32(msg.GetDestAddress()); err != nil {
 			return errorsmod.Wrapf(err, "invalid dest address")
 		}
 	}
 
+	if len(msg.Destinations) > 0 {
+		if msg.DestAddress != "" || msg.Burn {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "dest address must be empty and burn false when splitting between destinations")
+		}
+
+		if err := ValidateClawbackDestinations(msg.Destinations); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -282,3 +345,1025 @@ func (msg MsgConvertVestingAccount) GetSigners() []sdk.AccAddress {
 	vesting := sdk.MustAccAddressFromBech32(msg.VestingAddress)
 	return []sdk.AccAddress{vesting}
 }
+
+// NewMsgMigrateVestingAccount creates new instance of MsgMigrateVestingAccount
+func NewMsgMigrateVestingAccount(
+	authority, vestingAddr, funderAddr sdk.AccAddress,
+	enableGovClawback bool,
+) *MsgMigrateVestingAccount {
+	return &MsgMigrateVestingAccount{
+		Authority:         authority.String(),
+		VestingAddress:    vestingAddr.String(),
+		FunderAddress:     funderAddr.String(),
+		EnableGovClawback: enableGovClawback,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgMigrateVestingAccount) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgMigrateVestingAccount
+func (msg MsgMigrateVestingAccount) Type() string { return TypeMsgMigrateVestingAccount }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgMigrateVestingAccount) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(err, "invalid authority address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.VestingAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid vesting address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.FunderAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid funder address")
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgMigrateVestingAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgMigrateVestingAccount) GetSigners() []sdk.AccAddress {
+	authority := sdk.MustAccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// Route returns the name of the module
+func (msg MsgUpdateParams) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgUpdateParams
+func (msg MsgUpdateParams) Type() string { return TypeMsgUpdateParams }
+
+// ValidateBasic does a sanity check for the provided data
+func (msg *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return msg.Params.Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateParams message.
+func (msg *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgSetVestingManager creates new instance of MsgSetVestingManager. An
+// empty manager address clears the manager of the vesting account.
+func NewMsgSetVestingManager(funder, vesting, manager sdk.AccAddress) *MsgSetVestingManager {
+	msg := &MsgSetVestingManager{
+		FunderAddress:  funder.String(),
+		VestingAddress: vesting.String(),
+	}
+	if !manager.Empty() {
+		msg.ManagerAddress = manager.String()
+	}
+	return msg
+}
+
+// Route returns the message route for a MsgSetVestingManager.
+func (msg MsgSetVestingManager) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgSetVestingManager.
+func (msg MsgSetVestingManager) Type() string { return TypeMsgSetVestingManager }
+
+// ValidateBasic runs stateless checks on the MsgSetVestingManager message
+func (msg MsgSetVestingManager) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.FunderAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid funder address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.VestingAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid vesting account address")
+	}
+
+	// an empty manager address clears the manager
+	if msg.ManagerAddress == "" {
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.ManagerAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid manager address")
+	}
+
+	if msg.ManagerAddress == msg.FunderAddress {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "manager address is equal to funder address")
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgSetVestingManager) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSetVestingManager) GetSigners() []sdk.AccAddress {
+	funder := sdk.MustAccAddressFromBech32(msg.FunderAddress)
+	return []sdk.AccAddress{funder}
+}
+
+// NewMsgBatchClawback creates new instance of MsgBatchClawback
+func NewMsgBatchClawback(
+	authority sdk.AccAddress,
+	accounts []sdk.AccAddress,
+	dest sdk.AccAddress,
+	bestEffort bool,
+	burn bool,
+) *MsgBatchClawback {
+	accountAddresses := make([]string, len(accounts))
+	for i, account := range accounts {
+		accountAddresses[i] = account.String()
+	}
+
+	msg := &MsgBatchClawback{
+		Authority:        authority.String(),
+		AccountAddresses: accountAddresses,
+		BestEffort:       bestEffort,
+		Burn:             burn,
+	}
+	if !dest.Empty() {
+		msg.DestAddress = dest.String()
+	}
+	return msg
+}
+
+// Route returns the message route for a MsgBatchClawback.
+func (msg MsgBatchClawback) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgBatchClawback.
+func (msg MsgBatchClawback) Type() string { return TypeMsgBatchClawback }
+
+// ValidateBasic runs stateless checks on the MsgBatchClawback message
+func (msg MsgBatchClawback) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(err, "invalid authority address")
+	}
+
+	if len(msg.AccountAddresses) == 0 {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "account addresses cannot be empty")
+	}
+
+	seen := make(map[string]bool, len(msg.AccountAddresses))
+	for _, address := range msg.AccountAddresses {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return errorsmod.Wrapf(err, "invalid account address %s", address)
+		}
+
+		if seen[address] {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "duplicate account address %s", address)
+		}
+		seen[address] = true
+	}
+
+	if msg.DestAddress != "" {
+		if msg.Burn {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "dest address must be empty when burning the clawed back coins")
+		}
+
+		if _, err := sdk.AccAddressFromBech32(msg.DestAddress); err != nil {
+			return errorsmod.Wrapf(err, "invalid dest address")
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgBatchClawback) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgBatchClawback) GetSigners() []sdk.AccAddress {
+	authority := sdk.MustAccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// NewMsgRenounceClawback creates new instance of MsgRenounceClawback
+func NewMsgRenounceClawback(funder, vesting sdk.AccAddress, renounceGovClawback bool) *MsgRenounceClawback {
+	return &MsgRenounceClawback{
+		FunderAddress:       funder.String(),
+		VestingAddress:      vesting.String(),
+		RenounceGovClawback: renounceGovClawback,
+	}
+}
+
+// Route returns the message route for a MsgRenounceClawback.
+func (msg MsgRenounceClawback) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgRenounceClawback.
+func (msg MsgRenounceClawback) Type() string { return TypeMsgRenounceClawback }
+
+// ValidateBasic runs stateless checks on the MsgRenounceClawback message
+func (msg MsgRenounceClawback) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.FunderAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid funder address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.VestingAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid vesting account address")
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgRenounceClawback) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRenounceClawback) GetSigners() []sdk.AccAddress {
+	funder := sdk.MustAccAddressFromBech32(msg.FunderAddress)
+	return []sdk.AccAddress{funder}
+}
+
+// NewMsgPauseVesting creates new instance of MsgPauseVesting
+func NewMsgPauseVesting(authority, vesting sdk.AccAddress) *MsgPauseVesting {
+	return &MsgPauseVesting{
+		Authority:      authority.String(),
+		VestingAddress: vesting.String(),
+	}
+}
+
+// Route returns the message route for a MsgPauseVesting.
+func (msg MsgPauseVesting) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgPauseVesting.
+func (msg MsgPauseVesting) Type() string { return TypeMsgPauseVesting }
+
+// ValidateBasic runs stateless checks on the MsgPauseVesting message
+func (msg MsgPauseVesting) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(err, "invalid authority address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.VestingAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid vesting account address")
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgPauseVesting) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgPauseVesting) GetSigners() []sdk.AccAddress {
+	authority := sdk.MustAccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// NewMsgResumeVesting creates new instance of MsgResumeVesting
+func NewMsgResumeVesting(authority, vesting sdk.AccAddress) *MsgResumeVesting {
+	return &MsgResumeVesting{
+		Authority:      authority.String(),
+		VestingAddress: vesting.String(),
+	}
+}
+
+// Route returns the message route for a MsgResumeVesting.
+func (msg MsgResumeVesting) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgResumeVesting.
+func (msg MsgResumeVesting) Type() string { return TypeMsgResumeVesting }
+
+// ValidateBasic runs stateless checks on the MsgResumeVesting message
+func (msg MsgResumeVesting) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(err, "invalid authority address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.VestingAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid vesting account address")
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgResumeVesting) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgResumeVesting) GetSigners() []sdk.AccAddress {
+	authority := sdk.MustAccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// NewMsgUnlockVesting creates new instance of MsgUnlockVesting
+func NewMsgUnlockVesting(authority sdk.AccAddress, vestingAccounts []sdk.AccAddress) *MsgUnlockVesting {
+	vestingAddresses := make([]string, len(vestingAccounts))
+	for i, account := range vestingAccounts {
+		vestingAddresses[i] = account.String()
+	}
+
+	return &MsgUnlockVesting{
+		Authority:        authority.String(),
+		VestingAddresses: vestingAddresses,
+	}
+}
+
+// Route returns the message route for a MsgUnlockVesting.
+func (msg MsgUnlockVesting) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgUnlockVesting.
+func (msg MsgUnlockVesting) Type() string { return TypeMsgUnlockVesting }
+
+// ValidateBasic runs stateless checks on the MsgUnlockVesting message
+func (msg MsgUnlockVesting) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(err, "invalid authority address")
+	}
+
+	if len(msg.VestingAddresses) == 0 {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "vesting addresses cannot be empty")
+	}
+
+	seen := make(map[string]bool, len(msg.VestingAddresses))
+	for _, address := range msg.VestingAddresses {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return errorsmod.Wrapf(err, "invalid vesting account address %s", address)
+		}
+
+		if seen[address] {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "duplicate vesting account address %s", address)
+		}
+		seen[address] = true
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgUnlockVesting) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgUnlockVesting) GetSigners() []sdk.AccAddress {
+	authority := sdk.MustAccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// NewMsgProposeScheduleAmendment creates new instance of MsgProposeScheduleAmendment
+func NewMsgProposeScheduleAmendment(
+	funder, vesting sdk.AccAddress,
+	lockupPeriods, vestingPeriods sdkvesting.Periods,
+) *MsgProposeScheduleAmendment {
+	return &MsgProposeScheduleAmendment{
+		FunderAddress:  funder.String(),
+		VestingAddress: vesting.String(),
+		LockupPeriods:  lockupPeriods,
+		VestingPeriods: vestingPeriods,
+	}
+}
+
+// Route returns the message route for a MsgProposeScheduleAmendment.
+func (msg MsgProposeScheduleAmendment) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgProposeScheduleAmendment.
+func (msg MsgProposeScheduleAmendment) Type() string { return TypeMsgProposeScheduleAmendment }
+
+// ValidateBasic runs stateless checks on the MsgProposeScheduleAmendment message
+func (msg MsgProposeScheduleAmendment) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.FunderAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid funder address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.VestingAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid vesting account address")
+	}
+
+	return ValidateAmendmentPeriods(msg.LockupPeriods, msg.VestingPeriods)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgProposeScheduleAmendment) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgProposeScheduleAmendment) GetSigners() []sdk.AccAddress {
+	funder := sdk.MustAccAddressFromBech32(msg.FunderAddress)
+	return []sdk.AccAddress{funder}
+}
+
+// NewMsgAcceptScheduleAmendment creates new instance of MsgAcceptScheduleAmendment
+func NewMsgAcceptScheduleAmendment(vesting sdk.AccAddress) *MsgAcceptScheduleAmendment {
+	return &MsgAcceptScheduleAmendment{
+		VestingAddress: vesting.String(),
+	}
+}
+
+// Route returns the message route for a MsgAcceptScheduleAmendment.
+func (msg MsgAcceptScheduleAmendment) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgAcceptScheduleAmendment.
+func (msg MsgAcceptScheduleAmendment) Type() string { return TypeMsgAcceptScheduleAmendment }
+
+// ValidateBasic runs stateless checks on the MsgAcceptScheduleAmendment message
+func (msg MsgAcceptScheduleAmendment) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.VestingAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid vesting account address")
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgAcceptScheduleAmendment) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgAcceptScheduleAmendment) GetSigners() []sdk.AccAddress {
+	vesting := sdk.MustAccAddressFromBech32(msg.VestingAddress)
+	return []sdk.AccAddress{vesting}
+}
+
+// NewMsgSelfLockup creates new instance of MsgSelfLockup
+func NewMsgSelfLockup(address sdk.AccAddress, lockupPeriods sdkvesting.Periods) *MsgSelfLockup {
+	return &MsgSelfLockup{
+		Address:       address.String(),
+		LockupPeriods: lockupPeriods,
+	}
+}
+
+// Route returns the message route for a MsgSelfLockup.
+func (msg MsgSelfLockup) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgSelfLockup.
+func (msg MsgSelfLockup) Type() string { return TypeMsgSelfLockup }
+
+// ValidateBasic runs stateless checks on the MsgSelfLockup message
+func (msg MsgSelfLockup) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return errorsmod.Wrapf(err, "invalid address")
+	}
+
+	if len(msg.LockupPeriods) == 0 {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "lockup periods cannot be empty")
+	}
+
+	for i, period := range msg.LockupPeriods {
+		if period.Length < 1 {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid period length of %d in period %d, length must be greater than 0", period.Length, i)
+		}
+		if !period.Amount.IsValid() || period.Amount.IsZero() {
+			return errortypes.ErrInvalidCoins.Wrap(period.Amount.String())
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgSelfLockup) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSelfLockup) GetSigners() []sdk.AccAddress {
+	address := sdk.MustAccAddressFromBech32(msg.Address)
+	return []sdk.AccAddress{address}
+}
+
+// NewMsgFundVestingAccountFromCommunityPool creates new instance of MsgFundVestingAccountFromCommunityPool
+func NewMsgFundVestingAccountFromCommunityPool(
+	authority, vestingAddr sdk.AccAddress,
+	startTime time.Time,
+	lockupPeriods,
+	vestingPeriods sdkvesting.Periods,
+) *MsgFundVestingAccountFromCommunityPool {
+	return &MsgFundVestingAccountFromCommunityPool{
+		Authority:      authority.String(),
+		VestingAddress: vestingAddr.String(),
+		StartTime:      startTime,
+		LockupPeriods:  lockupPeriods,
+		VestingPeriods: vestingPeriods,
+	}
+}
+
+// Route returns the message route for a MsgFundVestingAccountFromCommunityPool.
+func (msg MsgFundVestingAccountFromCommunityPool) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgFundVestingAccountFromCommunityPool.
+func (msg MsgFundVestingAccountFromCommunityPool) Type() string {
+	return TypeMsgFundFromCommunityPool
+}
+
+// ValidateBasic runs stateless checks on the MsgFundVestingAccountFromCommunityPool message
+func (msg MsgFundVestingAccountFromCommunityPool) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(err, "invalid authority address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.VestingAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid vesting address")
+	}
+
+	return validateGrantPeriods(msg.LockupPeriods, msg.VestingPeriods)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgFundVestingAccountFromCommunityPool) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgFundVestingAccountFromCommunityPool) GetSigners() []sdk.AccAddress {
+	authority := sdk.MustAccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// NewMsgCreateFundingInstruction creates new instance of MsgCreateFundingInstruction
+func NewMsgCreateFundingInstruction(
+	funder, vestingAddr sdk.AccAddress,
+	startTime time.Time,
+	interval int64,
+	count uint64,
+	lockupPeriods sdkvesting.Periods,
+) *MsgCreateFundingInstruction {
+	return &MsgCreateFundingInstruction{
+		FunderAddress:  funder.String(),
+		VestingAddress: vestingAddr.String(),
+		StartTime:      startTime,
+		Interval:       interval,
+		Count:          count,
+		LockupPeriods:  lockupPeriods,
+	}
+}
+
+// Route returns the message route for a MsgCreateFundingInstruction.
+func (msg MsgCreateFundingInstruction) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgCreateFundingInstruction.
+func (msg MsgCreateFundingInstruction) Type() string { return TypeMsgCreateFundingInstruction }
+
+// ValidateBasic runs stateless checks on the MsgCreateFundingInstruction message
+func (msg MsgCreateFundingInstruction) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.FunderAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid funder address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.VestingAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid vesting address")
+	}
+
+	if msg.Interval < 1 {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid interval of %d, interval must be greater than 0", msg.Interval)
+	}
+
+	if msg.Count == 0 {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "count must be greater than 0")
+	}
+
+	if len(msg.LockupPeriods) == 0 {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "lockup periods cannot be empty")
+	}
+
+	for i, period := range msg.LockupPeriods {
+		if period.Length < 1 {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid period length of %d in period %d, length must be greater than 0", period.Length, i)
+		}
+		if !period.Amount.IsValid() || period.Amount.IsZero() {
+			return errortypes.ErrInvalidCoins.Wrap(period.Amount.String())
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgCreateFundingInstruction) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgCreateFundingInstruction) GetSigners() []sdk.AccAddress {
+	funder := sdk.MustAccAddressFromBech32(msg.FunderAddress)
+	return []sdk.AccAddress{funder}
+}
+
+// NewMsgCancelFundingInstruction creates new instance of MsgCancelFundingInstruction
+func NewMsgCancelFundingInstruction(funder sdk.AccAddress, id uint64) *MsgCancelFundingInstruction {
+	return &MsgCancelFundingInstruction{
+		FunderAddress: funder.String(),
+		Id:            id,
+	}
+}
+
+// Route returns the message route for a MsgCancelFundingInstruction.
+func (msg MsgCancelFundingInstruction) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgCancelFundingInstruction.
+func (msg MsgCancelFundingInstruction) Type() string { return TypeMsgCancelFundingInstruction }
+
+// ValidateBasic runs stateless checks on the MsgCancelFundingInstruction message
+func (msg MsgCancelFundingInstruction) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.FunderAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid funder address")
+	}
+
+	if msg.Id == 0 {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid funding instruction id")
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgCancelFundingInstruction) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgCancelFundingInstruction) GetSigners() []sdk.AccAddress {
+	funder := sdk.MustAccAddressFromBech32(msg.FunderAddress)
+	return []sdk.AccAddress{funder}
+}
+
+// NewMsgCreateEscrowGrant creates new instance of MsgCreateEscrowGrant
+func NewMsgCreateEscrowGrant(
+	funder, recipient sdk.AccAddress,
+	startTime time.Time,
+	lockupPeriods,
+	vestingPeriods sdkvesting.Periods,
+) *MsgCreateEscrowGrant {
+	return &MsgCreateEscrowGrant{
+		FunderAddress:    funder.String(),
+		RecipientAddress: recipient.String(),
+		StartTime:        startTime,
+		LockupPeriods:    lockupPeriods,
+		VestingPeriods:   vestingPeriods,
+	}
+}
+
+// Route returns the message route for a MsgCreateEscrowGrant.
+func (msg MsgCreateEscrowGrant) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgCreateEscrowGrant.
+func (msg MsgCreateEscrowGrant) Type() string { return TypeMsgCreateEscrowGrant }
+
+// ValidateBasic runs stateless checks on the MsgCreateEscrowGrant message
+func (msg MsgCreateEscrowGrant) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.FunderAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid funder address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.RecipientAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid recipient address")
+	}
+
+	return validateGrantPeriods(msg.LockupPeriods, msg.VestingPeriods)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgCreateEscrowGrant) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgCreateEscrowGrant) GetSigners() []sdk.AccAddress {
+	funder := sdk.MustAccAddressFromBech32(msg.FunderAddress)
+	return []sdk.AccAddress{funder}
+}
+
+// NewMsgClaimEscrowGrant creates new instance of MsgClaimEscrowGrant
+func NewMsgClaimEscrowGrant(recipient sdk.AccAddress, id uint64) *MsgClaimEscrowGrant {
+	return &MsgClaimEscrowGrant{
+		RecipientAddress: recipient.String(),
+		Id:               id,
+	}
+}
+
+// Route returns the message route for a MsgClaimEscrowGrant.
+func (msg MsgClaimEscrowGrant) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgClaimEscrowGrant.
+func (msg MsgClaimEscrowGrant) Type() string { return TypeMsgClaimEscrowGrant }
+
+// ValidateBasic runs stateless checks on the MsgClaimEscrowGrant message
+func (msg MsgClaimEscrowGrant) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.RecipientAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid recipient address")
+	}
+
+	if msg.Id == 0 {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid escrow grant id")
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgClaimEscrowGrant) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgClaimEscrowGrant) GetSigners() []sdk.AccAddress {
+	recipient := sdk.MustAccAddressFromBech32(msg.RecipientAddress)
+	return []sdk.AccAddress{recipient}
+}
+
+// NewMsgClawbackEscrowGrant creates new instance of MsgClawbackEscrowGrant
+func NewMsgClawbackEscrowGrant(funder sdk.AccAddress, id uint64, dest sdk.AccAddress) *MsgClawbackEscrowGrant {
+	var destString string
+	if dest != nil {
+		destString = dest.String()
+	}
+
+	return &MsgClawbackEscrowGrant{
+		FunderAddress: funder.String(),
+		Id:            id,
+		DestAddress:   destString,
+	}
+}
+
+// Route returns the message route for a MsgClawbackEscrowGrant.
+func (msg MsgClawbackEscrowGrant) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgClawbackEscrowGrant.
+func (msg MsgClawbackEscrowGrant) Type() string { return TypeMsgClawbackEscrowGrant }
+
+// ValidateBasic runs stateless checks on the MsgClawbackEscrowGrant message
+func (msg MsgClawbackEscrowGrant) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.FunderAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid funder address")
+	}
+
+	if msg.Id == 0 {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid escrow grant id")
+	}
+
+	if msg.DestAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.DestAddress); err != nil {
+			return errorsmod.Wrapf(err, "invalid dest address")
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgClawbackEscrowGrant) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgClawbackEscrowGrant) GetSigners() []sdk.AccAddress {
+	funder := sdk.MustAccAddressFromBech32(msg.FunderAddress)
+	return []sdk.AccAddress{funder}
+}
+
+// NewMsgCreateFunderCommittee creates new instance of MsgCreateFunderCommittee
+func NewMsgCreateFunderCommittee(
+	creator sdk.AccAddress,
+	members []string,
+	threshold uint32,
+	proposalLifetime int64,
+) *MsgCreateFunderCommittee {
+	return &MsgCreateFunderCommittee{
+		Creator:          creator.String(),
+		Members:          members,
+		Threshold:        threshold,
+		ProposalLifetime: proposalLifetime,
+	}
+}
+
+// Route returns the message route for a MsgCreateFunderCommittee.
+func (msg MsgCreateFunderCommittee) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgCreateFunderCommittee.
+func (msg MsgCreateFunderCommittee) Type() string { return TypeMsgCreateFunderCommittee }
+
+// ValidateBasic runs stateless checks on the MsgCreateFunderCommittee message
+func (msg MsgCreateFunderCommittee) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(err, "invalid creator address")
+	}
+
+	if err := ValidateCommitteeMembers(msg.Members, msg.Threshold); err != nil {
+		return err
+	}
+
+	if msg.ProposalLifetime < 1 {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid proposal lifetime of %d, lifetime must be greater than 0", msg.ProposalLifetime)
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgCreateFunderCommittee) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgCreateFunderCommittee) GetSigners() []sdk.AccAddress {
+	creator := sdk.MustAccAddressFromBech32(msg.Creator)
+	return []sdk.AccAddress{creator}
+}
+
+// NewMsgSubmitCommitteeProposal creates new instance of MsgSubmitCommitteeProposal
+func NewMsgSubmitCommitteeProposal(
+	proposer, committee, vestingAddr sdk.AccAddress,
+	action CommitteeAction,
+	destAddress, newFunderAddress string,
+) *MsgSubmitCommitteeProposal {
+	return &MsgSubmitCommitteeProposal{
+		Proposer:         proposer.String(),
+		CommitteeAddress: committee.String(),
+		Action:           action,
+		VestingAddress:   vestingAddr.String(),
+		DestAddress:      destAddress,
+		NewFunderAddress: newFunderAddress,
+	}
+}
+
+// Route returns the message route for a MsgSubmitCommitteeProposal.
+func (msg MsgSubmitCommitteeProposal) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgSubmitCommitteeProposal.
+func (msg MsgSubmitCommitteeProposal) Type() string { return TypeMsgSubmitCommitteeProposal }
+
+// ValidateBasic runs stateless checks on the MsgSubmitCommitteeProposal message
+func (msg MsgSubmitCommitteeProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Proposer); err != nil {
+		return errorsmod.Wrapf(err, "invalid proposer address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.CommitteeAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid committee address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.VestingAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid vesting address")
+	}
+
+	return ValidateCommitteeAction(msg.Action, msg.DestAddress, msg.NewFunderAddress)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgSubmitCommitteeProposal) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSubmitCommitteeProposal) GetSigners() []sdk.AccAddress {
+	proposer := sdk.MustAccAddressFromBech32(msg.Proposer)
+	return []sdk.AccAddress{proposer}
+}
+
+// NewMsgApproveCommitteeProposal creates new instance of MsgApproveCommitteeProposal
+func NewMsgApproveCommitteeProposal(member sdk.AccAddress, id uint64) *MsgApproveCommitteeProposal {
+	return &MsgApproveCommitteeProposal{
+		Member: member.String(),
+		Id:     id,
+	}
+}
+
+// Route returns the message route for a MsgApproveCommitteeProposal.
+func (msg MsgApproveCommitteeProposal) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgApproveCommitteeProposal.
+func (msg MsgApproveCommitteeProposal) Type() string { return TypeMsgApproveCommitteeProposal }
+
+// ValidateBasic runs stateless checks on the MsgApproveCommitteeProposal message
+func (msg MsgApproveCommitteeProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Member); err != nil {
+		return errorsmod.Wrapf(err, "invalid member address")
+	}
+
+	if msg.Id == 0 {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid committee proposal id")
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgApproveCommitteeProposal) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgApproveCommitteeProposal) GetSigners() []sdk.AccAddress {
+	member := sdk.MustAccAddressFromBech32(msg.Member)
+	return []sdk.AccAddress{member}
+}
+
+// NewMsgRecoverVestingAccount creates new instance of MsgRecoverVestingAccount
+func NewMsgRecoverVestingAccount(authority, vesting, newAddr sdk.AccAddress) *MsgRecoverVestingAccount {
+	return &MsgRecoverVestingAccount{
+		Authority:      authority.String(),
+		VestingAddress: vesting.String(),
+		NewAddress:     newAddr.String(),
+	}
+}
+
+// Route returns the message route for a MsgRecoverVestingAccount.
+func (msg MsgRecoverVestingAccount) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgRecoverVestingAccount.
+func (msg MsgRecoverVestingAccount) Type() string { return TypeMsgRecoverVestingAccount }
+
+// ValidateBasic runs stateless checks on the MsgRecoverVestingAccount message
+func (msg MsgRecoverVestingAccount) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(err, "invalid authority address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.VestingAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid vesting account address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.NewAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid new address")
+	}
+
+	if msg.VestingAddress == msg.NewAddress {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "new address must differ from the vesting account address")
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgRecoverVestingAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRecoverVestingAccount) GetSigners() []sdk.AccAddress {
+	authority := sdk.MustAccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// NewMsgMergeVestingAccounts creates new instance of MsgMergeVestingAccounts
+func NewMsgMergeVestingAccounts(funder, source, target sdk.AccAddress) *MsgMergeVestingAccounts {
+	return &MsgMergeVestingAccounts{
+		FunderAddress: funder.String(),
+		SourceAddress: source.String(),
+		TargetAddress: target.String(),
+	}
+}
+
+// Route returns the message route for a MsgMergeVestingAccounts.
+func (msg MsgMergeVestingAccounts) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgMergeVestingAccounts.
+func (msg MsgMergeVestingAccounts) Type() string { return TypeMsgMergeVestingAccounts }
+
+// ValidateBasic runs stateless checks on the MsgMergeVestingAccounts message
+func (msg MsgMergeVestingAccounts) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.FunderAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid funder address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.SourceAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid source address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.TargetAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid target address")
+	}
+
+	if msg.SourceAddress == msg.TargetAddress {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "source and target addresses must differ")
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgMergeVestingAccounts) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required. Both vesting accounts and
+// their funder must sign the merge.
+func (msg MsgMergeVestingAccounts) GetSigners() []sdk.AccAddress {
+	funder := sdk.MustAccAddressFromBech32(msg.FunderAddress)
+	source := sdk.MustAccAddressFromBech32(msg.SourceAddress)
+	target := sdk.MustAccAddressFromBech32(msg.TargetAddress)
+	return []sdk.AccAddress{funder, source, target}
+}
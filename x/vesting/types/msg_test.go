@@ -0,0 +1,657 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/evmos/vesting/x/vesting/types"
+	"github.com/stretchr/testify/suite"
+)
+
+type MsgsTestSuite struct {
+	suite.Suite
+}
+
+func TestMsgsTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgsTestSuite))
+}
+
+func (suite *MsgsTestSuite) TestMsgClawback() {
+	funder := "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"
+	account := "cosmos1p3ucd3ptpw902fluyjzhq3ffgq4ntddac9sa3s"
+	dest := "cosmos1vfshgcmgtaskxcm0w4h8ghejta047h6lwjtgz0"
+
+	testCases := []struct {
+		msg        string
+		dest       string
+		burn       bool
+		expectPass bool
+	}{
+		{
+			msg:        "Clawback - valid without destination",
+			expectPass: true,
+		},
+		{
+			msg:        "Clawback - valid with destination",
+			dest:       dest,
+			expectPass: true,
+		},
+		{
+			msg:        "Clawback - invalid destination",
+			dest:       "125182ujaisch8hsgs",
+			expectPass: false,
+		},
+		{
+			msg:        "Clawback - valid burn",
+			burn:       true,
+			expectPass: true,
+		},
+		{
+			msg:        "Clawback - invalid burn with destination",
+			dest:       dest,
+			burn:       true,
+			expectPass: false,
+		},
+	}
+
+	for i, tc := range testCases {
+		msg := types.MsgClawback{
+			FunderAddress:  funder,
+			AccountAddress: account,
+			DestAddress:    tc.dest,
+			Burn:           tc.burn,
+		}
+		err := msg.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s", i, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgBatchClawback() {
+	authority := "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"
+	address1 := "cosmos1p3ucd3ptpw902fluyjzhq3ffgq4ntddac9sa3s"
+	address2 := "cosmos1vfshgcmgtaskxcm0w4h8ghejta047h6lwjtgz0"
+
+	testCases := []struct {
+		msg        string
+		authority  string
+		accounts   []string
+		dest       string
+		burn       bool
+		expectPass bool
+	}{
+		{
+			msg:        "Batch clawback - valid",
+			authority:  authority,
+			accounts:   []string{address1, address2},
+			expectPass: true,
+		},
+		{
+			msg:        "Batch clawback - valid with destination",
+			authority:  authority,
+			accounts:   []string{address1},
+			dest:       address2,
+			expectPass: true,
+		},
+		{
+			msg:        "Batch clawback - invalid authority",
+			authority:  "invalid",
+			accounts:   []string{address1},
+			expectPass: false,
+		},
+		{
+			msg:        "Batch clawback - empty accounts",
+			authority:  authority,
+			expectPass: false,
+		},
+		{
+			msg:        "Batch clawback - invalid account",
+			authority:  authority,
+			accounts:   []string{address1, "cosmos1p3ucd3ptpw902fluyjzhq3ffgq4ntddac9sass"},
+			expectPass: false,
+		},
+		{
+			msg:        "Batch clawback - duplicate account",
+			authority:  authority,
+			accounts:   []string{address1, address2, address1},
+			expectPass: false,
+		},
+		{
+			msg:        "Batch clawback - invalid destination",
+			authority:  authority,
+			accounts:   []string{address1},
+			dest:       "125182ujaisch8hsgs",
+			expectPass: false,
+		},
+		{
+			msg:        "Batch clawback - valid burn",
+			authority:  authority,
+			accounts:   []string{address1},
+			burn:       true,
+			expectPass: true,
+		},
+		{
+			msg:        "Batch clawback - invalid burn with destination",
+			authority:  authority,
+			accounts:   []string{address1},
+			dest:       address2,
+			burn:       true,
+			expectPass: false,
+		},
+	}
+
+	for i, tc := range testCases {
+		msg := types.MsgBatchClawback{
+			Authority:        tc.authority,
+			AccountAddresses: tc.accounts,
+			DestAddress:      tc.dest,
+			Burn:             tc.burn,
+		}
+		err := msg.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s", i, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgRenounceClawback() {
+	funder := "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"
+	account := "cosmos1p3ucd3ptpw902fluyjzhq3ffgq4ntddac9sa3s"
+
+	testCases := []struct {
+		msg        string
+		funder     string
+		account    string
+		expectPass bool
+	}{
+		{
+			msg:        "Renounce clawback - valid",
+			funder:     funder,
+			account:    account,
+			expectPass: true,
+		},
+		{
+			msg:        "Renounce clawback - invalid funder",
+			funder:     "invalid",
+			account:    account,
+			expectPass: false,
+		},
+		{
+			msg:        "Renounce clawback - invalid account",
+			funder:     funder,
+			account:    "cosmos1p3ucd3ptpw902fluyjzhq3ffgq4ntddac9sass",
+			expectPass: false,
+		},
+	}
+
+	for i, tc := range testCases {
+		msg := types.MsgRenounceClawback{
+			FunderAddress:       tc.funder,
+			VestingAddress:      tc.account,
+			RenounceGovClawback: true,
+		}
+		err := msg.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s", i, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgUnlockVesting() {
+	authority := "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"
+	address1 := "cosmos1p3ucd3ptpw902fluyjzhq3ffgq4ntddac9sa3s"
+	address2 := "cosmos1vfshgcmgtaskxcm0w4h8ghejta047h6lwjtgz0"
+
+	testCases := []struct {
+		msg        string
+		authority  string
+		accounts   []string
+		expectPass bool
+	}{
+		{
+			msg:        "Unlock vesting - valid",
+			authority:  authority,
+			accounts:   []string{address1, address2},
+			expectPass: true,
+		},
+		{
+			msg:        "Unlock vesting - invalid authority",
+			authority:  "invalid",
+			accounts:   []string{address1},
+			expectPass: false,
+		},
+		{
+			msg:        "Unlock vesting - empty accounts",
+			authority:  authority,
+			expectPass: false,
+		},
+		{
+			msg:        "Unlock vesting - invalid account",
+			authority:  authority,
+			accounts:   []string{address1, "cosmos1p3ucd3ptpw902fluyjzhq3ffgq4ntddac9sass"},
+			expectPass: false,
+		},
+		{
+			msg:        "Unlock vesting - duplicate account",
+			authority:  authority,
+			accounts:   []string{address1, address1},
+			expectPass: false,
+		},
+	}
+
+	for i, tc := range testCases {
+		msg := types.MsgUnlockVesting{
+			Authority:        tc.authority,
+			VestingAddresses: tc.accounts,
+		}
+		err := msg.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s", i, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgSelfLockup() {
+	address := "cosmos1p3ucd3ptpw902fluyjzhq3ffgq4ntddac9sa3s"
+	coins := sdk.NewCoins(sdk.NewInt64Coin("test", 100))
+
+	testCases := []struct {
+		msg           string
+		address       string
+		lockupPeriods sdkvesting.Periods
+		expectPass    bool
+	}{
+		{
+			msg:           "Self lockup - valid",
+			address:       address,
+			lockupPeriods: sdkvesting.Periods{{Length: 10, Amount: coins}, {Length: 10, Amount: coins}},
+			expectPass:    true,
+		},
+		{
+			msg:           "Self lockup - invalid address",
+			address:       "cosmos1p3ucd3ptpw902fluyjzhq3ffgq4ntddac9sass",
+			lockupPeriods: sdkvesting.Periods{{Length: 10, Amount: coins}},
+			expectPass:    false,
+		},
+		{
+			msg:        "Self lockup - empty lockup periods",
+			address:    address,
+			expectPass: false,
+		},
+		{
+			msg:           "Self lockup - zero length",
+			address:       address,
+			lockupPeriods: sdkvesting.Periods{{Length: 0, Amount: coins}},
+			expectPass:    false,
+		},
+		{
+			msg:           "Self lockup - zero amount",
+			address:       address,
+			lockupPeriods: sdkvesting.Periods{{Length: 10, Amount: sdk.NewCoins()}},
+			expectPass:    false,
+		},
+	}
+
+	for i, tc := range testCases {
+		msg := types.MsgSelfLockup{
+			Address:       tc.address,
+			LockupPeriods: tc.lockupPeriods,
+		}
+		err := msg.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s", i, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgFundVestingAccountFromCommunityPool() {
+	authority := "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"
+	address := "cosmos1p3ucd3ptpw902fluyjzhq3ffgq4ntddac9sa3s"
+	coins := sdk.NewCoins(sdk.NewInt64Coin("test", 100))
+
+	testCases := []struct {
+		msg            string
+		authority      string
+		address        string
+		lockupPeriods  sdkvesting.Periods
+		vestingPeriods sdkvesting.Periods
+		expectPass     bool
+	}{
+		{
+			msg:            "Fund from community pool - valid",
+			authority:      authority,
+			address:        address,
+			lockupPeriods:  sdkvesting.Periods{{Length: 10, Amount: coins}},
+			vestingPeriods: sdkvesting.Periods{{Length: 5, Amount: coins}},
+			expectPass:     true,
+		},
+		{
+			msg:            "Fund from community pool - valid vesting only",
+			authority:      authority,
+			address:        address,
+			vestingPeriods: sdkvesting.Periods{{Length: 5, Amount: coins}},
+			expectPass:     true,
+		},
+		{
+			msg:           "Fund from community pool - invalid authority",
+			authority:     "invalid",
+			address:       address,
+			lockupPeriods: sdkvesting.Periods{{Length: 10, Amount: coins}},
+			expectPass:    false,
+		},
+		{
+			msg:           "Fund from community pool - invalid address",
+			authority:     authority,
+			address:       "cosmos1p3ucd3ptpw902fluyjzhq3ffgq4ntddac9sass",
+			lockupPeriods: sdkvesting.Periods{{Length: 10, Amount: coins}},
+			expectPass:    false,
+		},
+		{
+			msg:        "Fund from community pool - no schedules",
+			authority:  authority,
+			address:    address,
+			expectPass: false,
+		},
+		{
+			msg:            "Fund from community pool - different totals",
+			authority:      authority,
+			address:        address,
+			lockupPeriods:  sdkvesting.Periods{{Length: 10, Amount: coins}},
+			vestingPeriods: sdkvesting.Periods{{Length: 5, Amount: coins.Add(coins...)}},
+			expectPass:     false,
+		},
+	}
+
+	for i, tc := range testCases {
+		msg := types.MsgFundVestingAccountFromCommunityPool{
+			Authority:      tc.authority,
+			VestingAddress: tc.address,
+			StartTime:      time.Now(),
+			LockupPeriods:  tc.lockupPeriods,
+			VestingPeriods: tc.vestingPeriods,
+		}
+		err := msg.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s", i, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgCreateFundingInstruction() {
+	funder := "cosmos1p3ucd3ptpw902fluyjzhq3ffgq4ntddac9sa3s"
+	vesting := "cosmos1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqnrql8a"
+	coins := sdk.NewCoins(sdk.NewInt64Coin("test", 100))
+	lockupPeriods := sdkvesting.Periods{{Length: 10, Amount: coins}}
+
+	testCases := []struct {
+		msg           string
+		funder        string
+		vesting       string
+		interval      int64
+		count         uint64
+		lockupPeriods sdkvesting.Periods
+		expectPass    bool
+	}{
+		{
+			msg:           "Create funding instruction - valid",
+			funder:        funder,
+			vesting:       vesting,
+			interval:      100,
+			count:         12,
+			lockupPeriods: lockupPeriods,
+			expectPass:    true,
+		},
+		{
+			msg:           "Create funding instruction - invalid funder address",
+			funder:        "cosmos1p3ucd3ptpw902fluyjzhq3ffgq4ntddac9sass",
+			vesting:       vesting,
+			interval:      100,
+			count:         12,
+			lockupPeriods: lockupPeriods,
+			expectPass:    false,
+		},
+		{
+			msg:           "Create funding instruction - invalid vesting address",
+			funder:        funder,
+			vesting:       "cosmos1p3ucd3ptpw902fluyjzhq3ffgq4ntddac9sass",
+			interval:      100,
+			count:         12,
+			lockupPeriods: lockupPeriods,
+			expectPass:    false,
+		},
+		{
+			msg:           "Create funding instruction - zero interval",
+			funder:        funder,
+			vesting:       vesting,
+			count:         12,
+			lockupPeriods: lockupPeriods,
+			expectPass:    false,
+		},
+		{
+			msg:           "Create funding instruction - zero count",
+			funder:        funder,
+			vesting:       vesting,
+			interval:      100,
+			lockupPeriods: lockupPeriods,
+			expectPass:    false,
+		},
+		{
+			msg:        "Create funding instruction - empty lockup periods",
+			funder:     funder,
+			vesting:    vesting,
+			interval:   100,
+			count:      12,
+			expectPass: false,
+		},
+		{
+			msg:           "Create funding instruction - zero amount",
+			funder:        funder,
+			vesting:       vesting,
+			interval:      100,
+			count:         12,
+			lockupPeriods: sdkvesting.Periods{{Length: 10, Amount: sdk.NewCoins()}},
+			expectPass:    false,
+		},
+	}
+
+	for i, tc := range testCases {
+		msg := types.MsgCreateFundingInstruction{
+			FunderAddress:  tc.funder,
+			VestingAddress: tc.vesting,
+			StartTime:      time.Unix(100, 0),
+			Interval:       tc.interval,
+			Count:          tc.count,
+			LockupPeriods:  tc.lockupPeriods,
+		}
+		err := msg.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s", i, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgCancelFundingInstruction() {
+	testCases := []struct {
+		msg        string
+		funder     string
+		id         uint64
+		expectPass bool
+	}{
+		{
+			msg:        "Cancel funding instruction - valid",
+			funder:     "cosmos1p3ucd3ptpw902fluyjzhq3ffgq4ntddac9sa3s",
+			id:         1,
+			expectPass: true,
+		},
+		{
+			msg:        "Cancel funding instruction - invalid funder address",
+			funder:     "cosmos1p3ucd3ptpw902fluyjzhq3ffgq4ntddac9sass",
+			id:         1,
+			expectPass: false,
+		},
+		{
+			msg:        "Cancel funding instruction - zero id",
+			funder:     "cosmos1p3ucd3ptpw902fluyjzhq3ffgq4ntddac9sa3s",
+			expectPass: false,
+		},
+	}
+
+	for i, tc := range testCases {
+		msg := types.MsgCancelFundingInstruction{
+			FunderAddress: tc.funder,
+			Id:            tc.id,
+		}
+		err := msg.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s", i, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgClawbackEscrowGrant() {
+	funder := "cosmos1p3ucd3ptpw902fluyjzhq3ffgq4ntddac9sa3s"
+
+	testCases := []struct {
+		msg        string
+		funder     string
+		id         uint64
+		dest       string
+		expectPass bool
+	}{
+		{
+			msg:        "Clawback escrow grant - valid",
+			funder:     funder,
+			id:         1,
+			expectPass: true,
+		},
+		{
+			msg:        "Clawback escrow grant - valid with dest",
+			funder:     funder,
+			id:         1,
+			dest:       "cosmos1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqnrql8a",
+			expectPass: true,
+		},
+		{
+			msg:        "Clawback escrow grant - invalid funder address",
+			funder:     "cosmos1p3ucd3ptpw902fluyjzhq3ffgq4ntddac9sass",
+			id:         1,
+			expectPass: false,
+		},
+		{
+			msg:        "Clawback escrow grant - zero id",
+			funder:     funder,
+			expectPass: false,
+		},
+		{
+			msg:        "Clawback escrow grant - invalid dest address",
+			funder:     funder,
+			id:         1,
+			dest:       "cosmos1p3ucd3ptpw902fluyjzhq3ffgq4ntddac9sass",
+			expectPass: false,
+		},
+	}
+
+	for i, tc := range testCases {
+		msg := types.MsgClawbackEscrowGrant{
+			FunderAddress: tc.funder,
+			Id:            tc.id,
+			DestAddress:   tc.dest,
+		}
+		err := msg.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s", i, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgCreateFunderCommittee() {
+	creator := "cosmos1p3ucd3ptpw902fluyjzhq3ffgq4ntddac9sa3s"
+	members := []string{creator, "cosmos1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqnrql8a"}
+
+	testCases := []struct {
+		msg        string
+		creator    string
+		members    []string
+		threshold  uint32
+		lifetime   int64
+		expectPass bool
+	}{
+		{
+			msg:        "Create funder committee - valid",
+			creator:    creator,
+			members:    members,
+			threshold:  2,
+			lifetime:   100,
+			expectPass: true,
+		},
+		{
+			msg:        "Create funder committee - invalid creator address",
+			creator:    "cosmos1p3ucd3ptpw902fluyjzhq3ffgq4ntddac9sass",
+			members:    members,
+			threshold:  2,
+			lifetime:   100,
+			expectPass: false,
+		},
+		{
+			msg:        "Create funder committee - threshold above members",
+			creator:    creator,
+			members:    members,
+			threshold:  3,
+			lifetime:   100,
+			expectPass: false,
+		},
+		{
+			msg:        "Create funder committee - zero proposal lifetime",
+			creator:    creator,
+			members:    members,
+			threshold:  2,
+			expectPass: false,
+		},
+	}
+
+	for i, tc := range testCases {
+		msg := types.MsgCreateFunderCommittee{
+			Creator:          tc.creator,
+			Members:          tc.members,
+			Threshold:        tc.threshold,
+			ProposalLifetime: tc.lifetime,
+		}
+		err := msg.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s", i, tc.msg)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import "fmt"
+
+const (
+	// DefaultMaxConversionsPerBlock is the default maximum number of completed
+	// vesting accounts that are converted in a single block.
+	DefaultMaxConversionsPerBlock uint32 = 50
+	// DefaultMaxScheduleEventsPerBlock is the default maximum number of vesting
+	// and unlocking events that are emitted in a single block.
+	DefaultMaxScheduleEventsPerBlock uint32 = 100
+	// DefaultMaxFundingInstructionsPerBlock is the default maximum number of
+	// recurring funding instructions that are executed in a single block.
+	DefaultMaxFundingInstructionsPerBlock uint32 = 50
+)
+
+// NewParams creates a new Params instance
+func NewParams(
+	enableAutoConvert bool,
+	maxConversionsPerBlock, maxScheduleEventsPerBlock, maxFundingInstructionsPerBlock uint32,
+) Params {
+	return Params{
+		EnableAutoConvert:              enableAutoConvert,
+		MaxConversionsPerBlock:         maxConversionsPerBlock,
+		MaxScheduleEventsPerBlock:      maxScheduleEventsPerBlock,
+		MaxFundingInstructionsPerBlock: maxFundingInstructionsPerBlock,
+	}
+}
+
+// DefaultParams returns the default x/vesting module parameters. The automatic
+// conversion of completed vesting accounts is disabled by default.
+func DefaultParams() Params {
+	return NewParams(false, DefaultMaxConversionsPerBlock, DefaultMaxScheduleEventsPerBlock, DefaultMaxFundingInstructionsPerBlock)
+}
+
+// Validate performs a stateless validation of the params
+func (p Params) Validate() error {
+	if p.EnableAutoConvert && p.MaxConversionsPerBlock == 0 {
+		return fmt.Errorf("max conversions per block must be positive when auto conversion is enabled")
+	}
+
+	// the schedule events and funding instructions that are due would otherwise
+	// pile up without ever being processed
+	if p.MaxScheduleEventsPerBlock == 0 {
+		return fmt.Errorf("max schedule events per block must be positive")
+	}
+
+	if p.MaxFundingInstructionsPerBlock == 0 {
+		return fmt.Errorf("max funding instructions per block must be positive")
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package types_test
+
+import (
+	"bytes"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/evmos/vesting/x/vesting/types"
+)
+
+type ParamsTestSuite struct {
+	suite.Suite
+}
+
+func TestParamsTestSuite(t *testing.T) {
+	suite.Run(t, new(ParamsTestSuite))
+}
+
+func (suite *ParamsTestSuite) TestParamsValidate() {
+	testCases := []struct {
+		name     string
+		params   types.Params
+		expError bool
+	}{
+		{"default", types.DefaultParams(), false},
+		{"auto conversion enabled", types.NewParams(true, 10, 10, 10), false},
+		{"auto conversion disabled with zero max conversions", types.NewParams(false, 0, 10, 10), false},
+		{"auto conversion enabled with zero max conversions", types.NewParams(true, 0, 10, 10), true},
+		{"zero max schedule events", types.NewParams(false, 10, 0, 10), true},
+		{"zero max funding instructions", types.NewParams(false, 10, 10, 0), true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.params.Validate()
+		if tc.expError {
+			suite.Require().Error(err, tc.name)
+		} else {
+			suite.Require().NoError(err, tc.name)
+		}
+		suite.Require().Equal(err == nil, types.NewGenesisState(tc.params).Validate() == nil, tc.name)
+	}
+
+	suite.Require().False(types.DefaultParams().EnableAutoConvert)
+}
+
+func (suite *ParamsTestSuite) TestEndTimeIndexKey() {
+	addr := sdk.AccAddress("test_address")
+
+	key := types.EndTimeIndexKey(1_000_000, addr)
+	suite.Require().Equal(types.KeyPrefixEndTimeIndex, key[:1])
+
+	endTime, keyAddr := types.SplitEndTimeIndexKey(key[1:])
+	suite.Require().Equal(int64(1_000_000), endTime)
+	suite.Require().Equal(addr, keyAddr)
+
+	// entries are ordered by end time
+	suite.Require().Negative(bytes.Compare(types.EndTimeIndexKey(999_999, addr), key))
+}
+
+func (suite *ParamsTestSuite) TestScheduleEventIndexKey() {
+	addr := sdk.AccAddress("test_address")
+
+	key := types.ScheduleEventIndexKey(1_000_000, addr, types.ScheduleEventTypeVest, 3)
+	suite.Require().Equal(types.KeyPrefixScheduleEventIndex, key[:1])
+
+	eventTime, keyAddr, eventType, periodIndex := types.SplitScheduleEventIndexKey(key[1:])
+	suite.Require().Equal(int64(1_000_000), eventTime)
+	suite.Require().Equal(addr, keyAddr)
+	suite.Require().Equal(types.ScheduleEventTypeVest, eventType)
+	suite.Require().Equal(uint64(3), periodIndex)
+
+	// entries are ordered by event time
+	suite.Require().Negative(bytes.Compare(types.ScheduleEventIndexKey(999_999, addr, types.ScheduleEventTypeVest, 4), key))
+}
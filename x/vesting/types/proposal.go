@@ -22,6 +22,8 @@ func init() {
 }
 
 // NewClawbackProposal returns new instance of RegisterCoinProposal
+//
+// Deprecated: submit MsgClawback through a gov v1 MsgSubmitProposal instead.
 func NewClawbackProposal(title, description, address, destinationAddress string) v1beta1.Content {
 	return &ClawbackProposal{
 		Title:              title,
@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -142,276 +143,4696 @@ func (m *QueryBalancesResponse) GetVested() github_com_cosmos_cosmos_sdk_types.C
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryBalancesRequest)(nil), "vesting.v1.QueryBalancesRequest")
-	proto.RegisterType((*QueryBalancesResponse)(nil), "vesting.v1.QueryBalancesResponse")
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
 }
 
-func init() { proto.RegisterFile("vesting/v1/query.proto", fileDescriptor_ae633be142995aa7) }
-
-var fileDescriptor_ae633be142995aa7 = []byte{
-	// 370 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x52, 0xc1, 0x4e, 0xf2, 0x40,
-	0x10, 0x6e, 0x21, 0x3f, 0x3f, 0xff, 0xfe, 0xb7, 0x06, 0x4d, 0x25, 0xa6, 0x20, 0x31, 0x86, 0x83,
-	0xee, 0x52, 0x7c, 0x83, 0xfa, 0x04, 0x72, 0xf4, 0xb6, 0x6d, 0x37, 0x6b, 0x03, 0xec, 0x14, 0x76,
-	0xdb, 0x48, 0x0c, 0x17, 0x0f, 0x9e, 0x4d, 0x7c, 0x0b, 0x9f, 0x84, 0x78, 0x22, 0xf1, 0xe2, 0x49,
-	0x0d, 0xf8, 0x20, 0xa6, 0xed, 0x82, 0x68, 0x8c, 0x27, 0x3d, 0x75, 0x3a, 0x3b, 0xf3, 0xcd, 0xf7,
-	0xcd, 0x37, 0x68, 0x3b, 0x65, 0x52, 0x45, 0x82, 0x93, 0xd4, 0x25, 0xa3, 0x84, 0x8d, 0x27, 0x38,
-	0x1e, 0x83, 0x02, 0x0b, 0xe9, 0x3c, 0x4e, 0xdd, 0xba, 0x13, 0x80, 0x1c, 0x82, 0x24, 0x3e, 0x95,
-	0x8c, 0xa4, 0xae, 0xcf, 0x14, 0x75, 0x49, 0x00, 0x91, 0x28, 0x6a, 0xeb, 0x35, 0x0e, 0x1c, 0xf2,
-	0x90, 0x64, 0x91, 0xce, 0xee, 0x72, 0x00, 0x3e, 0x60, 0x84, 0xc6, 0x11, 0xa1, 0x42, 0x80, 0xa2,
-	0x2a, 0x02, 0x21, 0x8b, 0xd7, 0x56, 0x07, 0xd5, 0x4e, 0xb3, 0x71, 0x1e, 0x1d, 0x50, 0x11, 0x30,
-	0xd9, 0x63, 0xa3, 0x84, 0x49, 0x65, 0xd9, 0xe8, 0x2f, 0x0d, 0xc3, 0x31, 0x93, 0xd2, 0x36, 0x9b,
-	0x66, 0xfb, 0x5f, 0x6f, 0xf5, 0xdb, 0xba, 0x2f, 0xa1, 0xad, 0x4f, 0x2d, 0x32, 0x06, 0x21, 0x99,
-	0x15, 0xa0, 0xca, 0x00, 0x82, 0x3e, 0x0b, 0x6d, 0xb3, 0x59, 0x6e, 0xff, 0xef, 0xee, 0xe0, 0x82,
-	0x30, 0xce, 0x08, 0x63, 0x4d, 0x18, 0x9f, 0x40, 0x24, 0xbc, 0xce, 0xec, 0xa9, 0x61, 0xdc, 0x3d,
-	0x37, 0xda, 0x3c, 0x52, 0xe7, 0x89, 0x8f, 0x03, 0x18, 0x12, 0xad, 0xae, 0xf8, 0x1c, 0xc9, 0xb0,
-	0x4f, 0xd4, 0x24, 0x66, 0x32, 0x6f, 0x90, 0x3d, 0x0d, 0x6d, 0x71, 0x54, 0x4d, 0x44, 0xb6, 0x14,
-	0x16, 0xda, 0xa5, 0x9f, 0x1f, 0xb3, 0x06, 0xcf, 0xd4, 0xe8, 0x31, 0xe5, 0x5f, 0x50, 0x53, 0x40,
-	0x77, 0xaf, 0x4d, 0xf4, 0x27, 0x5f, 0xa6, 0x35, 0x45, 0xd5, 0xd5, 0x42, 0xad, 0x26, 0x7e, 0x77,
-	0x1d, 0x7f, 0x65, 0x4f, 0x7d, 0xef, 0x9b, 0x8a, 0xc2, 0x8d, 0xd6, 0xe1, 0xd5, 0xc3, 0xeb, 0x6d,
-	0xe9, 0xc0, 0xda, 0x27, 0x2c, 0xcd, 0x98, 0x6c, 0x1c, 0x98, 0xaf, 0x6b, 0xc9, 0xa5, 0x36, 0x75,
-	0xea, 0x79, 0xb3, 0x85, 0x63, 0xce, 0x17, 0x8e, 0xf9, 0xb2, 0x70, 0xcc, 0x9b, 0xa5, 0x63, 0xcc,
-	0x97, 0x8e, 0xf1, 0xb8, 0x74, 0x8c, 0xb3, 0x4d, 0x51, 0x1f, 0x91, 0x2e, 0xd6, 0x51, 0x2e, 0xcd,
-	0xaf, 0xe4, 0x27, 0x75, 0xfc, 0x16, 0x00, 0x00, 0xff, 0xff, 0xba, 0x8a, 0x14, 0x79, 0xcc, 0x02,
-	0x00, 0x00,
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae633be142995aa7, []int{2}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+// QueryParamsResponse is the response type for the Query/Params RPC
+// method.
+type QueryParamsResponse struct {
+	// params defines the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Balances retrieves the unvested, vested and locked tokens for a vesting account
-	Balances(ctx context.Context, in *QueryBalancesRequest, opts ...grpc.CallOption) (*QueryBalancesResponse, error)
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae633be142995aa7, []int{3}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// QueryPausedVestingAccountsRequest is the request type for the
+// Query/PausedVestingAccounts RPC method.
+type QueryPausedVestingAccountsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) Balances(ctx context.Context, in *QueryBalancesRequest, opts ...grpc.CallOption) (*QueryBalancesResponse, error) {
-	out := new(QueryBalancesResponse)
-	err := c.cc.Invoke(ctx, "/vesting.v1.Query/Balances", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPausedVestingAccountsRequest) Reset()         { *m = QueryPausedVestingAccountsRequest{} }
+func (m *QueryPausedVestingAccountsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPausedVestingAccountsRequest) ProtoMessage()    {}
+func (*QueryPausedVestingAccountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae633be142995aa7, []int{4}
+}
+func (m *QueryPausedVestingAccountsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPausedVestingAccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPausedVestingAccountsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryPausedVestingAccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPausedVestingAccountsRequest.Merge(m, src)
+}
+func (m *QueryPausedVestingAccountsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPausedVestingAccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPausedVestingAccountsRequest.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Balances retrieves the unvested, vested and locked tokens for a vesting account
-	Balances(context.Context, *QueryBalancesRequest) (*QueryBalancesResponse, error)
+var xxx_messageInfo_QueryPausedVestingAccountsRequest proto.InternalMessageInfo
+
+func (m *QueryPausedVestingAccountsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// PausedVestingAccount defines a clawback vesting account whose vesting is
+// paused.
+type PausedVestingAccount struct {
+	// address of the clawback vesting account
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// paused_at is the unix time at which the vesting was paused
+	PausedAt int64 `protobuf:"varint,2,opt,name=paused_at,json=pausedAt,proto3" json:"paused_at,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Balances(ctx context.Context, req *QueryBalancesRequest) (*QueryBalancesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Balances not implemented")
+func (m *PausedVestingAccount) Reset()         { *m = PausedVestingAccount{} }
+func (m *PausedVestingAccount) String() string { return proto.CompactTextString(m) }
+func (*PausedVestingAccount) ProtoMessage()    {}
+func (*PausedVestingAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae633be142995aa7, []int{5}
+}
+func (m *PausedVestingAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PausedVestingAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PausedVestingAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PausedVestingAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PausedVestingAccount.Merge(m, src)
+}
+func (m *PausedVestingAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *PausedVestingAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_PausedVestingAccount.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_PausedVestingAccount proto.InternalMessageInfo
+
+func (m *PausedVestingAccount) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func _Query_Balances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBalancesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *PausedVestingAccount) GetPausedAt() int64 {
+	if m != nil {
+		return m.PausedAt
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Balances(ctx, in)
+	return 0
+}
+
+// QueryPausedVestingAccountsResponse is the response type for the
+// Query/PausedVestingAccounts RPC method.
+type QueryPausedVestingAccountsResponse struct {
+	// accounts are the clawback vesting accounts whose vesting is paused
+	Accounts []PausedVestingAccount `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPausedVestingAccountsResponse) Reset()         { *m = QueryPausedVestingAccountsResponse{} }
+func (m *QueryPausedVestingAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPausedVestingAccountsResponse) ProtoMessage()    {}
+func (*QueryPausedVestingAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae633be142995aa7, []int{6}
+}
+func (m *QueryPausedVestingAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPausedVestingAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPausedVestingAccountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/vesting.v1.Query/Balances",
+}
+func (m *QueryPausedVestingAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPausedVestingAccountsResponse.Merge(m, src)
+}
+func (m *QueryPausedVestingAccountsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPausedVestingAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPausedVestingAccountsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPausedVestingAccountsResponse proto.InternalMessageInfo
+
+func (m *QueryPausedVestingAccountsResponse) GetAccounts() []PausedVestingAccount {
+	if m != nil {
+		return m.Accounts
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Balances(ctx, req.(*QueryBalancesRequest))
+	return nil
+}
+
+func (m *QueryPausedVestingAccountsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "vesting.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Balances",
-			Handler:    _Query_Balances_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "vesting/v1/query.proto",
+// QueryScheduleAmendmentRequest is the request type for the
+// Query/ScheduleAmendment RPC method.
+type QueryScheduleAmendmentRequest struct {
+	// address of the clawback vesting account
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *QueryBalancesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryScheduleAmendmentRequest) Reset()         { *m = QueryScheduleAmendmentRequest{} }
+func (m *QueryScheduleAmendmentRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduleAmendmentRequest) ProtoMessage()    {}
+func (*QueryScheduleAmendmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae633be142995aa7, []int{7}
+}
+func (m *QueryScheduleAmendmentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduleAmendmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduleAmendmentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryScheduleAmendmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduleAmendmentRequest.Merge(m, src)
+}
+func (m *QueryScheduleAmendmentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduleAmendmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduleAmendmentRequest.DiscardUnknown(m)
 }
 
-func (m *QueryBalancesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryScheduleAmendmentRequest proto.InternalMessageInfo
+
+func (m *QueryScheduleAmendmentRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func (m *QueryBalancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+// QueryScheduleAmendmentResponse is the response type for the
+// Query/ScheduleAmendment RPC method.
+type QueryScheduleAmendmentResponse struct {
+	// amendment is the pending schedule amendment of the vesting account
+	Amendment ScheduleAmendment `protobuf:"bytes,1,opt,name=amendment,proto3" json:"amendment"`
+}
+
+func (m *QueryScheduleAmendmentResponse) Reset()         { *m = QueryScheduleAmendmentResponse{} }
+func (m *QueryScheduleAmendmentResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduleAmendmentResponse) ProtoMessage()    {}
+func (*QueryScheduleAmendmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae633be142995aa7, []int{8}
+}
+func (m *QueryScheduleAmendmentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduleAmendmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduleAmendmentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryScheduleAmendmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduleAmendmentResponse.Merge(m, src)
+}
+func (m *QueryScheduleAmendmentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduleAmendmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduleAmendmentResponse.DiscardUnknown(m)
 }
 
-func (m *QueryBalancesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryScheduleAmendmentResponse proto.InternalMessageInfo
+
+func (m *QueryScheduleAmendmentResponse) GetAmendment() ScheduleAmendment {
+	if m != nil {
+		return m.Amendment
 	}
-	return dAtA[:n], nil
+	return ScheduleAmendment{}
 }
 
-func (m *QueryBalancesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryFundingInstructionRequest is the request type for the
+// Query/FundingInstruction RPC method.
+type QueryFundingInstructionRequest struct {
+	// id of the funding instruction
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *QueryBalancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Vested) > 0 {
-		for iNdEx := len(m.Vested) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Vested[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryFundingInstructionRequest) Reset()         { *m = QueryFundingInstructionRequest{} }
+func (m *QueryFundingInstructionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFundingInstructionRequest) ProtoMessage()    {}
+func (*QueryFundingInstructionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae633be142995aa7, []int{9}
+}
+func (m *QueryFundingInstructionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFundingInstructionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFundingInstructionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFundingInstructionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFundingInstructionRequest.Merge(m, src)
+}
+func (m *QueryFundingInstructionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFundingInstructionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFundingInstructionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFundingInstructionRequest proto.InternalMessageInfo
+
+func (m *QueryFundingInstructionRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QueryFundingInstructionResponse is the response type for the
+// Query/FundingInstruction RPC method.
+type QueryFundingInstructionResponse struct {
+	// instruction is the recurring funding instruction
+	Instruction FundingInstruction `protobuf:"bytes,1,opt,name=instruction,proto3" json:"instruction"`
+}
+
+func (m *QueryFundingInstructionResponse) Reset()         { *m = QueryFundingInstructionResponse{} }
+func (m *QueryFundingInstructionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFundingInstructionResponse) ProtoMessage()    {}
+func (*QueryFundingInstructionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae633be142995aa7, []int{10}
+}
+func (m *QueryFundingInstructionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFundingInstructionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFundingInstructionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFundingInstructionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFundingInstructionResponse.Merge(m, src)
+}
+func (m *QueryFundingInstructionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFundingInstructionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFundingInstructionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFundingInstructionResponse proto.InternalMessageInfo
+
+func (m *QueryFundingInstructionResponse) GetInstruction() FundingInstruction {
+	if m != nil {
+		return m.Instruction
+	}
+	return FundingInstruction{}
+}
+
+// QueryFundingInstructionsRequest is the request type for the
+// Query/FundingInstructions RPC method.
+type QueryFundingInstructionsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFundingInstructionsRequest) Reset()         { *m = QueryFundingInstructionsRequest{} }
+func (m *QueryFundingInstructionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFundingInstructionsRequest) ProtoMessage()    {}
+func (*QueryFundingInstructionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae633be142995aa7, []int{11}
+}
+func (m *QueryFundingInstructionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFundingInstructionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFundingInstructionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFundingInstructionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFundingInstructionsRequest.Merge(m, src)
+}
+func (m *QueryFundingInstructionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFundingInstructionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFundingInstructionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFundingInstructionsRequest proto.InternalMessageInfo
+
+func (m *QueryFundingInstructionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryFundingInstructionsResponse is the response type for the
+// Query/FundingInstructions RPC method.
+type QueryFundingInstructionsResponse struct {
+	// instructions are the recurring funding instructions
+	Instructions []FundingInstruction `protobuf:"bytes,1,rep,name=instructions,proto3" json:"instructions"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFundingInstructionsResponse) Reset()         { *m = QueryFundingInstructionsResponse{} }
+func (m *QueryFundingInstructionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFundingInstructionsResponse) ProtoMessage()    {}
+func (*QueryFundingInstructionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae633be142995aa7, []int{12}
+}
+func (m *QueryFundingInstructionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFundingInstructionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFundingInstructionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFundingInstructionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFundingInstructionsResponse.Merge(m, src)
+}
+func (m *QueryFundingInstructionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFundingInstructionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFundingInstructionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFundingInstructionsResponse proto.InternalMessageInfo
+
+func (m *QueryFundingInstructionsResponse) GetInstructions() []FundingInstruction {
+	if m != nil {
+		return m.Instructions
+	}
+	return nil
+}
+
+func (m *QueryFundingInstructionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryEscrowGrantRequest is the request type for the Query/EscrowGrant RPC
+// method.
+type QueryEscrowGrantRequest struct {
+	// id of the escrow grant
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryEscrowGrantRequest) Reset()         { *m = QueryEscrowGrantRequest{} }
+func (m *QueryEscrowGrantRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEscrowGrantRequest) ProtoMessage()    {}
+func (*QueryEscrowGrantRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae633be142995aa7, []int{13}
+}
+func (m *QueryEscrowGrantRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEscrowGrantRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEscrowGrantRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEscrowGrantRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEscrowGrantRequest.Merge(m, src)
+}
+func (m *QueryEscrowGrantRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEscrowGrantRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEscrowGrantRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEscrowGrantRequest proto.InternalMessageInfo
+
+func (m *QueryEscrowGrantRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QueryEscrowGrantResponse is the response type for the Query/EscrowGrant RPC
+// method.
+type QueryEscrowGrantResponse struct {
+	// grant is the escrow grant
+	Grant EscrowGrant `protobuf:"bytes,1,opt,name=grant,proto3" json:"grant"`
+	// claimable defines the current amount of vested and unlocked coins that
+	// have not been claimed
+	Claimable github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=claimable,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"claimable"`
+}
+
+func (m *QueryEscrowGrantResponse) Reset()         { *m = QueryEscrowGrantResponse{} }
+func (m *QueryEscrowGrantResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEscrowGrantResponse) ProtoMessage()    {}
+func (*QueryEscrowGrantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae633be142995aa7, []int{14}
+}
+func (m *QueryEscrowGrantResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEscrowGrantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEscrowGrantResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEscrowGrantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEscrowGrantResponse.Merge(m, src)
+}
+func (m *QueryEscrowGrantResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEscrowGrantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEscrowGrantResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEscrowGrantResponse proto.InternalMessageInfo
+
+func (m *QueryEscrowGrantResponse) GetGrant() EscrowGrant {
+	if m != nil {
+		return m.Grant
+	}
+	return EscrowGrant{}
+}
+
+func (m *QueryEscrowGrantResponse) GetClaimable() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Claimable
+	}
+	return nil
+}
+
+// QueryEscrowGrantsRequest is the request type for the Query/EscrowGrants RPC
+// method.
+type QueryEscrowGrantsRequest struct {
+	// address of the recipient
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryEscrowGrantsRequest) Reset()         { *m = QueryEscrowGrantsRequest{} }
+func (m *QueryEscrowGrantsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEscrowGrantsRequest) ProtoMessage()    {}
+func (*QueryEscrowGrantsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae633be142995aa7, []int{15}
+}
+func (m *QueryEscrowGrantsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEscrowGrantsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEscrowGrantsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEscrowGrantsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEscrowGrantsRequest.Merge(m, src)
+}
+func (m *QueryEscrowGrantsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEscrowGrantsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEscrowGrantsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEscrowGrantsRequest proto.InternalMessageInfo
+
+func (m *QueryEscrowGrantsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryEscrowGrantsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryEscrowGrantsResponse is the response type for the Query/EscrowGrants
+// RPC method.
+type QueryEscrowGrantsResponse struct {
+	// grants are the escrow grants of the recipient
+	Grants []EscrowGrant `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryEscrowGrantsResponse) Reset()         { *m = QueryEscrowGrantsResponse{} }
+func (m *QueryEscrowGrantsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEscrowGrantsResponse) ProtoMessage()    {}
+func (*QueryEscrowGrantsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae633be142995aa7, []int{16}
+}
+func (m *QueryEscrowGrantsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEscrowGrantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEscrowGrantsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEscrowGrantsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEscrowGrantsResponse.Merge(m, src)
+}
+func (m *QueryEscrowGrantsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEscrowGrantsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEscrowGrantsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEscrowGrantsResponse proto.InternalMessageInfo
+
+func (m *QueryEscrowGrantsResponse) GetGrants() []EscrowGrant {
+	if m != nil {
+		return m.Grants
+	}
+	return nil
+}
+
+func (m *QueryEscrowGrantsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryFunderCommitteeRequest is the request type for the
+// Query/FunderCommittee RPC method.
+type QueryFunderCommitteeRequest struct {
+	// address of the funder committee
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryFunderCommitteeRequest) Reset()         { *m = QueryFunderCommitteeRequest{} }
+func (m *QueryFunderCommitteeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFunderCommitteeRequest) ProtoMessage()    {}
+func (*QueryFunderCommitteeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae633be142995aa7, []int{17}
+}
+func (m *QueryFunderCommitteeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFunderCommitteeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFunderCommitteeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFunderCommitteeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFunderCommitteeRequest.Merge(m, src)
+}
+func (m *QueryFunderCommitteeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFunderCommitteeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFunderCommitteeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFunderCommitteeRequest proto.InternalMessageInfo
+
+func (m *QueryFunderCommitteeRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryFunderCommitteeResponse is the response type for the
+// Query/FunderCommittee RPC method.
+type QueryFunderCommitteeResponse struct {
+	// committee is the funder committee
+	Committee FunderCommittee `protobuf:"bytes,1,opt,name=committee,proto3" json:"committee"`
+}
+
+func (m *QueryFunderCommitteeResponse) Reset()         { *m = QueryFunderCommitteeResponse{} }
+func (m *QueryFunderCommitteeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFunderCommitteeResponse) ProtoMessage()    {}
+func (*QueryFunderCommitteeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae633be142995aa7, []int{18}
+}
+func (m *QueryFunderCommitteeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFunderCommitteeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFunderCommitteeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFunderCommitteeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFunderCommitteeResponse.Merge(m, src)
+}
+func (m *QueryFunderCommitteeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFunderCommitteeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFunderCommitteeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFunderCommitteeResponse proto.InternalMessageInfo
+
+func (m *QueryFunderCommitteeResponse) GetCommittee() FunderCommittee {
+	if m != nil {
+		return m.Committee
+	}
+	return FunderCommittee{}
+}
+
+// QueryCommitteeProposalRequest is the request type for the
+// Query/CommitteeProposal RPC method.
+type QueryCommitteeProposalRequest struct {
+	// id of the proposal
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryCommitteeProposalRequest) Reset()         { *m = QueryCommitteeProposalRequest{} }
+func (m *QueryCommitteeProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCommitteeProposalRequest) ProtoMessage()    {}
+func (*QueryCommitteeProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae633be142995aa7, []int{19}
+}
+func (m *QueryCommitteeProposalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCommitteeProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCommitteeProposalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCommitteeProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCommitteeProposalRequest.Merge(m, src)
+}
+func (m *QueryCommitteeProposalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCommitteeProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCommitteeProposalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCommitteeProposalRequest proto.InternalMessageInfo
+
+func (m *QueryCommitteeProposalRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QueryCommitteeProposalResponse is the response type for the
+// Query/CommitteeProposal RPC method.
+type QueryCommitteeProposalResponse struct {
+	// proposal is the funder committee proposal
+	Proposal CommitteeProposal `protobuf:"bytes,1,opt,name=proposal,proto3" json:"proposal"`
+}
+
+func (m *QueryCommitteeProposalResponse) Reset()         { *m = QueryCommitteeProposalResponse{} }
+func (m *QueryCommitteeProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCommitteeProposalResponse) ProtoMessage()    {}
+func (*QueryCommitteeProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae633be142995aa7, []int{20}
+}
+func (m *QueryCommitteeProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCommitteeProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCommitteeProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCommitteeProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCommitteeProposalResponse.Merge(m, src)
+}
+func (m *QueryCommitteeProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCommitteeProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCommitteeProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCommitteeProposalResponse proto.InternalMessageInfo
+
+func (m *QueryCommitteeProposalResponse) GetProposal() CommitteeProposal {
+	if m != nil {
+		return m.Proposal
+	}
+	return CommitteeProposal{}
+}
+
+// QueryCommitteeProposalsRequest is the request type for the
+// Query/CommitteeProposals RPC method.
+type QueryCommitteeProposalsRequest struct {
+	// address of the funder committee
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCommitteeProposalsRequest) Reset()         { *m = QueryCommitteeProposalsRequest{} }
+func (m *QueryCommitteeProposalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCommitteeProposalsRequest) ProtoMessage()    {}
+func (*QueryCommitteeProposalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae633be142995aa7, []int{21}
+}
+func (m *QueryCommitteeProposalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCommitteeProposalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCommitteeProposalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCommitteeProposalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCommitteeProposalsRequest.Merge(m, src)
+}
+func (m *QueryCommitteeProposalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCommitteeProposalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCommitteeProposalsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCommitteeProposalsRequest proto.InternalMessageInfo
+
+func (m *QueryCommitteeProposalsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryCommitteeProposalsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryCommitteeProposalsResponse is the response type for the
+// Query/CommitteeProposals RPC method.
+type QueryCommitteeProposalsResponse struct {
+	// proposals are the pending proposals of the funder committee
+	Proposals []CommitteeProposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCommitteeProposalsResponse) Reset()         { *m = QueryCommitteeProposalsResponse{} }
+func (m *QueryCommitteeProposalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCommitteeProposalsResponse) ProtoMessage()    {}
+func (*QueryCommitteeProposalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae633be142995aa7, []int{22}
+}
+func (m *QueryCommitteeProposalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCommitteeProposalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCommitteeProposalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCommitteeProposalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCommitteeProposalsResponse.Merge(m, src)
+}
+func (m *QueryCommitteeProposalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCommitteeProposalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCommitteeProposalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCommitteeProposalsResponse proto.InternalMessageInfo
+
+func (m *QueryCommitteeProposalsResponse) GetProposals() []CommitteeProposal {
+	if m != nil {
+		return m.Proposals
+	}
+	return nil
+}
+
+func (m *QueryCommitteeProposalsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryBalancesRequest)(nil), "vesting.v1.QueryBalancesRequest")
+	proto.RegisterType((*QueryBalancesResponse)(nil), "vesting.v1.QueryBalancesResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "vesting.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "vesting.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryPausedVestingAccountsRequest)(nil), "vesting.v1.QueryPausedVestingAccountsRequest")
+	proto.RegisterType((*PausedVestingAccount)(nil), "vesting.v1.PausedVestingAccount")
+	proto.RegisterType((*QueryPausedVestingAccountsResponse)(nil), "vesting.v1.QueryPausedVestingAccountsResponse")
+	proto.RegisterType((*QueryScheduleAmendmentRequest)(nil), "vesting.v1.QueryScheduleAmendmentRequest")
+	proto.RegisterType((*QueryScheduleAmendmentResponse)(nil), "vesting.v1.QueryScheduleAmendmentResponse")
+	proto.RegisterType((*QueryFundingInstructionRequest)(nil), "vesting.v1.QueryFundingInstructionRequest")
+	proto.RegisterType((*QueryFundingInstructionResponse)(nil), "vesting.v1.QueryFundingInstructionResponse")
+	proto.RegisterType((*QueryFundingInstructionsRequest)(nil), "vesting.v1.QueryFundingInstructionsRequest")
+	proto.RegisterType((*QueryFundingInstructionsResponse)(nil), "vesting.v1.QueryFundingInstructionsResponse")
+	proto.RegisterType((*QueryEscrowGrantRequest)(nil), "vesting.v1.QueryEscrowGrantRequest")
+	proto.RegisterType((*QueryEscrowGrantResponse)(nil), "vesting.v1.QueryEscrowGrantResponse")
+	proto.RegisterType((*QueryEscrowGrantsRequest)(nil), "vesting.v1.QueryEscrowGrantsRequest")
+	proto.RegisterType((*QueryEscrowGrantsResponse)(nil), "vesting.v1.QueryEscrowGrantsResponse")
+	proto.RegisterType((*QueryFunderCommitteeRequest)(nil), "vesting.v1.QueryFunderCommitteeRequest")
+	proto.RegisterType((*QueryFunderCommitteeResponse)(nil), "vesting.v1.QueryFunderCommitteeResponse")
+	proto.RegisterType((*QueryCommitteeProposalRequest)(nil), "vesting.v1.QueryCommitteeProposalRequest")
+	proto.RegisterType((*QueryCommitteeProposalResponse)(nil), "vesting.v1.QueryCommitteeProposalResponse")
+	proto.RegisterType((*QueryCommitteeProposalsRequest)(nil), "vesting.v1.QueryCommitteeProposalsRequest")
+	proto.RegisterType((*QueryCommitteeProposalsResponse)(nil), "vesting.v1.QueryCommitteeProposalsResponse")
+}
+
+func init() { proto.RegisterFile("vesting/v1/query.proto", fileDescriptor_ae633be142995aa7) }
+
+var fileDescriptor_ae633be142995aa7 = []byte{
+	// 1188 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcf, 0x6f, 0xdc, 0xd4,
+	0x13, 0xcf, 0xdb, 0xb4, 0xf9, 0x26, 0x93, 0x7e, 0x41, 0x7d, 0x4d, 0xe9, 0xd6, 0x69, 0x9d, 0x8d,
+	0x5b, 0xd2, 0x4d, 0x9a, 0xd8, 0xf9, 0xa1, 0x50, 0x55, 0x1c, 0xaa, 0x6c, 0x45, 0x02, 0x07, 0xa4,
+	0xb0, 0x48, 0x1c, 0xb8, 0xac, 0xbc, 0xf6, 0xc3, 0xb5, 0xb2, 0xeb, 0xb7, 0xf5, 0xf3, 0x06, 0xaa,
+	0x92, 0x4b, 0x0f, 0x9c, 0x91, 0xb8, 0x54, 0xaa, 0x84, 0xa0, 0x47, 0x24, 0x90, 0x38, 0x70, 0xe1,
+	0x2f, 0xa8, 0x38, 0x55, 0xe2, 0xc2, 0x09, 0x50, 0xc2, 0x1f, 0x82, 0xf6, 0x79, 0xec, 0xf5, 0xe6,
+	0xd9, 0xd9, 0x05, 0x6d, 0x39, 0xc5, 0x19, 0x7f, 0x3e, 0x33, 0x9f, 0x99, 0x37, 0x7e, 0x33, 0x5a,
+	0x78, 0xe3, 0x90, 0x89, 0xc8, 0x0f, 0x3c, 0xeb, 0x70, 0xc3, 0x7a, 0xd8, 0x65, 0xe1, 0x23, 0xb3,
+	0x13, 0xf2, 0x88, 0x53, 0x40, 0xbb, 0x79, 0xb8, 0xa1, 0xad, 0x38, 0x5c, 0xb4, 0xb9, 0xb0, 0x9a,
+	0xb6, 0x60, 0x31, 0xc8, 0x3a, 0xdc, 0x68, 0xb2, 0xc8, 0xde, 0xb0, 0x3a, 0xb6, 0xe7, 0x07, 0x76,
+	0xe4, 0xf3, 0x20, 0xe6, 0x69, 0x7a, 0x16, 0x9b, 0xa0, 0x1c, 0xee, 0x27, 0xef, 0xe7, 0x3c, 0xee,
+	0x71, 0xf9, 0x68, 0xf5, 0x9e, 0xd0, 0x7a, 0xcd, 0xe3, 0xdc, 0x6b, 0x31, 0xcb, 0xee, 0xf8, 0x96,
+	0x1d, 0x04, 0x3c, 0x92, 0x2e, 0x05, 0xbe, 0x2d, 0x67, 0x34, 0x7a, 0x2c, 0x60, 0xc2, 0xcf, 0x7b,
+	0x93, 0x08, 0x96, 0x6f, 0x8c, 0x75, 0x98, 0xfb, 0xa0, 0xa7, 0xb4, 0x66, 0xb7, 0xec, 0xc0, 0x61,
+	0xa2, 0xce, 0x1e, 0x76, 0x99, 0x88, 0x68, 0x19, 0xfe, 0x67, 0xbb, 0x6e, 0xc8, 0x84, 0x28, 0x93,
+	0x0a, 0xa9, 0xce, 0xd4, 0x93, 0x7f, 0x8d, 0x5f, 0x4a, 0x70, 0xf9, 0x14, 0x45, 0x74, 0x78, 0x20,
+	0x18, 0x75, 0x60, 0xaa, 0xc5, 0x9d, 0x03, 0xe6, 0x96, 0x49, 0x65, 0xb2, 0x3a, 0xbb, 0x79, 0xd5,
+	0x8c, 0x93, 0x34, 0x7b, 0x49, 0x9a, 0x98, 0xa4, 0x79, 0x9f, 0xfb, 0x41, 0x6d, 0xfd, 0xc5, 0xef,
+	0x0b, 0x13, 0xdf, 0xfd, 0xb1, 0x50, 0xf5, 0xfc, 0xe8, 0x41, 0xb7, 0x69, 0x3a, 0xbc, 0x6d, 0x61,
+	0x45, 0xe2, 0x3f, 0x6b, 0xc2, 0x3d, 0xb0, 0xa2, 0x47, 0x1d, 0x26, 0x24, 0x41, 0xd4, 0xd1, 0x35,
+	0xf5, 0x60, 0xba, 0x1b, 0xf4, 0x72, 0x60, 0x6e, 0xb9, 0x34, 0xfe, 0x30, 0xa9, 0xf3, 0x5e, 0x36,
+	0x18, 0x66, 0xf2, 0x15, 0x64, 0x13, 0xbb, 0x36, 0xe6, 0x80, 0xca, 0x5a, 0xee, 0xdb, 0xa1, 0xdd,
+	0x4e, 0x8a, 0x6f, 0xec, 0xc1, 0xa5, 0x01, 0x2b, 0xd6, 0x77, 0x1d, 0xa6, 0x3a, 0xd2, 0x22, 0x8f,
+	0x64, 0x76, 0x93, 0x9a, 0xfd, 0xe6, 0x33, 0x63, 0x6c, 0xed, 0x5c, 0x4f, 0x4a, 0x1d, 0x71, 0xc6,
+	0x01, 0x2c, 0xa2, 0xa3, 0xae, 0x60, 0xee, 0x47, 0x31, 0x7a, 0xc7, 0x71, 0x78, 0x37, 0x88, 0xd2,
+	0xa3, 0xde, 0x05, 0xe8, 0xb7, 0x27, 0xba, 0x5e, 0x1a, 0x48, 0x36, 0x6e, 0xf8, 0x24, 0xe5, 0x7d,
+	0xdb, 0x63, 0xc8, 0xad, 0x67, 0x98, 0xc6, 0xfb, 0x30, 0x97, 0x17, 0xa7, 0xb8, 0x95, 0xe8, 0x3c,
+	0xcc, 0x74, 0x24, 0xa3, 0x61, 0x47, 0xe5, 0x52, 0x85, 0x54, 0x27, 0xeb, 0xd3, 0xb1, 0x61, 0x27,
+	0x32, 0x7e, 0x24, 0x60, 0x9c, 0x25, 0x1e, 0x8b, 0x52, 0x83, 0x69, 0x1b, 0x6d, 0xd8, 0x76, 0x95,
+	0xc1, 0xb2, 0xa8, 0x64, 0x2c, 0x52, 0xca, 0xa3, 0x7b, 0x03, 0x15, 0x28, 0xc9, 0x0a, 0xdc, 0x1a,
+	0x5a, 0x81, 0x58, 0xc0, 0x40, 0x09, 0xee, 0xc2, 0x75, 0x29, 0xf9, 0x43, 0xe7, 0x01, 0x73, 0xbb,
+	0x2d, 0xb6, 0xd3, 0x66, 0x81, 0xdb, 0x66, 0x41, 0x34, 0xfc, 0xb3, 0x72, 0x40, 0x2f, 0xa2, 0x62,
+	0xa6, 0x3b, 0x30, 0x63, 0x27, 0x46, 0x3c, 0xa6, 0xeb, 0xd9, 0x54, 0x15, 0x26, 0xe6, 0xd9, 0x67,
+	0x19, 0xeb, 0x18, 0x64, 0xb7, 0x1b, 0xb8, 0x7e, 0xe0, 0xbd, 0x17, 0x88, 0x28, 0xec, 0x3a, 0x3d,
+	0xe9, 0x89, 0xc0, 0xd7, 0xa0, 0xe4, 0xbb, 0xd2, 0xfb, 0xb9, 0x7a, 0xc9, 0x77, 0x0d, 0x1f, 0x16,
+	0x0a, 0x19, 0xa8, 0x6b, 0x17, 0x66, 0xfd, 0xbe, 0x19, 0x95, 0xe9, 0x59, 0x65, 0x2a, 0x19, 0xa5,
+	0x65, 0x89, 0x67, 0x84, 0x1a, 0x7b, 0xab, 0xfe, 0x44, 0xa0, 0x52, 0x1c, 0x0b, 0xf3, 0x7a, 0x17,
+	0x2e, 0x64, 0xe4, 0x25, 0xdd, 0x35, 0x5a, 0x62, 0x03, 0xcc, 0xf1, 0xf5, 0xd7, 0x32, 0x5c, 0x91,
+	0xb2, 0xdf, 0x11, 0x4e, 0xc8, 0x3f, 0xdd, 0x0b, 0xed, 0x20, 0x2a, 0x3a, 0xb8, 0x9f, 0x09, 0x94,
+	0x55, 0x2c, 0xa6, 0xb6, 0x05, 0xe7, 0xbd, 0x9e, 0x01, 0x4b, 0x78, 0x25, 0x9b, 0x53, 0x06, 0x8f,
+	0xc9, 0xc4, 0x58, 0xea, 0xc3, 0x8c, 0xd3, 0xb2, 0xfd, 0xb6, 0xdd, 0x6c, 0xb1, 0x57, 0x71, 0xf5,
+	0xf6, 0xbd, 0x1b, 0x9f, 0xab, 0xda, 0x87, 0x4f, 0x26, 0xba, 0x9b, 0x53, 0xe6, 0x7f, 0xd3, 0x1d,
+	0xcf, 0x08, 0x5c, 0xcd, 0x09, 0x8f, 0xb5, 0xdb, 0x86, 0x29, 0x59, 0x8f, 0xa4, 0x21, 0x86, 0x14,
+	0x0f, 0xc1, 0xe3, 0xeb, 0x81, 0x3b, 0x30, 0x9f, 0xb6, 0x2e, 0x0b, 0xef, 0xf3, 0x76, 0xdb, 0x8f,
+	0x22, 0xc6, 0x86, 0xdf, 0x30, 0x0d, 0xb8, 0x96, 0x4f, 0xc4, 0xc4, 0xee, 0xc1, 0x8c, 0x93, 0x18,
+	0xb1, 0x31, 0xe6, 0x4f, 0x37, 0x7b, 0x86, 0x97, 0xdc, 0x2e, 0x29, 0xc7, 0xb0, 0xf0, 0xf6, 0x4b,
+	0x21, 0xfb, 0x21, 0xef, 0x70, 0x61, 0xb7, 0x8a, 0x7a, 0xd4, 0x06, 0xbd, 0x88, 0x90, 0x6a, 0x9a,
+	0xee, 0xa0, 0x2d, 0xef, 0xca, 0x53, 0x88, 0xc9, 0xd5, 0x9e, 0x90, 0x8c, 0x27, 0xa4, 0x28, 0xc6,
+	0x7f, 0xd8, 0x50, 0xdf, 0x13, 0x58, 0x28, 0x14, 0xd1, 0xbf, 0xdd, 0x13, 0xd1, 0x49, 0x67, 0x8d,
+	0x94, 0x6a, 0x9f, 0x35, 0xb6, 0x16, 0xdb, 0x7c, 0xfa, 0x7f, 0x38, 0x2f, 0xf5, 0xd2, 0x23, 0x98,
+	0x4e, 0xd6, 0x3c, 0x3a, 0x30, 0x57, 0xf3, 0x96, 0x46, 0x6d, 0xf1, 0x0c, 0x44, 0x1c, 0xc6, 0x58,
+	0x7d, 0xf2, 0xeb, 0x5f, 0x5f, 0x95, 0x96, 0xe8, 0x4d, 0x8b, 0x1d, 0xf6, 0xee, 0x82, 0xcc, 0x62,
+	0xda, 0x44, 0xac, 0xf5, 0x18, 0xeb, 0x7f, 0x44, 0x0f, 0x60, 0x2a, 0xde, 0x6b, 0xa8, 0xae, 0xb8,
+	0x1e, 0x58, 0x99, 0xb4, 0x85, 0xc2, 0xf7, 0x18, 0xb8, 0x22, 0x03, 0x6b, 0xb4, 0xac, 0x06, 0x8e,
+	0x97, 0x25, 0xfa, 0x2d, 0x81, 0xcb, 0xb9, 0xbb, 0x06, 0x5d, 0xcb, 0x71, 0x5e, 0xbc, 0x50, 0x69,
+	0xe6, 0xa8, 0x70, 0x94, 0xb6, 0x2c, 0xa5, 0xdd, 0xa0, 0x8b, 0x79, 0xd2, 0xe2, 0xf5, 0x28, 0x51,
+	0xf2, 0x9c, 0xc0, 0x45, 0x65, 0xce, 0xd3, 0x65, 0x25, 0x60, 0xd1, 0x02, 0xa2, 0xad, 0x8c, 0x02,
+	0x45, 0x5d, 0x6f, 0x49, 0x5d, 0xeb, 0xd4, 0x54, 0x75, 0x09, 0x24, 0x35, 0xd2, 0xdd, 0x22, 0x73,
+	0x6a, 0xcf, 0x09, 0x50, 0x75, 0x32, 0x52, 0x35, 0x74, 0xe1, 0x1a, 0xa2, 0xdd, 0x1e, 0x09, 0x8b,
+	0x3a, 0xb7, 0xa4, 0xce, 0x35, 0x7a, 0x5b, 0xd5, 0xf9, 0x49, 0xcc, 0x6a, 0x64, 0xc7, 0xb1, 0xf5,
+	0xd8, 0x77, 0x8f, 0xe8, 0x37, 0x04, 0x2e, 0xa9, 0x3e, 0x05, 0x1d, 0x25, 0x72, 0x7a, 0xd2, 0xab,
+	0xa3, 0x81, 0x51, 0xa7, 0x29, 0x75, 0x56, 0xe9, 0xd2, 0x68, 0x3a, 0xe9, 0x17, 0x04, 0x66, 0x33,
+	0x03, 0x85, 0xde, 0x50, 0xa2, 0xa9, 0x7b, 0x80, 0x76, 0xf3, 0x6c, 0xd0, 0xf0, 0xcf, 0x90, 0x49,
+	0x78, 0x23, 0x1e, 0x5b, 0x71, 0xad, 0x9e, 0x12, 0xb8, 0x90, 0xf1, 0x22, 0xe8, 0x99, 0x41, 0xd2,
+	0xea, 0xbc, 0x39, 0x04, 0x85, 0x5a, 0xde, 0x96, 0x5a, 0xb6, 0xe9, 0xd6, 0x30, 0x2d, 0x21, 0x73,
+	0xfc, 0x8e, 0x3f, 0xd8, 0x6b, 0xcf, 0x08, 0xbc, 0x7e, 0x6a, 0x30, 0xd1, 0x5b, 0xb9, 0xa7, 0xa2,
+	0xce, 0x4a, 0xad, 0x3a, 0x1c, 0x88, 0x1a, 0xb7, 0xa5, 0x46, 0x8b, 0xae, 0xe5, 0x1f, 0x1d, 0x0b,
+	0x1b, 0xe9, 0x18, 0xcc, 0xde, 0x5f, 0x5f, 0x13, 0xb8, 0xa8, 0x5c, 0xdc, 0x39, 0x9f, 0x6b, 0xd1,
+	0xc4, 0xd4, 0x56, 0x46, 0x81, 0xa2, 0xc6, 0x4d, 0xa9, 0x71, 0x95, 0xae, 0xa8, 0x1a, 0x53, 0x71,
+	0x8d, 0x74, 0x5a, 0xc4, 0x27, 0xfb, 0x03, 0x01, 0xaa, 0x78, 0x14, 0x74, 0x84, 0xb0, 0xa2, 0xf8,
+	0x53, 0x2d, 0x9e, 0x72, 0xc6, 0x3d, 0xa9, 0xf1, 0x2e, 0xbd, 0xf3, 0x8f, 0xea, 0x68, 0xa5, 0xaa,
+	0x6b, 0xb5, 0x17, 0xc7, 0x3a, 0x79, 0x79, 0xac, 0x93, 0x3f, 0x8f, 0x75, 0xf2, 0xe5, 0x89, 0x3e,
+	0xf1, 0xf2, 0x44, 0x9f, 0xf8, 0xed, 0x44, 0x9f, 0xf8, 0x38, 0xbb, 0x68, 0x0e, 0x3a, 0xff, 0x2c,
+	0x7d, 0x92, 0xeb, 0x66, 0x73, 0x4a, 0xfe, 0xf4, 0xb1, 0xf5, 0xf7, 0x00, 0x15, 0x32, 0x31, 0x93,
+	0xd4, 0x11, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Balances retrieves the unvested, vested and locked tokens for a vesting account
+	Balances(ctx context.Context, in *QueryBalancesRequest, opts ...grpc.CallOption) (*QueryBalancesResponse, error)
+	// Params retrieves the x/vesting module parameters
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// PausedVestingAccounts retrieves the clawback vesting accounts whose vesting
+	// is paused
+	PausedVestingAccounts(ctx context.Context, in *QueryPausedVestingAccountsRequest, opts ...grpc.CallOption) (*QueryPausedVestingAccountsResponse, error)
+	// ScheduleAmendment retrieves the pending schedule amendment of a vesting
+	// account
+	ScheduleAmendment(ctx context.Context, in *QueryScheduleAmendmentRequest, opts ...grpc.CallOption) (*QueryScheduleAmendmentResponse, error)
+	// FundingInstruction retrieves a recurring funding instruction by its id
+	FundingInstruction(ctx context.Context, in *QueryFundingInstructionRequest, opts ...grpc.CallOption) (*QueryFundingInstructionResponse, error)
+	// FundingInstructions retrieves all the recurring funding instructions
+	FundingInstructions(ctx context.Context, in *QueryFundingInstructionsRequest, opts ...grpc.CallOption) (*QueryFundingInstructionsResponse, error)
+	// EscrowGrant retrieves an escrow grant and its claimable coins
+	EscrowGrant(ctx context.Context, in *QueryEscrowGrantRequest, opts ...grpc.CallOption) (*QueryEscrowGrantResponse, error)
+	// EscrowGrants retrieves the escrow grants of a recipient
+	EscrowGrants(ctx context.Context, in *QueryEscrowGrantsRequest, opts ...grpc.CallOption) (*QueryEscrowGrantsResponse, error)
+	// FunderCommittee retrieves a funder committee
+	FunderCommittee(ctx context.Context, in *QueryFunderCommitteeRequest, opts ...grpc.CallOption) (*QueryFunderCommitteeResponse, error)
+	// CommitteeProposal retrieves a funder committee proposal
+	CommitteeProposal(ctx context.Context, in *QueryCommitteeProposalRequest, opts ...grpc.CallOption) (*QueryCommitteeProposalResponse, error)
+	// CommitteeProposals retrieves the pending proposals of a funder committee
+	CommitteeProposals(ctx context.Context, in *QueryCommitteeProposalsRequest, opts ...grpc.CallOption) (*QueryCommitteeProposalsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Balances(ctx context.Context, in *QueryBalancesRequest, opts ...grpc.CallOption) (*QueryBalancesResponse, error) {
+	out := new(QueryBalancesResponse)
+	err := c.cc.Invoke(ctx, "/vesting.v1.Query/Balances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/vesting.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PausedVestingAccounts(ctx context.Context, in *QueryPausedVestingAccountsRequest, opts ...grpc.CallOption) (*QueryPausedVestingAccountsResponse, error) {
+	out := new(QueryPausedVestingAccountsResponse)
+	err := c.cc.Invoke(ctx, "/vesting.v1.Query/PausedVestingAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScheduleAmendment(ctx context.Context, in *QueryScheduleAmendmentRequest, opts ...grpc.CallOption) (*QueryScheduleAmendmentResponse, error) {
+	out := new(QueryScheduleAmendmentResponse)
+	err := c.cc.Invoke(ctx, "/vesting.v1.Query/ScheduleAmendment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FundingInstruction(ctx context.Context, in *QueryFundingInstructionRequest, opts ...grpc.CallOption) (*QueryFundingInstructionResponse, error) {
+	out := new(QueryFundingInstructionResponse)
+	err := c.cc.Invoke(ctx, "/vesting.v1.Query/FundingInstruction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FundingInstructions(ctx context.Context, in *QueryFundingInstructionsRequest, opts ...grpc.CallOption) (*QueryFundingInstructionsResponse, error) {
+	out := new(QueryFundingInstructionsResponse)
+	err := c.cc.Invoke(ctx, "/vesting.v1.Query/FundingInstructions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EscrowGrant(ctx context.Context, in *QueryEscrowGrantRequest, opts ...grpc.CallOption) (*QueryEscrowGrantResponse, error) {
+	out := new(QueryEscrowGrantResponse)
+	err := c.cc.Invoke(ctx, "/vesting.v1.Query/EscrowGrant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EscrowGrants(ctx context.Context, in *QueryEscrowGrantsRequest, opts ...grpc.CallOption) (*QueryEscrowGrantsResponse, error) {
+	out := new(QueryEscrowGrantsResponse)
+	err := c.cc.Invoke(ctx, "/vesting.v1.Query/EscrowGrants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FunderCommittee(ctx context.Context, in *QueryFunderCommitteeRequest, opts ...grpc.CallOption) (*QueryFunderCommitteeResponse, error) {
+	out := new(QueryFunderCommitteeResponse)
+	err := c.cc.Invoke(ctx, "/vesting.v1.Query/FunderCommittee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CommitteeProposal(ctx context.Context, in *QueryCommitteeProposalRequest, opts ...grpc.CallOption) (*QueryCommitteeProposalResponse, error) {
+	out := new(QueryCommitteeProposalResponse)
+	err := c.cc.Invoke(ctx, "/vesting.v1.Query/CommitteeProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CommitteeProposals(ctx context.Context, in *QueryCommitteeProposalsRequest, opts ...grpc.CallOption) (*QueryCommitteeProposalsResponse, error) {
+	out := new(QueryCommitteeProposalsResponse)
+	err := c.cc.Invoke(ctx, "/vesting.v1.Query/CommitteeProposals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Balances retrieves the unvested, vested and locked tokens for a vesting account
+	Balances(context.Context, *QueryBalancesRequest) (*QueryBalancesResponse, error)
+	// Params retrieves the x/vesting module parameters
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// PausedVestingAccounts retrieves the clawback vesting accounts whose vesting
+	// is paused
+	PausedVestingAccounts(context.Context, *QueryPausedVestingAccountsRequest) (*QueryPausedVestingAccountsResponse, error)
+	// ScheduleAmendment retrieves the pending schedule amendment of a vesting
+	// account
+	ScheduleAmendment(context.Context, *QueryScheduleAmendmentRequest) (*QueryScheduleAmendmentResponse, error)
+	// FundingInstruction retrieves a recurring funding instruction by its id
+	FundingInstruction(context.Context, *QueryFundingInstructionRequest) (*QueryFundingInstructionResponse, error)
+	// FundingInstructions retrieves all the recurring funding instructions
+	FundingInstructions(context.Context, *QueryFundingInstructionsRequest) (*QueryFundingInstructionsResponse, error)
+	// EscrowGrant retrieves an escrow grant and its claimable coins
+	EscrowGrant(context.Context, *QueryEscrowGrantRequest) (*QueryEscrowGrantResponse, error)
+	// EscrowGrants retrieves the escrow grants of a recipient
+	EscrowGrants(context.Context, *QueryEscrowGrantsRequest) (*QueryEscrowGrantsResponse, error)
+	// FunderCommittee retrieves a funder committee
+	FunderCommittee(context.Context, *QueryFunderCommitteeRequest) (*QueryFunderCommitteeResponse, error)
+	// CommitteeProposal retrieves a funder committee proposal
+	CommitteeProposal(context.Context, *QueryCommitteeProposalRequest) (*QueryCommitteeProposalResponse, error)
+	// CommitteeProposals retrieves the pending proposals of a funder committee
+	CommitteeProposals(context.Context, *QueryCommitteeProposalsRequest) (*QueryCommitteeProposalsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Balances(ctx context.Context, req *QueryBalancesRequest) (*QueryBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Balances not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) PausedVestingAccounts(ctx context.Context, req *QueryPausedVestingAccountsRequest) (*QueryPausedVestingAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PausedVestingAccounts not implemented")
+}
+func (*UnimplementedQueryServer) ScheduleAmendment(ctx context.Context, req *QueryScheduleAmendmentRequest) (*QueryScheduleAmendmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleAmendment not implemented")
+}
+func (*UnimplementedQueryServer) FundingInstruction(ctx context.Context, req *QueryFundingInstructionRequest) (*QueryFundingInstructionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundingInstruction not implemented")
+}
+func (*UnimplementedQueryServer) FundingInstructions(ctx context.Context, req *QueryFundingInstructionsRequest) (*QueryFundingInstructionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundingInstructions not implemented")
+}
+func (*UnimplementedQueryServer) EscrowGrant(ctx context.Context, req *QueryEscrowGrantRequest) (*QueryEscrowGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EscrowGrant not implemented")
+}
+func (*UnimplementedQueryServer) EscrowGrants(ctx context.Context, req *QueryEscrowGrantsRequest) (*QueryEscrowGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EscrowGrants not implemented")
+}
+func (*UnimplementedQueryServer) FunderCommittee(ctx context.Context, req *QueryFunderCommitteeRequest) (*QueryFunderCommitteeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FunderCommittee not implemented")
+}
+func (*UnimplementedQueryServer) CommitteeProposal(ctx context.Context, req *QueryCommitteeProposalRequest) (*QueryCommitteeProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitteeProposal not implemented")
+}
+func (*UnimplementedQueryServer) CommitteeProposals(ctx context.Context, req *QueryCommitteeProposalsRequest) (*QueryCommitteeProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitteeProposals not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Balances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Balances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vesting.v1.Query/Balances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Balances(ctx, req.(*QueryBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vesting.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PausedVestingAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPausedVestingAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PausedVestingAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vesting.v1.Query/PausedVestingAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PausedVestingAccounts(ctx, req.(*QueryPausedVestingAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduleAmendment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduleAmendmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduleAmendment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vesting.v1.Query/ScheduleAmendment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduleAmendment(ctx, req.(*QueryScheduleAmendmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FundingInstruction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFundingInstructionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FundingInstruction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vesting.v1.Query/FundingInstruction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FundingInstruction(ctx, req.(*QueryFundingInstructionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FundingInstructions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFundingInstructionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FundingInstructions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vesting.v1.Query/FundingInstructions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FundingInstructions(ctx, req.(*QueryFundingInstructionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EscrowGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEscrowGrantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EscrowGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vesting.v1.Query/EscrowGrant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EscrowGrant(ctx, req.(*QueryEscrowGrantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EscrowGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEscrowGrantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EscrowGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vesting.v1.Query/EscrowGrants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EscrowGrants(ctx, req.(*QueryEscrowGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FunderCommittee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFunderCommitteeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FunderCommittee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vesting.v1.Query/FunderCommittee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FunderCommittee(ctx, req.(*QueryFunderCommitteeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CommitteeProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCommitteeProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CommitteeProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vesting.v1.Query/CommitteeProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CommitteeProposal(ctx, req.(*QueryCommitteeProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CommitteeProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCommitteeProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CommitteeProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vesting.v1.Query/CommitteeProposals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CommitteeProposals(ctx, req.(*QueryCommitteeProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "vesting.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Balances",
+			Handler:    _Query_Balances_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "PausedVestingAccounts",
+			Handler:    _Query_PausedVestingAccounts_Handler,
+		},
+		{
+			MethodName: "ScheduleAmendment",
+			Handler:    _Query_ScheduleAmendment_Handler,
+		},
+		{
+			MethodName: "FundingInstruction",
+			Handler:    _Query_FundingInstruction_Handler,
+		},
+		{
+			MethodName: "FundingInstructions",
+			Handler:    _Query_FundingInstructions_Handler,
+		},
+		{
+			MethodName: "EscrowGrant",
+			Handler:    _Query_EscrowGrant_Handler,
+		},
+		{
+			MethodName: "EscrowGrants",
+			Handler:    _Query_EscrowGrants_Handler,
+		},
+		{
+			MethodName: "FunderCommittee",
+			Handler:    _Query_FunderCommittee_Handler,
+		},
+		{
+			MethodName: "CommitteeProposal",
+			Handler:    _Query_CommitteeProposal_Handler,
+		},
+		{
+			MethodName: "CommitteeProposals",
+			Handler:    _Query_CommitteeProposals_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "vesting/v1/query.proto",
+}
+
+func (m *QueryBalancesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBalancesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBalancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBalancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBalancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBalancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Vested) > 0 {
+		for iNdEx := len(m.Vested) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vested[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Unvested) > 0 {
+		for iNdEx := len(m.Unvested) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unvested[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Locked) > 0 {
+		for iNdEx := len(m.Locked) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locked[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPausedVestingAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPausedVestingAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPausedVestingAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PausedVestingAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PausedVestingAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PausedVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PausedAt != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PausedAt))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPausedVestingAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPausedVestingAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPausedVestingAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduleAmendmentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduleAmendmentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduleAmendmentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduleAmendmentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduleAmendmentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduleAmendmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amendment.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFundingInstructionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFundingInstructionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFundingInstructionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFundingInstructionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFundingInstructionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFundingInstructionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Instruction.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFundingInstructionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFundingInstructionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFundingInstructionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFundingInstructionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFundingInstructionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFundingInstructionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Instructions) > 0 {
+		for iNdEx := len(m.Instructions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Instructions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEscrowGrantRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEscrowGrantRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEscrowGrantRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEscrowGrantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEscrowGrantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEscrowGrantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Claimable) > 0 {
+		for iNdEx := len(m.Claimable) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claimable[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Grant.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEscrowGrantsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEscrowGrantsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEscrowGrantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEscrowGrantsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEscrowGrantsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEscrowGrantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Grants) > 0 {
+		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFunderCommitteeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFunderCommitteeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFunderCommitteeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFunderCommitteeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFunderCommitteeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFunderCommitteeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Committee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCommitteeProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommitteeProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommitteeProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCommitteeProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommitteeProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommitteeProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCommitteeProposalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommitteeProposalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommitteeProposalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCommitteeProposalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommitteeProposalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommitteeProposalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryBalancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBalancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Locked) > 0 {
+		for _, e := range m.Locked {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Unvested) > 0 {
+		for _, e := range m.Unvested {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Vested) > 0 {
+		for _, e := range m.Vested {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPausedVestingAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PausedVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PausedAt != 0 {
+		n += 1 + sovQuery(uint64(m.PausedAt))
+	}
+	return n
+}
+
+func (m *QueryPausedVestingAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScheduleAmendmentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScheduleAmendmentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amendment.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFundingInstructionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryFundingInstructionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Instruction.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFundingInstructionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFundingInstructionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Instructions) > 0 {
+		for _, e := range m.Instructions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEscrowGrantRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryEscrowGrantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Grant.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Claimable) > 0 {
+		for _, e := range m.Claimable {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEscrowGrantsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEscrowGrantsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFunderCommitteeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFunderCommitteeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Committee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCommitteeProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryCommitteeProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Proposal.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCommitteeProposalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCommitteeProposalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryBalancesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBalancesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBalancesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBalancesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locked = append(m.Locked, types.Coin{})
+			if err := m.Locked[len(m.Locked)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unvested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unvested = append(m.Unvested, types.Coin{})
+			if err := m.Unvested[len(m.Unvested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vested = append(m.Vested, types.Coin{})
+			if err := m.Vested[len(m.Vested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPausedVestingAccountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPausedVestingAccountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPausedVestingAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PausedVestingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PausedVestingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PausedVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedAt", wireType)
+			}
+			m.PausedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PausedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPausedVestingAccountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPausedVestingAccountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPausedVestingAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, PausedVestingAccount{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduleAmendmentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduleAmendmentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduleAmendmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduleAmendmentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduleAmendmentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduleAmendmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amendment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amendment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFundingInstructionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFundingInstructionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFundingInstructionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFundingInstructionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFundingInstructionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFundingInstructionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Instruction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Instruction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFundingInstructionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFundingInstructionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFundingInstructionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFundingInstructionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFundingInstructionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFundingInstructionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Instructions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Instructions = append(m.Instructions, FundingInstruction{})
+			if err := m.Instructions[len(m.Instructions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEscrowGrantRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEscrowGrantRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEscrowGrantRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEscrowGrantResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEscrowGrantResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEscrowGrantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grant", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Grant.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimable = append(m.Claimable, types.Coin{})
+			if err := m.Claimable[len(m.Claimable)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEscrowGrantsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEscrowGrantsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEscrowGrantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEscrowGrantsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEscrowGrantsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEscrowGrantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grants = append(m.Grants, EscrowGrant{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFunderCommitteeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFunderCommitteeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFunderCommitteeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFunderCommitteeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFunderCommitteeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFunderCommitteeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Committee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Committee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Unvested) > 0 {
-		for iNdEx := len(m.Unvested) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Unvested[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
 			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Locked) > 0 {
-		for iNdEx := len(m.Locked) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Locked[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			i--
-			dAtA[i] = 0xa
+			iNdEx += skippy
 		}
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryBalancesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryBalancesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Locked) > 0 {
-		for _, e := range m.Locked {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryCommitteeProposalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
-	}
-	if len(m.Unvested) > 0 {
-		for _, e := range m.Unvested {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCommitteeProposalRequest: wiretype end group for non-group")
 		}
-	}
-	if len(m.Vested) > 0 {
-		for _, e := range m.Vested {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCommitteeProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryBalancesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCommitteeProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -434,17 +4855,17 @@ func (m *QueryBalancesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBalancesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCommitteeProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCommitteeProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -454,23 +4875,24 @@ func (m *QueryBalancesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -493,7 +4915,7 @@ func (m *QueryBalancesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBalancesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCommitteeProposalsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -516,15 +4938,47 @@ func (m *QueryBalancesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBalancesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCommitteeProposalsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCommitteeProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -551,14 +5005,66 @@ func (m *QueryBalancesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Locked = append(m.Locked, types.Coin{})
-			if err := m.Locked[len(m.Locked)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCommitteeProposalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCommitteeProposalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCommitteeProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unvested", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -585,14 +5091,14 @@ func (m *QueryBalancesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Unvested = append(m.Unvested, types.Coin{})
-			if err := m.Unvested[len(m.Unvested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Proposals = append(m.Proposals, CommitteeProposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vested", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -619,8 +5125,10 @@ func (m *QueryBalancesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Vested = append(m.Vested, types.Coin{})
-			if err := m.Vested[len(m.Vested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
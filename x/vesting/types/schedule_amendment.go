@@ -0,0 +1,122 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// NewScheduleAmendment returns a new ScheduleAmendment
+func NewScheduleAmendment(
+	funder, vesting sdk.AccAddress,
+	lockupPeriods, vestingPeriods sdkvesting.Periods,
+) ScheduleAmendment {
+	return ScheduleAmendment{
+		FunderAddress:  funder.String(),
+		VestingAddress: vesting.String(),
+		LockupPeriods:  lockupPeriods,
+		VestingPeriods: vestingPeriods,
+	}
+}
+
+// ValidateAmendmentPeriods checks that the periods of a schedule amendment have
+// a positive length and valid amounts, and that at least one schedule is
+// amended.
+func ValidateAmendmentPeriods(lockupPeriods, vestingPeriods sdkvesting.Periods) error {
+	if len(lockupPeriods) == 0 && len(vestingPeriods) == 0 {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "vesting and/or lockup periods must be present")
+	}
+
+	for _, periods := range []sdkvesting.Periods{lockupPeriods, vestingPeriods} {
+		for i, period := range periods {
+			if period.Length < 1 {
+				return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid period length of %d in period %d, length must be greater than 0", period.Length, i)
+			}
+			if !period.Amount.IsValid() || period.Amount.IsZero() {
+				return errortypes.ErrInvalidCoins.Wrap(period.Amount.String())
+			}
+		}
+	}
+
+	return nil
+}
+
+// AmendSchedule replaces the future lockup and vesting periods of the account
+// with the given periods, which are relative to amendTime. The passed periods
+// and the original vesting are kept. The new lockup periods must cover the
+// coins that are still locked up at amendTime, and the new vesting periods
+// the coins that are still unvested. Empty periods keep the corresponding
+// schedule unchanged.
+func (va *ClawbackVestingAccount) AmendSchedule(
+	amendTime int64,
+	lockupPeriods, vestingPeriods sdkvesting.Periods,
+) error {
+	newLockupPeriods := va.LockupPeriods
+	if len(lockupPeriods) > 0 {
+		var err error
+		newLockupPeriods, err = amendPeriods(va.GetStartTime(), va.EndTime, va.LockupPeriods, amendTime, lockupPeriods)
+		if err != nil {
+			return errorsmod.Wrap(err, "invalid lockup periods")
+		}
+	}
+
+	newVestingPeriods := va.VestingPeriods
+	if len(vestingPeriods) > 0 {
+		var err error
+		newVestingPeriods, err = amendPeriods(va.GetStartTime(), va.EndTime, va.VestingPeriods, amendTime, vestingPeriods)
+		if err != nil {
+			return errorsmod.Wrap(err, "invalid vesting periods")
+		}
+	}
+
+	va.LockupPeriods = newLockupPeriods
+	va.VestingPeriods = newVestingPeriods
+	va.EndTime = Max64(
+		va.GetStartTime()+newLockupPeriods.TotalLength(),
+		va.GetStartTime()+newVestingPeriods.TotalLength(),
+	)
+	return nil
+}
+
+// amendPeriods returns the passed periods at amendTime followed by the given
+// future periods, which are relative to amendTime (or to the start time if
+// amendTime is before it). The future periods must describe the same total
+// amount as the remaining periods they replace.
+func amendPeriods(
+	startTime, endTime int64,
+	periods sdkvesting.Periods,
+	amendTime int64,
+	futurePeriods sdkvesting.Periods,
+) (sdkvesting.Periods, error) {
+	passed := ReadPastPeriodCount(startTime, endTime, periods, amendTime)
+
+	remaining := sdk.NewCoins()
+	for _, period := range periods[passed:] {
+		remaining = remaining.Add(period.Amount...)
+	}
+	if remaining.IsZero() {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "schedule has already ended")
+	}
+
+	future := sdk.NewCoins()
+	for _, period := range futurePeriods {
+		future = future.Add(period.Amount...)
+	}
+	if !CoinEq(remaining, future) {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "total amount %s must equal the remaining amount %s", future, remaining)
+	}
+
+	// copy the periods to avoid mutating the slice of the previous account state
+	amended := make(sdkvesting.Periods, 0, passed+len(futurePeriods))
+	amended = append(amended, periods[:passed]...)
+	amended = append(amended, futurePeriods...)
+
+	// the first future period starts at the amendment time
+	passedEnd := startTime + periods[:passed].TotalLength()
+	amended[passed].Length += Max64(amendTime, passedEnd) - passedEnd
+	return amended, nil
+}
@@ -0,0 +1,189 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	tmtime "github.com/cometbft/cometbft/types/time"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/evmos/vesting/testutil"
+	"github.com/evmos/vesting/x/vesting/types"
+	"github.com/stretchr/testify/suite"
+)
+
+type ScheduleAmendmentTestSuite struct {
+	suite.Suite
+}
+
+func TestScheduleAmendmentTestSuite(t *testing.T) {
+	suite.Run(t, new(ScheduleAmendmentTestSuite))
+}
+
+func (suite *ScheduleAmendmentTestSuite) TestValidateAmendmentPeriods() {
+	coins := sdk.NewCoins(sdk.NewInt64Coin(testutil.FeeDenom, 100))
+
+	testCases := []struct {
+		name           string
+		lockupPeriods  sdkvesting.Periods
+		vestingPeriods sdkvesting.Periods
+		expPass        bool
+	}{
+		{
+			"pass - vesting periods",
+			nil,
+			sdkvesting.Periods{{Length: 10, Amount: coins}},
+			true,
+		},
+		{
+			"pass - lockup and vesting periods",
+			sdkvesting.Periods{{Length: 10, Amount: coins}},
+			sdkvesting.Periods{{Length: 5, Amount: coins}, {Length: 5, Amount: coins}},
+			true,
+		},
+		{
+			"fail - no periods",
+			nil,
+			nil,
+			false,
+		},
+		{
+			"fail - zero length",
+			sdkvesting.Periods{{Length: 0, Amount: coins}},
+			nil,
+			false,
+		},
+		{
+			"fail - zero amount",
+			nil,
+			sdkvesting.Periods{{Length: 10, Amount: sdk.NewCoins()}},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := types.ValidateAmendmentPeriods(tc.lockupPeriods, tc.vestingPeriods)
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *ScheduleAmendmentTestSuite) TestAmendSchedule() {
+	fee := func(x int64) sdk.Coin { return sdk.NewInt64Coin(testutil.FeeDenom, x) }
+	hours := func(x int64) int64 { return x * 3600 }
+	vestingStart := tmtime.Now()
+	lockupPeriods := sdkvesting.Periods{
+		{Length: hours(12), Amount: sdk.NewCoins(fee(400))},
+	}
+	vestingPeriods := sdkvesting.Periods{
+		{Length: hours(6), Amount: sdk.NewCoins(fee(100))},
+		{Length: hours(6), Amount: sdk.NewCoins(fee(100))},
+		{Length: hours(6), Amount: sdk.NewCoins(fee(100))},
+		{Length: hours(6), Amount: sdk.NewCoins(fee(100))},
+	}
+
+	testCases := []struct {
+		name              string
+		amendTime         time.Time
+		lockupPeriods     sdkvesting.Periods
+		vestingPeriods    sdkvesting.Periods
+		expPass           bool
+		expLockupPeriods  sdkvesting.Periods
+		expVestingPeriods sdkvesting.Periods
+		expEndTime        int64
+	}{
+		{
+			"pass - stretch the remaining vesting",
+			vestingStart.Add(13 * time.Hour),
+			nil,
+			sdkvesting.Periods{
+				{Length: hours(10), Amount: sdk.NewCoins(fee(100))},
+				{Length: hours(10), Amount: sdk.NewCoins(fee(50))},
+				{Length: hours(10), Amount: sdk.NewCoins(fee(50))},
+			},
+			true,
+			lockupPeriods,
+			sdkvesting.Periods{
+				{Length: hours(6), Amount: sdk.NewCoins(fee(100))},
+				{Length: hours(6), Amount: sdk.NewCoins(fee(100))},
+				{Length: hours(11), Amount: sdk.NewCoins(fee(100))},
+				{Length: hours(10), Amount: sdk.NewCoins(fee(50))},
+				{Length: hours(10), Amount: sdk.NewCoins(fee(50))},
+			},
+			vestingStart.Add(43 * time.Hour).Unix(),
+		},
+		{
+			"pass - amend the lockup before it ends",
+			vestingStart.Add(5 * time.Hour),
+			sdkvesting.Periods{{Length: hours(30), Amount: sdk.NewCoins(fee(400))}},
+			nil,
+			true,
+			sdkvesting.Periods{{Length: hours(35), Amount: sdk.NewCoins(fee(400))}},
+			vestingPeriods,
+			vestingStart.Add(35 * time.Hour).Unix(),
+		},
+		{
+			"pass - amend before the start time",
+			vestingStart.Add(-time.Hour),
+			sdkvesting.Periods{{Length: hours(24), Amount: sdk.NewCoins(fee(400))}},
+			sdkvesting.Periods{{Length: hours(24), Amount: sdk.NewCoins(fee(400))}},
+			true,
+			sdkvesting.Periods{{Length: hours(24), Amount: sdk.NewCoins(fee(400))}},
+			sdkvesting.Periods{{Length: hours(24), Amount: sdk.NewCoins(fee(400))}},
+			vestingStart.Add(24 * time.Hour).Unix(),
+		},
+		{
+			"fail - amount different from the unvested coins",
+			vestingStart.Add(13 * time.Hour),
+			nil,
+			sdkvesting.Periods{{Length: hours(10), Amount: sdk.NewCoins(fee(300))}},
+			false,
+			nil,
+			nil,
+			0,
+		},
+		{
+			"fail - lockup already ended",
+			vestingStart.Add(13 * time.Hour),
+			sdkvesting.Periods{{Length: hours(10), Amount: sdk.NewCoins(fee(400))}},
+			nil,
+			false,
+			nil,
+			nil,
+			0,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			addr := sdk.AccAddress("test_address")
+			bacc := authtypes.NewBaseAccountWithAddress(addr)
+			va := types.NewClawbackVestingAccount(bacc, sdk.AccAddress("funder"), sdk.NewCoins(fee(400)), vestingStart, lockupPeriods, vestingPeriods)
+			vestedBefore := va.GetVestedCoins(tc.amendTime)
+
+			err := va.AmendSchedule(tc.amendTime.Unix(), tc.lockupPeriods, tc.vestingPeriods)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expLockupPeriods, va.LockupPeriods)
+			suite.Require().Equal(tc.expVestingPeriods, va.VestingPeriods)
+			suite.Require().Equal(tc.expEndTime, va.EndTime)
+			suite.Require().Equal(sdk.NewCoins(fee(400)), va.OriginalVesting)
+			// the coins vested before the amendment are kept
+			suite.Require().Equal(vestedBefore, va.GetVestedCoins(tc.amendTime))
+			suite.Require().NoError(va.Validate())
+		})
+	}
+
+	// the periods of the previous account state are not mutated
+	suite.Require().Equal(hours(6), vestingPeriods[2].Length)
+}
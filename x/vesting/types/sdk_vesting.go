@@ -0,0 +1,133 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// ContinuousVestingPeriodLength is the length (in seconds) of the vesting periods
+// used to approximate the linear schedule of a ContinuousVestingAccount.
+const ContinuousVestingPeriodLength int64 = 24 * 60 * 60 // 1 day
+
+// IsSDKVestingAccount returns true if the given account is one of the vesting
+// account types defined in the x/auth/vesting module of the Cosmos SDK.
+func IsSDKVestingAccount(acc authtypes.AccountI) bool {
+	switch acc.(type) {
+	case *sdkvesting.ContinuousVestingAccount,
+		*sdkvesting.DelayedVestingAccount,
+		*sdkvesting.PeriodicVestingAccount:
+		return true
+	default:
+		return false
+	}
+}
+
+// ConvertSDKVestingAccount returns a ClawbackVestingAccount with a schedule
+// equivalent to the one of the given x/auth/vesting account:
+//   - continuous vesting accounts vest the remaining coins in
+//     ContinuousVestingPeriodLength steps counted from the original start time,
+//     rounded up so that the account is never less vested than the original
+//     schedule (see continuousVestingPeriods)
+//   - delayed vesting accounts vest all coins in a single period ending at the
+//     original end time
+//   - periodic vesting accounts keep their start time and vesting periods
+//
+// The coins are unlocked from the start, so that, like in the original account,
+// only the unvested coins are not spendable. The delegated vesting coins are
+// capped at the unvested amount of the new schedule and the remaining delegated
+// coins are tracked as delegated free coins.
+func ConvertSDKVestingAccount(
+	acc vestexported.VestingAccount,
+	funder sdk.AccAddress,
+	blockTime time.Time,
+) (*ClawbackVestingAccount, error) {
+	var (
+		bva            *sdkvesting.BaseVestingAccount
+		startTime      int64
+		vestingPeriods sdkvesting.Periods
+	)
+
+	switch va := acc.(type) {
+	case *sdkvesting.ContinuousVestingAccount:
+		bva = va.BaseVestingAccount
+		startTime = va.StartTime
+		vestingPeriods = continuousVestingPeriods(va, blockTime.Unix())
+	case *sdkvesting.DelayedVestingAccount:
+		bva = va.BaseVestingAccount
+		// delayed vesting accounts don't have a start time, so the schedule starts
+		// at the time of the conversion
+		startTime = Min64(blockTime.Unix(), va.EndTime)
+		vestingPeriods = sdkvesting.Periods{
+			{Length: va.EndTime - startTime, Amount: va.OriginalVesting},
+		}
+	case *sdkvesting.PeriodicVestingAccount:
+		bva = va.BaseVestingAccount
+		startTime = va.StartTime
+		vestingPeriods = va.VestingPeriods
+	default:
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidType,
+			"cannot convert account of type %T into a clawback vesting account", acc,
+		)
+	}
+
+	lockupPeriods := sdkvesting.Periods{
+		{Length: 0, Amount: bva.OriginalVesting},
+	}
+
+	va := NewClawbackVestingAccount(
+		bva.BaseAccount,
+		funder,
+		bva.OriginalVesting,
+		time.Unix(startTime, 0).UTC(),
+		lockupPeriods,
+		vestingPeriods,
+	)
+
+	// cap DV at the current unvested amount, DF rounds out to current delegated
+	delegated := bva.DelegatedFree.Add(bva.DelegatedVesting...)
+	va.DelegatedVesting = delegated.Min(va.GetVestingCoins(blockTime))
+	va.DelegatedFree = delegated.Sub(va.DelegatedVesting...)
+
+	return va, nil
+}
+
+// continuousVestingPeriods discretizes the linear schedule of a continuous
+// vesting account into ContinuousVestingPeriodLength steps counted from its start
+// time. Each step vests the coins that the linear schedule vests by the end of
+// the step, so the converted account vests up to one step ahead of the original
+// schedule but never behind it. The first period ends at readTime and contains
+// the coins vested by the end of the ongoing step, so that the conversion never
+// locks coins that were already vested.
+func continuousVestingPeriods(va *sdkvesting.ContinuousVestingAccount, readTime int64) sdkvesting.Periods {
+	periods := sdkvesting.Periods{}
+	elapsedTime := va.StartTime
+	vested := sdk.NewCoins()
+
+	// NOTE: the first period is empty when the conversion happens before the
+	// start time
+	nextTime := Min64(Max64(readTime, va.StartTime), va.EndTime)
+	for {
+		// vest the coins of the ongoing step at its beginning
+		stepEnd := va.StartTime + ((nextTime-va.StartTime)/ContinuousVestingPeriodLength+1)*ContinuousVestingPeriodLength
+		stepEnd = Min64(stepEnd, va.EndTime)
+
+		total := va.GetVestedCoins(time.Unix(stepEnd, 0))
+		periods = append(periods, sdkvesting.Period{Length: nextTime - elapsedTime, Amount: total.Sub(vested...)})
+		vested = total
+		elapsedTime = nextTime
+
+		if stepEnd >= va.EndTime {
+			return periods
+		}
+		nextTime = stepEnd
+	}
+}
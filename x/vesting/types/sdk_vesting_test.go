@@ -0,0 +1,141 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/evmos/vesting/x/vesting/types"
+)
+
+type SDKVestingTestSuite struct {
+	suite.Suite
+}
+
+func TestSDKVestingTestSuite(t *testing.T) {
+	suite.Run(t, new(SDKVestingTestSuite))
+}
+
+func (suite *SDKVestingTestSuite) TestConvertSDKVestingAccount() {
+	var (
+		addr        = sdk.AccAddress("test_address")
+		funder      = sdk.AccAddress("the funder")
+		coins       = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+		start       = time.Unix(1_000_000, 0)
+		end         = start.Add(10 * 24 * time.Hour)
+		now         = start.Add(36*time.Hour + 500*time.Millisecond)
+		periods     = sdkvesting.Periods{{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 400))}, {Length: 200, Amount: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 600))}}
+		delegated   = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+		newBaseAcc  = func() *authtypes.BaseAccount { return authtypes.NewBaseAccountWithAddress(addr) }
+		continuous  = sdkvesting.NewContinuousVestingAccount(newBaseAcc(), coins, start.Unix(), end.Unix())
+		delayed     = sdkvesting.NewDelayedVestingAccount(newBaseAcc(), coins, end.Unix())
+		periodic    = sdkvesting.NewPeriodicVestingAccount(newBaseAcc(), coins, start.Unix(), periods)
+		withDelFree = sdkvesting.NewPeriodicVestingAccount(newBaseAcc(), coins, start.Unix(), periods)
+		withDelVest = sdkvesting.NewContinuousVestingAccount(newBaseAcc(), coins, start.Unix(), end.Unix())
+	)
+	withDelFree.DelegatedFree = delegated
+	withDelFree.DelegatedVesting = delegated
+	withDelVest.DelegatedVesting = delegated
+
+	testCases := []struct {
+		name         string
+		acc          vestexported.VestingAccount
+		expStart     time.Time
+		expEnd       int64
+		expPeriods   int
+		expDelFree   sdk.Coins
+		expDelVest   sdk.Coins
+		checkVesting bool
+	}{
+		{
+			name:         "continuous vesting account",
+			acc:          continuous,
+			expStart:     start,
+			expEnd:       end.Unix() - types.ContinuousVestingPeriodLength,
+			expPeriods:   9, // first 2 days vested at now + 7 daily steps, rounded up
+			checkVesting: true,
+		},
+		{
+			name:         "continuous vesting account with delegated vesting coins",
+			acc:          withDelVest,
+			expStart:     start,
+			expEnd:       end.Unix() - types.ContinuousVestingPeriodLength,
+			expPeriods:   9,
+			expDelFree:   sdk.NewCoins(),
+			expDelVest:   delegated,
+			checkVesting: true,
+		},
+		{
+			name:       "delayed vesting account",
+			acc:        delayed,
+			expStart:   now,
+			expEnd:     end.Unix(),
+			expPeriods: 1,
+		},
+		{
+			name:         "periodic vesting account",
+			acc:          periodic,
+			expStart:     start,
+			expEnd:       start.Unix() + 300,
+			expPeriods:   2,
+			checkVesting: true,
+		},
+		{
+			name:       "periodic vesting account with delegations",
+			acc:        withDelFree,
+			expStart:   start,
+			expEnd:     start.Unix() + 300,
+			expPeriods: 2,
+			expDelFree: delegated.Add(delegated...),
+			expDelVest: sdk.NewCoins(),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			va, err := types.ConvertSDKVestingAccount(tc.acc, funder, now)
+			suite.Require().NoError(err)
+
+			suite.Require().Equal(addr, va.GetAddress())
+			suite.Require().Equal(funder.String(), va.FunderAddress)
+			suite.Require().Equal(tc.expStart.Unix(), va.GetStartTime())
+			suite.Require().Equal(tc.expEnd, va.GetEndTime())
+			suite.Require().Len(va.VestingPeriods, tc.expPeriods)
+			suite.Require().Equal(coins, va.OriginalVesting)
+			suite.Require().Equal(coins, va.VestingPeriods.TotalAmount())
+			suite.Require().Equal(coins, va.LockupPeriods.TotalAmount())
+			suite.Require().NoError(va.Validate())
+
+			if tc.expDelFree != nil {
+				suite.Require().Equal(tc.expDelFree.String(), va.DelegatedFree.String())
+				suite.Require().Equal(tc.expDelVest.String(), va.DelegatedVesting.String())
+			}
+
+			// only unvested coins are locked and no vested coin is locked again
+			suite.Require().True(va.LockedCoins(now).IsAllLTE(tc.acc.GetVestingCoins(now)))
+
+			if tc.checkVesting {
+				// vested coins never fall behind the original schedule
+				for _, t := range []time.Time{now, now.Add(12 * time.Hour), now.Add(30 * time.Hour), end.Add(-time.Second), end} {
+					suite.Require().True(
+						tc.acc.GetVestedCoins(t).IsAllLTE(va.GetVestedCoins(t)),
+						"vested coins should never fall behind the original schedule",
+					)
+				}
+			}
+		})
+	}
+
+	suite.Run("base account is not supported", func() {
+		_, err := types.ConvertSDKVestingAccount(nil, funder, now)
+		suite.Require().Error(err)
+		suite.Require().False(types.IsSDKVestingAccount(newBaseAcc()))
+	})
+}
@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	github_com_cosmos_cosmos_sdk_x_auth_vesting_types "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	types "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
@@ -272,8 +274,15 @@ type MsgClawback struct {
 	AccountAddress string `protobuf:"bytes,2,opt,name=account_address,json=accountAddress,proto3" json:"account_address,omitempty"`
 	// dest_address specifies where the clawed-back tokens should be transferred
 	// to. If empty, the tokens will be transferred back to the original funder of
-	// the account.
+	// the account, or to the community pool for governance clawbacks.
 	DestAddress string `protobuf:"bytes,3,opt,name=dest_address,json=destAddress,proto3" json:"dest_address,omitempty"`
+	// burn specifies whether the clawed-back tokens should be burned instead of
+	// transferred. If true, dest_address must be empty.
+	Burn bool `protobuf:"varint,4,opt,name=burn,proto3" json:"burn,omitempty"`
+	// destinations specifies multiple addresses to split the clawed-back tokens
+	// between according to their weights, with the remainder going to the first
+	// destination. If not empty, dest_address must be empty and burn false.
+	Destinations []ClawbackDestination `protobuf:"bytes,5,rep,name=destinations,proto3" json:"destinations"`
 }
 
 func (m *MsgClawback) Reset()         { *m = MsgClawback{} }
@@ -330,6 +339,77 @@ func (m *MsgClawback) GetDestAddress() string {
 	return ""
 }
 
+func (m *MsgClawback) GetBurn() bool {
+	if m != nil {
+		return m.Burn
+	}
+	return false
+}
+
+func (m *MsgClawback) GetDestinations() []ClawbackDestination {
+	if m != nil {
+		return m.Destinations
+	}
+	return nil
+}
+
+// ClawbackDestination defines a destination of the clawed-back tokens of a
+// MsgClawback and its share of the tokens.
+type ClawbackDestination struct {
+	// address is the address receiving the clawed-back tokens
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// weight is the share of the clawed-back tokens of the address relative to
+	// the sum of the weights of all destinations
+	Weight uint64 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *ClawbackDestination) Reset()         { *m = ClawbackDestination{} }
+func (m *ClawbackDestination) String() string { return proto.CompactTextString(m) }
+func (*ClawbackDestination) ProtoMessage()    {}
+func (*ClawbackDestination) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16767edf988ec07f, []int{5}
+}
+func (m *ClawbackDestination) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClawbackDestination) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClawbackDestination.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClawbackDestination) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClawbackDestination.Merge(m, src)
+}
+func (m *ClawbackDestination) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClawbackDestination) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClawbackDestination.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClawbackDestination proto.InternalMessageInfo
+
+func (m *ClawbackDestination) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ClawbackDestination) GetWeight() uint64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
 // MsgClawbackResponse defines the MsgClawback response type.
 type MsgClawbackResponse struct {
 }
@@ -338,7 +418,7 @@ func (m *MsgClawbackResponse) Reset()         { *m = MsgClawbackResponse{} }
 func (m *MsgClawbackResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgClawbackResponse) ProtoMessage()    {}
 func (*MsgClawbackResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_16767edf988ec07f, []int{5}
+	return fileDescriptor_16767edf988ec07f, []int{6}
 }
 func (m *MsgClawbackResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -382,7 +462,7 @@ func (m *MsgUpdateVestingFunder) Reset()         { *m = MsgUpdateVestingFunder{}
 func (m *MsgUpdateVestingFunder) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateVestingFunder) ProtoMessage()    {}
 func (*MsgUpdateVestingFunder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_16767edf988ec07f, []int{6}
+	return fileDescriptor_16767edf988ec07f, []int{7}
 }
 func (m *MsgUpdateVestingFunder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -441,7 +521,7 @@ func (m *MsgUpdateVestingFunderResponse) Reset()         { *m = MsgUpdateVesting
 func (m *MsgUpdateVestingFunderResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateVestingFunderResponse) ProtoMessage()    {}
 func (*MsgUpdateVestingFunderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_16767edf988ec07f, []int{7}
+	return fileDescriptor_16767edf988ec07f, []int{8}
 }
 func (m *MsgUpdateVestingFunderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -480,7 +560,7 @@ func (m *MsgConvertVestingAccount) Reset()         { *m = MsgConvertVestingAccou
 func (m *MsgConvertVestingAccount) String() string { return proto.CompactTextString(m) }
 func (*MsgConvertVestingAccount) ProtoMessage()    {}
 func (*MsgConvertVestingAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_16767edf988ec07f, []int{8}
+	return fileDescriptor_16767edf988ec07f, []int{9}
 }
 func (m *MsgConvertVestingAccount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -524,7 +604,7 @@ func (m *MsgConvertVestingAccountResponse) Reset()         { *m = MsgConvertVest
 func (m *MsgConvertVestingAccountResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgConvertVestingAccountResponse) ProtoMessage()    {}
 func (*MsgConvertVestingAccountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_16767edf988ec07f, []int{9}
+	return fileDescriptor_16767edf988ec07f, []int{10}
 }
 func (m *MsgConvertVestingAccountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -553,843 +633,11455 @@ func (m *MsgConvertVestingAccountResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgConvertVestingAccountResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgCreateClawbackVestingAccount)(nil), "vesting.v1.MsgCreateClawbackVestingAccount")
-	proto.RegisterType((*MsgCreateClawbackVestingAccountResponse)(nil), "vesting.v1.MsgCreateClawbackVestingAccountResponse")
-	proto.RegisterType((*MsgFundVestingAccount)(nil), "vesting.v1.MsgFundVestingAccount")
-	proto.RegisterType((*MsgFundVestingAccountResponse)(nil), "vesting.v1.MsgFundVestingAccountResponse")
-	proto.RegisterType((*MsgClawback)(nil), "vesting.v1.MsgClawback")
-	proto.RegisterType((*MsgClawbackResponse)(nil), "vesting.v1.MsgClawbackResponse")
-	proto.RegisterType((*MsgUpdateVestingFunder)(nil), "vesting.v1.MsgUpdateVestingFunder")
-	proto.RegisterType((*MsgUpdateVestingFunderResponse)(nil), "vesting.v1.MsgUpdateVestingFunderResponse")
-	proto.RegisterType((*MsgConvertVestingAccount)(nil), "vesting.v1.MsgConvertVestingAccount")
-	proto.RegisterType((*MsgConvertVestingAccountResponse)(nil), "vesting.v1.MsgConvertVestingAccountResponse")
+// MsgMigrateVestingAccount defines a message that converts an existing
+// x/auth/vesting account into a ClawbackVestingAccount with an equivalent
+// schedule. The linear schedule of a continuous vesting account is
+// approximated with one-day vesting periods counted from its start time, each
+// vesting the coins the linear schedule vests by its end: the converted account
+// can be up to one day ahead of the original schedule, but never behind it.
+type MsgMigrateVestingAccount struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// vesting_address is the address of the vesting account to migrate
+	VestingAddress string `protobuf:"bytes,2,opt,name=vesting_address,json=vestingAddress,proto3" json:"vesting_address,omitempty"`
+	// funder_address specifies the account that will be able to fund and
+	// clawback the migrated vesting account
+	FunderAddress string `protobuf:"bytes,3,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	// enable_gov_clawback specifies whether the governance module can clawback
+	// the migrated account
+	EnableGovClawback bool `protobuf:"varint,4,opt,name=enable_gov_clawback,json=enableGovClawback,proto3" json:"enable_gov_clawback,omitempty"`
 }
 
-func init() { proto.RegisterFile("vesting/v1/tx.proto", fileDescriptor_16767edf988ec07f) }
-
-var fileDescriptor_16767edf988ec07f = []byte{
-	// 785 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xc1, 0x4f, 0x13, 0x4f,
-	0x14, 0xee, 0xd0, 0xdf, 0x8f, 0xc0, 0x54, 0x50, 0xb7, 0x22, 0xcd, 0x06, 0x76, 0x4b, 0x03, 0xa1,
-	0x40, 0xdd, 0x4d, 0x0b, 0x31, 0x91, 0x78, 0xa1, 0x4d, 0xf0, 0xd4, 0xc4, 0x34, 0xea, 0xc1, 0x4b,
-	0xb3, 0xdd, 0x1d, 0x96, 0xa6, 0xed, 0xce, 0xa6, 0x33, 0x5b, 0xea, 0x95, 0x93, 0xd1, 0x0b, 0x89,
-	0xe1, 0xe0, 0x4d, 0xaf, 0x7a, 0xf1, 0x6e, 0xe2, 0x99, 0x23, 0x89, 0x17, 0xbd, 0x88, 0x01, 0x13,
-	0xfd, 0x33, 0xcc, 0xce, 0xce, 0x6e, 0x61, 0x19, 0x2c, 0x1c, 0xf4, 0xd4, 0x9d, 0xf7, 0xbe, 0x79,
-	0xef, 0x7b, 0xef, 0x7d, 0xf3, 0x0a, 0xd3, 0x3d, 0x44, 0x68, 0xd3, 0xb1, 0xf5, 0x5e, 0x51, 0xa7,
-	0x7d, 0xcd, 0xed, 0x62, 0x8a, 0x25, 0xc8, 0x8d, 0x5a, 0xaf, 0x28, 0x4f, 0x9b, 0x98, 0x74, 0x30,
-	0xd1, 0x3b, 0x84, 0x61, 0x3a, 0xc4, 0x0e, 0x40, 0xf2, 0x3c, 0x77, 0x0c, 0x02, 0x34, 0x10, 0x35,
-	0x8a, 0xe1, 0x99, 0xa3, 0x6e, 0xd9, 0xd8, 0xc6, 0xec, 0x53, 0xf7, 0xbf, 0xb8, 0x75, 0xc6, 0xc6,
-	0xd8, 0x6e, 0x23, 0xdd, 0x70, 0x9b, 0xba, 0xe1, 0x38, 0x98, 0x1a, 0xb4, 0x89, 0x1d, 0xc2, 0xbd,
-	0x2a, 0xf7, 0xb2, 0x53, 0xc3, 0xdb, 0xd2, 0x69, 0xb3, 0x83, 0x08, 0x35, 0x3a, 0x6e, 0x00, 0xc8,
-	0x7d, 0x04, 0x50, 0xad, 0x12, 0xbb, 0xd2, 0x45, 0x06, 0x45, 0x95, 0xb6, 0xb1, 0xd3, 0x30, 0xcc,
-	0xd6, 0x93, 0x20, 0xef, 0x86, 0x69, 0x62, 0xcf, 0xa1, 0xd2, 0x02, 0x9c, 0xdc, 0xf2, 0x1c, 0x0b,
-	0x75, 0xeb, 0x86, 0x65, 0x75, 0x11, 0x21, 0x19, 0x90, 0x05, 0xf9, 0xf1, 0xda, 0x44, 0x60, 0xdd,
-	0x08, 0x8c, 0xd2, 0x22, 0xbc, 0xce, 0x09, 0x47, 0xb8, 0x11, 0x86, 0x9b, 0xe4, 0xe6, 0x10, 0xa8,
-	0xc1, 0x34, 0x72, 0x8c, 0x46, 0x1b, 0xd5, 0x6d, 0xdc, 0xab, 0x9b, 0x3c, 0x69, 0x26, 0x99, 0x05,
-	0xf9, 0xb1, 0xda, 0xcd, 0xc0, 0xf5, 0x00, 0xf7, 0x42, 0x36, 0xeb, 0x99, 0x5f, 0x6f, 0xd4, 0xc4,
-	0xee, 0xcf, 0x0f, 0xcb, 0xf1, 0xf8, 0xb9, 0x25, 0xb8, 0x38, 0x84, 0x7c, 0x0d, 0x11, 0x17, 0x3b,
-	0x04, 0xe5, 0xbe, 0x26, 0xe1, 0x54, 0x95, 0xd8, 0x9b, 0x9e, 0x63, 0xfd, 0xe5, 0xf2, 0x2a, 0x10,
-	0x12, 0x6a, 0x74, 0x69, 0xdd, 0xef, 0x35, 0xab, 0x2a, 0x55, 0x92, 0xb5, 0x60, 0x10, 0x5a, 0x38,
-	0x08, 0xed, 0x51, 0x38, 0x88, 0xf2, 0xd8, 0xc1, 0x37, 0x35, 0xb1, 0x77, 0xa4, 0x82, 0xda, 0x38,
-	0xbb, 0xe7, 0x7b, 0xa4, 0xe7, 0x00, 0x4e, 0xb6, 0xb1, 0xd9, 0xf2, 0xdc, 0xba, 0x8b, 0xba, 0x4d,
-	0x6c, 0x91, 0xcc, 0x7f, 0xd9, 0x64, 0x3e, 0x55, 0x52, 0xb4, 0x40, 0x2c, 0xda, 0x40, 0x58, 0x4c,
-	0x2c, 0xda, 0x43, 0x06, 0x2b, 0x6f, 0xf8, 0xd1, 0xde, 0x1d, 0xa9, 0xf7, 0xec, 0x26, 0xdd, 0xf6,
-	0x1a, 0x9a, 0x89, 0x3b, 0x3a, 0x97, 0x57, 0xf0, 0x73, 0x87, 0x58, 0x2d, 0xbd, 0xaf, 0x1b, 0x1e,
-	0xdd, 0x8e, 0x04, 0x47, 0x9f, 0xb9, 0x88, 0xf0, 0x08, 0xa4, 0x36, 0x11, 0x24, 0xe6, 0x47, 0xe9,
-	0x05, 0x18, 0x54, 0x1e, 0x72, 0xf9, 0xff, 0x5f, 0x71, 0x09, 0x9b, 0xcb, 0xcf, 0xeb, 0x69, 0x5f,
-	0x07, 0xb1, 0x79, 0xe5, 0x54, 0x38, 0x2b, 0x1c, 0x6d, 0x34, 0xfc, 0x7d, 0x00, 0x53, 0xbe, 0x50,
-	0xb8, 0x44, 0xae, 0x30, 0x72, 0x23, 0x88, 0x14, 0x1f, 0x39, 0x37, 0x87, 0xc0, 0x39, 0x78, 0xcd,
-	0x42, 0x64, 0x80, 0x4a, 0x32, 0x54, 0xca, 0xb7, 0x71, 0x88, 0x98, 0xf8, 0x14, 0x4c, 0x9f, 0xa2,
-	0x15, 0xd1, 0x7d, 0x0f, 0xe0, 0xed, 0x2a, 0xb1, 0x1f, 0xbb, 0x96, 0x41, 0x11, 0x2f, 0x69, 0x93,
-	0xdd, 0xbc, 0x2c, 0xf3, 0x02, 0x94, 0x1c, 0xb4, 0x53, 0x8f, 0x41, 0x03, 0xf2, 0x37, 0x1c, 0xb4,
-	0xb3, 0x39, 0x4c, 0xda, 0x49, 0x91, 0xb4, 0xc5, 0x45, 0x64, 0xa1, 0x22, 0x26, 0x1b, 0xd5, 0x53,
-	0x81, 0x19, 0xbf, 0x4c, 0xec, 0xf4, 0x50, 0x97, 0xc6, 0x5e, 0x9f, 0x20, 0x37, 0x10, 0xe5, 0xce,
-	0xe5, 0x60, 0xf6, 0xa2, 0x20, 0x61, 0xa2, 0xd2, 0xcb, 0x51, 0x98, 0xac, 0x12, 0x5b, 0xfa, 0x04,
-	0xe0, 0xcc, 0x1f, 0x57, 0xda, 0xca, 0x29, 0xc9, 0x6a, 0x43, 0x56, 0x88, 0xbc, 0x7a, 0x05, 0x70,
-	0x54, 0xf3, 0xfd, 0xdd, 0xcf, 0x3f, 0x5e, 0x8d, 0xdc, 0x95, 0xd6, 0x74, 0xd4, 0x3b, 0xbb, 0xdb,
-	0x75, 0xda, 0xd7, 0x4d, 0x16, 0x22, 0xda, 0x7d, 0xf5, 0xa8, 0x05, 0x9c, 0xdf, 0x3e, 0x80, 0x92,
-	0x60, 0x55, 0xcd, 0xc5, 0x98, 0x9c, 0x87, 0xc8, 0x4b, 0x43, 0x21, 0x11, 0xc5, 0x22, 0xa3, 0xb8,
-	0x22, 0x2d, 0x09, 0x29, 0xfa, 0x53, 0x3e, 0xc7, 0xab, 0x05, 0xc7, 0xa2, 0x47, 0x34, 0x1d, 0x6f,
-	0x0b, 0x77, 0xc8, 0xea, 0x05, 0x8e, 0x28, 0xf1, 0x02, 0x4b, 0xac, 0x4a, 0xb3, 0xe2, 0xde, 0x84,
-	0x09, 0x5e, 0x03, 0x98, 0x16, 0xbd, 0x81, 0x5c, 0x2c, 0xbe, 0x00, 0x23, 0x2f, 0x0f, 0xc7, 0x44,
-	0x74, 0x4a, 0x8c, 0x4e, 0x41, 0x5a, 0x16, 0xd2, 0xf1, 0xd8, 0xcd, 0xa8, 0x13, 0x81, 0xf8, 0xa5,
-	0xb7, 0x00, 0x4e, 0x89, 0x05, 0x3d, 0x1f, 0xaf, 0x5e, 0x84, 0x92, 0x0b, 0x97, 0x41, 0x45, 0x0c,
-	0xd7, 0x18, 0x43, 0x4d, 0x2a, 0x88, 0x1b, 0x16, 0xdc, 0x8d, 0x0f, 0xab, 0x5c, 0x3e, 0x38, 0x56,
-	0xc0, 0xe1, 0xb1, 0x02, 0xbe, 0x1f, 0x2b, 0x60, 0xef, 0x44, 0x49, 0x1c, 0x9e, 0x28, 0x89, 0x2f,
-	0x27, 0x4a, 0xe2, 0x69, 0xfe, 0xd4, 0x42, 0x3e, 0x1b, 0xb1, 0x7f, 0x76, 0x0f, 0x37, 0x46, 0xd9,
-	0x1f, 0xd6, 0xea, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x91, 0x22, 0x8a, 0xff, 0xdd, 0x08, 0x00,
-	0x00,
+func (m *MsgMigrateVestingAccount) Reset()         { *m = MsgMigrateVestingAccount{} }
+func (m *MsgMigrateVestingAccount) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateVestingAccount) ProtoMessage()    {}
+func (*MsgMigrateVestingAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16767edf988ec07f, []int{11}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// CreateClawbackVestingAccount creats a vesting account that is subject to clawback.
-	CreateClawbackVestingAccount(ctx context.Context, in *MsgCreateClawbackVestingAccount, opts ...grpc.CallOption) (*MsgCreateClawbackVestingAccountResponse, error)
-	// FundVestingAccount funds an existing ClawbackVestingAccount with tokens
-	// according to the vesting and lockup schedules.
-	FundVestingAccount(ctx context.Context, in *MsgFundVestingAccount, opts ...grpc.CallOption) (*MsgFundVestingAccountResponse, error)
-	// Clawback removes the unvested tokens from a ClawbackVestingAccount.
-	Clawback(ctx context.Context, in *MsgClawback, opts ...grpc.CallOption) (*MsgClawbackResponse, error)
-	// UpdateVestingFunder updates the funder address of an existing
-	// ClawbackVestingAccount.
-	UpdateVestingFunder(ctx context.Context, in *MsgUpdateVestingFunder, opts ...grpc.CallOption) (*MsgUpdateVestingFunderResponse, error)
-	// ConvertVestingAccount converts a ClawbackVestingAccount to an Eth account
-	ConvertVestingAccount(ctx context.Context, in *MsgConvertVestingAccount, opts ...grpc.CallOption) (*MsgConvertVestingAccountResponse, error)
+func (m *MsgMigrateVestingAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgMigrateVestingAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateVestingAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgMigrateVestingAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateVestingAccount.Merge(m, src)
 }
-
-func (c *msgClient) CreateClawbackVestingAccount(ctx context.Context, in *MsgCreateClawbackVestingAccount, opts ...grpc.CallOption) (*MsgCreateClawbackVestingAccountResponse, error) {
-	out := new(MsgCreateClawbackVestingAccountResponse)
-	err := c.cc.Invoke(ctx, "/vesting.v1.Msg/CreateClawbackVestingAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgMigrateVestingAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateVestingAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateVestingAccount.DiscardUnknown(m)
 }
 
-func (c *msgClient) FundVestingAccount(ctx context.Context, in *MsgFundVestingAccount, opts ...grpc.CallOption) (*MsgFundVestingAccountResponse, error) {
-	out := new(MsgFundVestingAccountResponse)
-	err := c.cc.Invoke(ctx, "/vesting.v1.Msg/FundVestingAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgMigrateVestingAccount proto.InternalMessageInfo
+
+func (m *MsgMigrateVestingAccount) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) Clawback(ctx context.Context, in *MsgClawback, opts ...grpc.CallOption) (*MsgClawbackResponse, error) {
-	out := new(MsgClawbackResponse)
-	err := c.cc.Invoke(ctx, "/vesting.v1.Msg/Clawback", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgMigrateVestingAccount) GetVestingAddress() string {
+	if m != nil {
+		return m.VestingAddress
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateVestingFunder(ctx context.Context, in *MsgUpdateVestingFunder, opts ...grpc.CallOption) (*MsgUpdateVestingFunderResponse, error) {
-	out := new(MsgUpdateVestingFunderResponse)
-	err := c.cc.Invoke(ctx, "/vesting.v1.Msg/UpdateVestingFunder", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgMigrateVestingAccount) GetFunderAddress() string {
+	if m != nil {
+		return m.FunderAddress
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) ConvertVestingAccount(ctx context.Context, in *MsgConvertVestingAccount, opts ...grpc.CallOption) (*MsgConvertVestingAccountResponse, error) {
-	out := new(MsgConvertVestingAccountResponse)
-	err := c.cc.Invoke(ctx, "/vesting.v1.Msg/ConvertVestingAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgMigrateVestingAccount) GetEnableGovClawback() bool {
+	if m != nil {
+		return m.EnableGovClawback
 	}
-	return out, nil
+	return false
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// CreateClawbackVestingAccount creats a vesting account that is subject to clawback.
-	CreateClawbackVestingAccount(context.Context, *MsgCreateClawbackVestingAccount) (*MsgCreateClawbackVestingAccountResponse, error)
-	// FundVestingAccount funds an existing ClawbackVestingAccount with tokens
-	// according to the vesting and lockup schedules.
-	FundVestingAccount(context.Context, *MsgFundVestingAccount) (*MsgFundVestingAccountResponse, error)
-	// Clawback removes the unvested tokens from a ClawbackVestingAccount.
-	Clawback(context.Context, *MsgClawback) (*MsgClawbackResponse, error)
-	// UpdateVestingFunder updates the funder address of an existing
-	// ClawbackVestingAccount.
-	UpdateVestingFunder(context.Context, *MsgUpdateVestingFunder) (*MsgUpdateVestingFunderResponse, error)
-	// ConvertVestingAccount converts a ClawbackVestingAccount to an Eth account
-	ConvertVestingAccount(context.Context, *MsgConvertVestingAccount) (*MsgConvertVestingAccountResponse, error)
+// MsgMigrateVestingAccountResponse defines the MsgMigrateVestingAccount
+// response type.
+type MsgMigrateVestingAccountResponse struct {
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgMigrateVestingAccountResponse) Reset()         { *m = MsgMigrateVestingAccountResponse{} }
+func (m *MsgMigrateVestingAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateVestingAccountResponse) ProtoMessage()    {}
+func (*MsgMigrateVestingAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16767edf988ec07f, []int{12}
 }
-
-func (*UnimplementedMsgServer) CreateClawbackVestingAccount(ctx context.Context, req *MsgCreateClawbackVestingAccount) (*MsgCreateClawbackVestingAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateClawbackVestingAccount not implemented")
+func (m *MsgMigrateVestingAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) FundVestingAccount(ctx context.Context, req *MsgFundVestingAccount) (*MsgFundVestingAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FundVestingAccount not implemented")
+func (m *MsgMigrateVestingAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateVestingAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) Clawback(ctx context.Context, req *MsgClawback) (*MsgClawbackResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Clawback not implemented")
+func (m *MsgMigrateVestingAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateVestingAccountResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) UpdateVestingFunder(ctx context.Context, req *MsgUpdateVestingFunder) (*MsgUpdateVestingFunderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateVestingFunder not implemented")
+func (m *MsgMigrateVestingAccountResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) ConvertVestingAccount(ctx context.Context, req *MsgConvertVestingAccount) (*MsgConvertVestingAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConvertVestingAccount not implemented")
+func (m *MsgMigrateVestingAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateVestingAccountResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgMigrateVestingAccountResponse proto.InternalMessageInfo
+
+// MsgUpdateParams defines a message for updating the x/vesting module
+// parameters.
+type MsgUpdateParams struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the x/vesting parameters to update.
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 }
 
-func _Msg_CreateClawbackVestingAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateClawbackVestingAccount)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateClawbackVestingAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/vesting.v1.Msg/CreateClawbackVestingAccount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateClawbackVestingAccount(ctx, req.(*MsgCreateClawbackVestingAccount))
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16767edf988ec07f, []int{13}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
 }
 
-func _Msg_FundVestingAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgFundVestingAccount)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).FundVestingAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/vesting.v1.Msg/FundVestingAccount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).FundVestingAccount(ctx, req.(*MsgFundVestingAccount))
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_Clawback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgClawback)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Clawback(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/vesting.v1.Msg/Clawback",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Clawback(ctx, req.(*MsgClawback))
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	return interceptor(ctx, in, info, handler)
+	return Params{}
 }
 
-func _Msg_UpdateVestingFunder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateVestingFunder)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateVestingFunder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/vesting.v1.Msg/UpdateVestingFunder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateVestingFunder(ctx, req.(*MsgUpdateVestingFunder))
+// MsgUpdateParamsResponse defines the response structure for executing a
+// MsgUpdateParams message.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16767edf988ec07f, []int{14}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
 }
 
-func _Msg_ConvertVestingAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgConvertVestingAccount)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+// MsgSetVestingManager defines a message that sets or clears the manager of a
+// ClawbackVestingAccount, which can fund and perform clawback on behalf of the
+// funder.
+type MsgSetVestingManager struct {
+	// funder_address is the funder address of the ClawbackVestingAccount
+	FunderAddress string `protobuf:"bytes,1,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	// vesting_address is the address of the ClawbackVestingAccount being updated
+	VestingAddress string `protobuf:"bytes,2,opt,name=vesting_address,json=vestingAddress,proto3" json:"vesting_address,omitempty"`
+	// manager_address is the new manager address. If empty, the manager is cleared.
+	ManagerAddress string `protobuf:"bytes,3,opt,name=manager_address,json=managerAddress,proto3" json:"manager_address,omitempty"`
+}
+
+func (m *MsgSetVestingManager) Reset()         { *m = MsgSetVestingManager{} }
+func (m *MsgSetVestingManager) String() string { return proto.CompactTextString(m) }
+func (*MsgSetVestingManager) ProtoMessage()    {}
+func (*MsgSetVestingManager) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16767edf988ec07f, []int{15}
+}
+func (m *MsgSetVestingManager) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetVestingManager) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetVestingManager.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).ConvertVestingAccount(ctx, in)
+}
+func (m *MsgSetVestingManager) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetVestingManager.Merge(m, src)
+}
+func (m *MsgSetVestingManager) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetVestingManager) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetVestingManager.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetVestingManager proto.InternalMessageInfo
+
+func (m *MsgSetVestingManager) GetFunderAddress() string {
+	if m != nil {
+		return m.FunderAddress
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/vesting.v1.Msg/ConvertVestingAccount",
+	return ""
+}
+
+func (m *MsgSetVestingManager) GetVestingAddress() string {
+	if m != nil {
+		return m.VestingAddress
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ConvertVestingAccount(ctx, req.(*MsgConvertVestingAccount))
+	return ""
+}
+
+func (m *MsgSetVestingManager) GetManagerAddress() string {
+	if m != nil {
+		return m.ManagerAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "vesting.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateClawbackVestingAccount",
-			Handler:    _Msg_CreateClawbackVestingAccount_Handler,
-		},
-		{
-			MethodName: "FundVestingAccount",
-			Handler:    _Msg_FundVestingAccount_Handler,
-		},
-		{
-			MethodName: "Clawback",
-			Handler:    _Msg_Clawback_Handler,
-		},
-		{
-			MethodName: "UpdateVestingFunder",
-			Handler:    _Msg_UpdateVestingFunder_Handler,
-		},
-		{
-			MethodName: "ConvertVestingAccount",
-			Handler:    _Msg_ConvertVestingAccount_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "vesting/v1/tx.proto",
+// MsgSetVestingManagerResponse defines the MsgSetVestingManager response type.
+type MsgSetVestingManagerResponse struct {
 }
 
-func (m *MsgCreateClawbackVestingAccount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetVestingManagerResponse) Reset()         { *m = MsgSetVestingManagerResponse{} }
+func (m *MsgSetVestingManagerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetVestingManagerResponse) ProtoMessage()    {}
+func (*MsgSetVestingManagerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16767edf988ec07f, []int{16}
+}
+func (m *MsgSetVestingManagerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetVestingManagerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetVestingManagerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetVestingManagerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetVestingManagerResponse.Merge(m, src)
+}
+func (m *MsgSetVestingManagerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetVestingManagerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetVestingManagerResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateClawbackVestingAccount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetVestingManagerResponse proto.InternalMessageInfo
+
+// MsgBatchClawback defines a message that removes the unvested tokens of
+// multiple ClawbackVestingAccounts through governance.
+type MsgBatchClawback struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// account_addresses are the addresses of the ClawbackVestingAccounts to claw
+	// back from.
+	AccountAddresses []string `protobuf:"bytes,2,rep,name=account_addresses,json=accountAddresses,proto3" json:"account_addresses,omitempty"`
+	// dest_address specifies where the clawed-back tokens should be transferred
+	// to. If empty, the tokens will be transferred to the community pool.
+	DestAddress string `protobuf:"bytes,3,opt,name=dest_address,json=destAddress,proto3" json:"dest_address,omitempty"`
+	// best_effort specifies whether the clawback of the other accounts proceeds
+	// when the clawback of an account fails. If false, the clawback of all
+	// accounts is reverted on the first failure.
+	BestEffort bool `protobuf:"varint,4,opt,name=best_effort,json=bestEffort,proto3" json:"best_effort,omitempty"`
+	// burn specifies whether the clawed-back tokens should be burned instead of
+	// transferred. If true, dest_address must be empty.
+	Burn bool `protobuf:"varint,5,opt,name=burn,proto3" json:"burn,omitempty"`
 }
 
-func (m *MsgCreateClawbackVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EnableGovClawback {
-		i--
-		if m.EnableGovClawback {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *MsgBatchClawback) Reset()         { *m = MsgBatchClawback{} }
+func (m *MsgBatchClawback) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchClawback) ProtoMessage()    {}
+func (*MsgBatchClawback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16767edf988ec07f, []int{17}
+}
+func (m *MsgBatchClawback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBatchClawback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchClawback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.VestingAddress) > 0 {
-		i -= len(m.VestingAddress)
-		copy(dAtA[i:], m.VestingAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VestingAddress)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.FunderAddress) > 0 {
-		i -= len(m.FunderAddress)
-		copy(dAtA[i:], m.FunderAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FunderAddress)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgBatchClawback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchClawback.Merge(m, src)
+}
+func (m *MsgBatchClawback) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBatchClawback) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchClawback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBatchClawback proto.InternalMessageInfo
+
+func (m *MsgBatchClawback) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgCreateClawbackVestingAccountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgBatchClawback) GetAccountAddresses() []string {
+	if m != nil {
+		return m.AccountAddresses
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgCreateClawbackVestingAccountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgBatchClawback) GetDestAddress() string {
+	if m != nil {
+		return m.DestAddress
+	}
+	return ""
 }
 
-func (m *MsgCreateClawbackVestingAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgBatchClawback) GetBestEffort() bool {
+	if m != nil {
+		return m.BestEffort
+	}
+	return false
 }
 
-func (m *MsgFundVestingAccount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgBatchClawback) GetBurn() bool {
+	if m != nil {
+		return m.Burn
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *MsgFundVestingAccount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ClawbackResult defines the result of the clawback of a single account in a
+// MsgBatchClawback.
+type ClawbackResult struct {
+	// account_address is the address of the ClawbackVestingAccount
+	AccountAddress string `protobuf:"bytes,1,opt,name=account_address,json=accountAddress,proto3" json:"account_address,omitempty"`
+	// clawed_back is the amount of coins clawed back from the account
+	ClawedBack github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=clawed_back,json=clawedBack,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"clawed_back"`
+	// error is the reason of the failed clawback, empty on success
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (m *MsgFundVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.VestingPeriods) > 0 {
-		for iNdEx := len(m.VestingPeriods) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.VestingPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
+func (m *ClawbackResult) Reset()         { *m = ClawbackResult{} }
+func (m *ClawbackResult) String() string { return proto.CompactTextString(m) }
+func (*ClawbackResult) ProtoMessage()    {}
+func (*ClawbackResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16767edf988ec07f, []int{18}
+}
+func (m *ClawbackResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClawbackResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClawbackResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.LockupPeriods) > 0 {
-		for iNdEx := len(m.LockupPeriods) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LockupPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
-	if err1 != nil {
-		return 0, err1
-	}
-	i -= n1
-	i = encodeVarintTx(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x1a
-	if len(m.VestingAddress) > 0 {
-		i -= len(m.VestingAddress)
-		copy(dAtA[i:], m.VestingAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VestingAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.FunderAddress) > 0 {
-		i -= len(m.FunderAddress)
-		copy(dAtA[i:], m.FunderAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FunderAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgFundVestingAccountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ClawbackResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClawbackResult.Merge(m, src)
 }
-
-func (m *MsgFundVestingAccountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ClawbackResult) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgFundVestingAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *ClawbackResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClawbackResult.DiscardUnknown(m)
 }
 
-func (m *MsgClawback) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_ClawbackResult proto.InternalMessageInfo
 
-func (m *MsgClawback) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ClawbackResult) GetAccountAddress() string {
+	if m != nil {
+		return m.AccountAddress
+	}
+	return ""
 }
 
-func (m *MsgClawback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DestAddress) > 0 {
-		i -= len(m.DestAddress)
-		copy(dAtA[i:], m.DestAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DestAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.AccountAddress) > 0 {
-		i -= len(m.AccountAddress)
-		copy(dAtA[i:], m.AccountAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.AccountAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.FunderAddress) > 0 {
-		i -= len(m.FunderAddress)
-		copy(dAtA[i:], m.FunderAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FunderAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *ClawbackResult) GetClawedBack() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ClawedBack
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgClawbackResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ClawbackResult) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgClawbackResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgBatchClawbackResponse defines the MsgBatchClawback response type.
+type MsgBatchClawbackResponse struct {
+	// results are the clawback results of the accounts, in the order of the
+	// message
+	Results []ClawbackResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
 }
 
-func (m *MsgClawbackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgBatchClawbackResponse) Reset()         { *m = MsgBatchClawbackResponse{} }
+func (m *MsgBatchClawbackResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchClawbackResponse) ProtoMessage()    {}
+func (*MsgBatchClawbackResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16767edf988ec07f, []int{19}
 }
-
-func (m *MsgUpdateVestingFunder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgBatchClawbackResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBatchClawbackResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchClawbackResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgUpdateVestingFunder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgBatchClawbackResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchClawbackResponse.Merge(m, src)
 }
-
-func (m *MsgUpdateVestingFunder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.VestingAddress) > 0 {
-		i -= len(m.VestingAddress)
-		copy(dAtA[i:], m.VestingAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VestingAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.NewFunderAddress) > 0 {
-		i -= len(m.NewFunderAddress)
-		copy(dAtA[i:], m.NewFunderAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NewFunderAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.FunderAddress) > 0 {
-		i -= len(m.FunderAddress)
-		copy(dAtA[i:], m.FunderAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FunderAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgBatchClawbackResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBatchClawbackResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchClawbackResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateVestingFunderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgBatchClawbackResponse proto.InternalMessageInfo
+
+func (m *MsgBatchClawbackResponse) GetResults() []ClawbackResult {
+	if m != nil {
+		return m.Results
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgUpdateVestingFunderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgRenounceClawback defines a message that permanently waives the clawback
+// rights of the funder of a ClawbackVestingAccount.
+type MsgRenounceClawback struct {
+	// funder_address is the funder address of the ClawbackVestingAccount
+	FunderAddress string `protobuf:"bytes,1,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	// vesting_address is the address of the ClawbackVestingAccount
+	VestingAddress string `protobuf:"bytes,2,opt,name=vesting_address,json=vestingAddress,proto3" json:"vesting_address,omitempty"`
+	// renounce_gov_clawback specifies whether governance clawback is also
+	// disabled for the account
+	RenounceGovClawback bool `protobuf:"varint,3,opt,name=renounce_gov_clawback,json=renounceGovClawback,proto3" json:"renounce_gov_clawback,omitempty"`
 }
 
-func (m *MsgUpdateVestingFunderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRenounceClawback) Reset()         { *m = MsgRenounceClawback{} }
+func (m *MsgRenounceClawback) String() string { return proto.CompactTextString(m) }
+func (*MsgRenounceClawback) ProtoMessage()    {}
+func (*MsgRenounceClawback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16767edf988ec07f, []int{20}
 }
-
-func (m *MsgConvertVestingAccount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRenounceClawback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRenounceClawback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRenounceClawback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgConvertVestingAccount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRenounceClawback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRenounceClawback.Merge(m, src)
+}
+func (m *MsgRenounceClawback) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRenounceClawback) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRenounceClawback.DiscardUnknown(m)
 }
 
-func (m *MsgConvertVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.VestingAddress) > 0 {
-		i -= len(m.VestingAddress)
-		copy(dAtA[i:], m.VestingAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VestingAddress)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgRenounceClawback proto.InternalMessageInfo
+
+func (m *MsgRenounceClawback) GetFunderAddress() string {
+	if m != nil {
+		return m.FunderAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgConvertVestingAccountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRenounceClawback) GetVestingAddress() string {
+	if m != nil {
+		return m.VestingAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgConvertVestingAccountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRenounceClawback) GetRenounceGovClawback() bool {
+	if m != nil {
+		return m.RenounceGovClawback
+	}
+	return false
 }
 
-func (m *MsgConvertVestingAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgRenounceClawbackResponse defines the MsgRenounceClawback response type.
+type MsgRenounceClawbackResponse struct {
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgRenounceClawbackResponse) Reset()         { *m = MsgRenounceClawbackResponse{} }
+func (m *MsgRenounceClawbackResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRenounceClawbackResponse) ProtoMessage()    {}
+func (*MsgRenounceClawbackResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16767edf988ec07f, []int{21}
 }
-func (m *MsgCreateClawbackVestingAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FunderAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.VestingAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.EnableGovClawback {
-		n += 2
+func (m *MsgRenounceClawbackResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRenounceClawbackResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRenounceClawbackResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgRenounceClawbackResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRenounceClawbackResponse.Merge(m, src)
+}
+func (m *MsgRenounceClawbackResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRenounceClawbackResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRenounceClawbackResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateClawbackVestingAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+var xxx_messageInfo_MsgRenounceClawbackResponse proto.InternalMessageInfo
+
+// MsgPauseVesting defines a message that pauses the vesting of a
+// ClawbackVestingAccount.
+type MsgPauseVesting struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// vesting_address is the address of the ClawbackVestingAccount to pause
+	VestingAddress string `protobuf:"bytes,2,opt,name=vesting_address,json=vestingAddress,proto3" json:"vesting_address,omitempty"`
 }
 
-func (m *MsgFundVestingAccount) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgPauseVesting) Reset()         { *m = MsgPauseVesting{} }
+func (m *MsgPauseVesting) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseVesting) ProtoMessage()    {}
+func (*MsgPauseVesting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16767edf988ec07f, []int{22}
+}
+func (m *MsgPauseVesting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPauseVesting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPauseVesting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.FunderAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgPauseVesting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseVesting.Merge(m, src)
+}
+func (m *MsgPauseVesting) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPauseVesting) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseVesting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPauseVesting proto.InternalMessageInfo
+
+func (m *MsgPauseVesting) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	l = len(m.VestingAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgPauseVesting) GetVestingAddress() string {
+	if m != nil {
+		return m.VestingAddress
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.LockupPeriods) > 0 {
-		for _, e := range m.LockupPeriods {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+// MsgPauseVestingResponse defines the MsgPauseVesting response type.
+type MsgPauseVestingResponse struct {
+}
+
+func (m *MsgPauseVestingResponse) Reset()         { *m = MsgPauseVestingResponse{} }
+func (m *MsgPauseVestingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseVestingResponse) ProtoMessage()    {}
+func (*MsgPauseVestingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16767edf988ec07f, []int{23}
+}
+func (m *MsgPauseVestingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPauseVestingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPauseVestingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.VestingPeriods) > 0 {
-		for _, e := range m.VestingPeriods {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgPauseVestingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseVestingResponse.Merge(m, src)
+}
+func (m *MsgPauseVestingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPauseVestingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseVestingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPauseVestingResponse proto.InternalMessageInfo
+
+// MsgResumeVesting defines a message that resumes the paused vesting of a
+// ClawbackVestingAccount.
+type MsgResumeVesting struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// vesting_address is the address of the ClawbackVestingAccount to resume
+	VestingAddress string `protobuf:"bytes,2,opt,name=vesting_address,json=vestingAddress,proto3" json:"vesting_address,omitempty"`
+}
+
+func (m *MsgResumeVesting) Reset()         { *m = MsgResumeVesting{} }
+func (m *MsgResumeVesting) String() string { return proto.CompactTextString(m) }
+func (*MsgResumeVesting) ProtoMessage()    {}
+func (*MsgResumeVesting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16767edf988ec07f, []int{24}
+}
+func (m *MsgResumeVesting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResumeVesting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResumeVesting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgResumeVesting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResumeVesting.Merge(m, src)
+}
+func (m *MsgResumeVesting) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResumeVesting) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResumeVesting.DiscardUnknown(m)
 }
 
-func (m *MsgFundVestingAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgResumeVesting proto.InternalMessageInfo
+
+func (m *MsgResumeVesting) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *MsgClawback) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FunderAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.AccountAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.DestAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgResumeVesting) GetVestingAddress() string {
+	if m != nil {
+		return m.VestingAddress
 	}
-	return n
+	return ""
 }
 
-func (m *MsgClawbackResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+// MsgResumeVestingResponse defines the MsgResumeVesting response type.
+type MsgResumeVestingResponse struct {
 }
 
-func (m *MsgUpdateVestingFunder) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FunderAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.NewFunderAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.VestingAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgResumeVestingResponse) Reset()         { *m = MsgResumeVestingResponse{} }
+func (m *MsgResumeVestingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResumeVestingResponse) ProtoMessage()    {}
+func (*MsgResumeVestingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16767edf988ec07f, []int{25}
+}
+func (m *MsgResumeVestingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResumeVestingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResumeVestingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgResumeVestingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResumeVestingResponse.Merge(m, src)
+}
+func (m *MsgResumeVestingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResumeVestingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResumeVestingResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateVestingFunderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+var xxx_messageInfo_MsgResumeVestingResponse proto.InternalMessageInfo
+
+// MsgUnlockVesting defines a message that unlocks all the lockup periods of
+// ClawbackVestingAccounts.
+type MsgUnlockVesting struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// vesting_addresses are the addresses of the ClawbackVestingAccounts to
+	// unlock
+	VestingAddresses []string `protobuf:"bytes,2,rep,name=vesting_addresses,json=vestingAddresses,proto3" json:"vesting_addresses,omitempty"`
 }
 
-func (m *MsgConvertVestingAccount) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUnlockVesting) Reset()         { *m = MsgUnlockVesting{} }
+func (m *MsgUnlockVesting) String() string { return proto.CompactTextString(m) }
+func (*MsgUnlockVesting) ProtoMessage()    {}
+func (*MsgUnlockVesting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16767edf988ec07f, []int{26}
+}
+func (m *MsgUnlockVesting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnlockVesting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnlockVesting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.VestingAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgUnlockVesting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnlockVesting.Merge(m, src)
+}
+func (m *MsgUnlockVesting) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnlockVesting) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnlockVesting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnlockVesting proto.InternalMessageInfo
+
+func (m *MsgUnlockVesting) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return n
+	return ""
 }
 
-func (m *MsgConvertVestingAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUnlockVesting) GetVestingAddresses() []string {
+	if m != nil {
+		return m.VestingAddresses
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+// MsgUnlockVestingResponse defines the MsgUnlockVesting response type.
+type MsgUnlockVestingResponse struct {
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgUnlockVestingResponse) Reset()         { *m = MsgUnlockVestingResponse{} }
+func (m *MsgUnlockVestingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnlockVestingResponse) ProtoMessage()    {}
+func (*MsgUnlockVestingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16767edf988ec07f, []int{27}
 }
-func (m *MsgCreateClawbackVestingAccount) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+func (m *MsgUnlockVestingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnlockVestingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnlockVestingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnlockVestingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnlockVestingResponse.Merge(m, src)
+}
+func (m *MsgUnlockVestingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnlockVestingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnlockVestingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnlockVestingResponse proto.InternalMessageInfo
+
+// MsgProposeScheduleAmendment defines a message that proposes an amendment of
+// the future lockup and vesting schedules of a ClawbackVestingAccount.
+type MsgProposeScheduleAmendment struct {
+	// funder_address is the funder address of the ClawbackVestingAccount
+	FunderAddress string `protobuf:"bytes,1,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	// vesting_address is the address of the ClawbackVestingAccount
+	VestingAddress string `protobuf:"bytes,2,opt,name=vesting_address,json=vestingAddress,proto3" json:"vesting_address,omitempty"`
+	// lockup_periods defines the future unlocking schedule, relative to the
+	// acceptance time
+	LockupPeriods github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods `protobuf:"bytes,3,rep,name=lockup_periods,json=lockupPeriods,proto3,castrepeated=github.com/cosmos/cosmos-sdk/x/auth/vesting/types.Periods" json:"lockup_periods"`
+	// vesting_periods defines the future vesting schedule, relative to the
+	// acceptance time
+	VestingPeriods github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods `protobuf:"bytes,4,rep,name=vesting_periods,json=vestingPeriods,proto3,castrepeated=github.com/cosmos/cosmos-sdk/x/auth/vesting/types.Periods" json:"vesting_periods"`
+}
+
+func (m *MsgProposeScheduleAmendment) Reset()         { *m = MsgProposeScheduleAmendment{} }
+func (m *MsgProposeScheduleAmendment) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeScheduleAmendment) ProtoMessage()    {}
+func (*MsgProposeScheduleAmendment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16767edf988ec07f, []int{28}
+}
+func (m *MsgProposeScheduleAmendment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProposeScheduleAmendment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeScheduleAmendment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgProposeScheduleAmendment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeScheduleAmendment.Merge(m, src)
+}
+func (m *MsgProposeScheduleAmendment) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposeScheduleAmendment) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeScheduleAmendment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgProposeScheduleAmendment proto.InternalMessageInfo
+
+func (m *MsgProposeScheduleAmendment) GetFunderAddress() string {
+	if m != nil {
+		return m.FunderAddress
+	}
+	return ""
+}
+
+func (m *MsgProposeScheduleAmendment) GetVestingAddress() string {
+	if m != nil {
+		return m.VestingAddress
+	}
+	return ""
+}
+
+func (m *MsgProposeScheduleAmendment) GetLockupPeriods() github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods {
+	if m != nil {
+		return m.LockupPeriods
+	}
+	return nil
+}
+
+func (m *MsgProposeScheduleAmendment) GetVestingPeriods() github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods {
+	if m != nil {
+		return m.VestingPeriods
+	}
+	return nil
+}
+
+// MsgProposeScheduleAmendmentResponse defines the MsgProposeScheduleAmendment
+// response type.
+type MsgProposeScheduleAmendmentResponse struct {
+}
+
+func (m *MsgProposeScheduleAmendmentResponse) Reset()         { *m = MsgProposeScheduleAmendmentResponse{} }
+func (m *MsgProposeScheduleAmendmentResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeScheduleAmendmentResponse) ProtoMessage()    {}
+func (*MsgProposeScheduleAmendmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16767edf988ec07f, []int{29}
+}
+func (m *MsgProposeScheduleAmendmentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProposeScheduleAmendmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeScheduleAmendmentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgProposeScheduleAmendmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeScheduleAmendmentResponse.Merge(m, src)
+}
+func (m *MsgProposeScheduleAmendmentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposeScheduleAmendmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeScheduleAmendmentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgProposeScheduleAmendmentResponse proto.InternalMessageInfo
+
+// MsgAcceptScheduleAmendment defines a message that accepts the pending
+// schedule amendment of a ClawbackVestingAccount.
+type MsgAcceptScheduleAmendment struct {
+	// vesting_address is the address of the ClawbackVestingAccount
+	VestingAddress string `protobuf:"bytes,1,opt,name=vesting_address,json=vestingAddress,proto3" json:"vesting_address,omitempty"`
+}
+
+func (m *MsgAcceptScheduleAmendment) Reset()         { *m = MsgAcceptScheduleAmendment{} }
+func (m *MsgAcceptScheduleAmendment) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptScheduleAmendment) ProtoMessage()    {}
+func (*MsgAcceptScheduleAmendment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16767edf988ec07f, []int{30}
+}
+func (m *MsgAcceptScheduleAmendment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptScheduleAmendment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptScheduleAmendment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptScheduleAmendment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptScheduleAmendment.Merge(m, src)
+}
+func (m *MsgAcceptScheduleAmendment) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptScheduleAmendment) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptScheduleAmendment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptScheduleAmendment proto.InternalMessageInfo
+
+func (m *MsgAcceptScheduleAmendment) GetVestingAddress() string {
+	if m != nil {
+		return m.VestingAddress
+	}
+	return ""
+}
+
+// MsgAcceptScheduleAmendmentResponse defines the MsgAcceptScheduleAmendment
+// response type.
+type MsgAcceptScheduleAmendmentResponse struct {
+}
+
+func (m *MsgAcceptScheduleAmendmentResponse) Reset()         { *m = MsgAcceptScheduleAmendmentResponse{} }
+func (m *MsgAcceptScheduleAmendmentResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptScheduleAmendmentResponse) ProtoMessage()    {}
+func (*MsgAcceptScheduleAmendmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16767edf988ec07f, []int{31}
+}
+func (m *MsgAcceptScheduleAmendmentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptScheduleAmendmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptScheduleAmendmentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptScheduleAmendmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptScheduleAmendmentResponse.Merge(m, src)
+}
+func (m *MsgAcceptScheduleAmendmentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptScheduleAmendmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptScheduleAmendmentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptScheduleAmendmentResponse proto.InternalMessageInfo
+
+// MsgSelfLockup defines a message that locks up a portion of the balance of
+// an account under a lockup schedule starting at the current block time.
+type MsgSelfLockup struct {
+	// address is the address of the account locking up its coins
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// lockup_periods defines the unlocking schedule relative to the current
+	// block time
+	LockupPeriods github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods `protobuf:"bytes,2,rep,name=lockup_periods,json=lockupPeriods,proto3,castrepeated=github.com/cosmos/cosmos-sdk/x/auth/vesting/types.Periods" json:"lockup_periods"`
+}
+
+func (m *MsgSelfLockup) Reset()         { *m = MsgSelfLockup{} }
+func (m *MsgSelfLockup) String() string { return proto.CompactTextString(m) }
+func (*MsgSelfLockup) ProtoMessage()    {}
+func (*MsgSelfLockup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16767edf988ec07f, []int{32}
+}
+func (m *MsgSelfLockup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSelfLockup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSelfLockup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSelfLockup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSelfLockup.Merge(m, src)
+}
+func (m *MsgSelfLockup) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSelfLockup) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSelfLockup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSelfLockup proto.InternalMessageInfo
+
+func (m *MsgSelfLockup) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgSelfLockup) GetLockupPeriods() github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods {
+	if m != nil {
+		return m.LockupPeriods
+	}
+	return nil
+}
+
+// MsgSelfLockupResponse defines the MsgSelfLockup response type.
+type MsgSelfLockupResponse struct {
+}
+
+func (m *MsgSelfLockupResponse) Reset()         { *m = MsgSelfLockupResponse{} }
+func (m *MsgSelfLockupResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSelfLockupResponse) ProtoMessage()    {}
+func (*MsgSelfLockupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16767edf988ec07f, []int{33}
+}
+func (m *MsgSelfLockupResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSelfLockupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSelfLockupResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSelfLockupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSelfLockupResponse.Merge(m, src)
+}
+func (m *MsgSelfLockupResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSelfLockupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSelfLockupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSelfLockupResponse proto.InternalMessageInfo
+
+// MsgFundVestingAccountFromCommunityPool defines a message that funds a
+// ClawbackVestingAccount from the community pool.
+type MsgFundVestingAccountFromCommunityPool struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// vesting_address specifies the account that receives the funds
+	VestingAddress string `protobuf:"bytes,2,opt,name=vesting_address,json=vestingAddress,proto3" json:"vesting_address,omitempty"`
+	// start_time defines the time at which the vesting period begins
+	StartTime time.Time `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// lockup_periods defines the unlocking schedule relative to the start_time
+	LockupPeriods github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods `protobuf:"bytes,4,rep,name=lockup_periods,json=lockupPeriods,proto3,castrepeated=github.com/cosmos/cosmos-sdk/x/auth/vesting/types.Periods" json:"lockup_periods"`
+	// vesting_periods defines the vesting schedule relative to the start_time
+	VestingPeriods github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods `protobuf:"bytes,5,rep,name=vesting_periods,json=vestingPeriods,proto3,castrepeated=github.com/cosmos/cosmos-sdk/x/auth/vesting/types.Periods" json:"vesting_periods"`
+}
+
+func (m *MsgFundVestingAccountFromCommunityPool) Reset() {
+	*m = MsgFundVestingAccountFromCommunityPool{}
+}
+func (m *MsgFundVestingAccountFromCommunityPool) String() string { return proto.CompactTextString(m) }
+func (*MsgFundVestingAccountFromCommunityPool) ProtoMessage()    {}
+func (*MsgFundVestingAccountFromCommunityPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16767edf988ec07f, []int{34}
+}
+func (m *MsgFundVestingAccountFromCommunityPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundVestingAccountFromCommunityPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundVestingAccountFromCommunityPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundVestingAccountFromCommunityPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundVestingAccountFromCommunityPool.Merge(m, src)
+}
+func (m *MsgFundVestingAccountFromCommunityPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundVestingAccountFromCommunityPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundVestingAccountFromCommunityPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundVestingAccountFromCommunityPool proto.InternalMessageInfo
+
+func (m *MsgFundVestingAccountFromCommunityPool) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgFundVestingAccountFromCommunityPool) GetVestingAddress() string {
+	if m != nil {
+		return m.VestingAddress
+	}
+	return ""
+}
+
+func (m *MsgFundVestingAccountFromCommunityPool) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *MsgFundVestingAccountFromCommunityPool) GetLockupPeriods() github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods {
+	if m != nil {
+		return m.LockupPeriods
+	}
+	return nil
+}
+
+func (m *MsgFundVestingAccountFromCommunityPool) GetVestingPeriods() github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods {
+	if m != nil {
+		return m.VestingPeriods
+	}
+	return nil
+}
+
+// MsgFundVestingAccountFromCommunityPoolResponse defines the
+// MsgFundVestingAccountFromCommunityPool response type.
+type MsgFundVestingAccountFromCommunityPoolResponse struct {
+}
+
+func (m *MsgFundVestingAccountFromCommunityPoolResponse) Reset() {
+	*m = MsgFundVestingAccountFromCommunityPoolResponse{}
+}
+func (m *MsgFundVestingAccountFromCommunityPoolResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgFundVestingAccountFromCommunityPoolResponse) ProtoMessage() {}
+func (*MsgFundVestingAccountFromCommunityPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16767edf988ec07f, []int{35}
+}
+func (m *MsgFundVestingAccountFromCommunityPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundVestingAccountFromCommunityPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundVestingAccountFromCommunityPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundVestingAccountFromCommunityPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundVestingAccountFromCommunityPoolResponse.Merge(m, src)
+}
+func (m *MsgFundVestingAccountFromCommunityPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundVestingAccountFromCommunityPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundVestingAccountFromCommunityPoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundVestingAccountFromCommunityPoolResponse proto.InternalMessageInfo
+
+// MsgCreateFundingInstruction defines a message that registers a recurring
+// funding of a ClawbackVestingAccount.
+type MsgCreateFundingInstruction struct {
+	// funder_address specifies the account that funds the grants
+	FunderAddress string `protobuf:"bytes,1,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	// vesting_address specifies the account that receives the grants
+	VestingAddress string `protobuf:"bytes,2,opt,name=vesting_address,json=vestingAddress,proto3" json:"vesting_address,omitempty"`
+	// start_time defines the due time of the first grant
+	StartTime time.Time `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// interval is the duration in seconds between two grants
+	Interval int64 `protobuf:"varint,4,opt,name=interval,proto3" json:"interval,omitempty"`
+	// count is the number of grants
+	Count uint64 `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	// lockup_periods defines the unlocking schedule of each grant relative to
+	// its due time. The amount of each grant is the total of the periods.
+	LockupPeriods github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods `protobuf:"bytes,6,rep,name=lockup_periods,json=lockupPeriods,proto3,castrepeated=github.com/cosmos/cosmos-sdk/x/auth/vesting/types.Periods" json:"lockup_periods"`
+}
+
+func (m *MsgCreateFundingInstruction) Reset()         { *m = MsgCreateFundingInstruction{} }
+func (m *MsgCreateFundingInstruction) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateFundingInstruction) ProtoMessage()    {}
+func (*MsgCreateFundingInstruction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16767edf988ec07f, []int{36}
+}
+func (m *MsgCreateFundingInstruction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateFundingInstruction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateFundingInstruction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateFundingInstruction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateFundingInstruction.Merge(m, src)
+}
+func (m *MsgCreateFundingInstruction) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateFundingInstruction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateFundingInstruction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateFundingInstruction proto.InternalMessageInfo
+
+func (m *MsgCreateFundingInstruction) GetFunderAddress() string {
+	if m != nil {
+		return m.FunderAddress
+	}
+	return ""
+}
+
+func (m *MsgCreateFundingInstruction) GetVestingAddress() string {
+	if m != nil {
+		return m.VestingAddress
+	}
+	return ""
+}
+
+func (m *MsgCreateFundingInstruction) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *MsgCreateFundingInstruction) GetInterval() int64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *MsgCreateFundingInstruction) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *MsgCreateFundingInstruction) GetLockupPeriods() github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods {
+	if m != nil {
+		return m.LockupPeriods
+	}
+	return nil
+}
+
+// MsgCreateFundingInstructionResponse defines the MsgCreateFundingInstruction
+// response type.
+type MsgCreateFundingInstructionResponse struct {
+	// id is the identifier of the created instruction
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgCreateFundingInstructionResponse) Reset()         { *m = MsgCreateFundingInstructionResponse{} }
+func (m *MsgCreateFundingInstructionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateFundingInstructionResponse) ProtoMessage()    {}
+func (*MsgCreateFundingInstructionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16767edf988ec07f, []int{37}
+}
+func (m *MsgCreateFundingInstructionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateFundingInstructionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateFundingInstructionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateFundingInstructionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateFundingInstructionResponse.Merge(m, src)
+}
+func (m *MsgCreateFundingInstructionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateFundingInstructionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateFundingInstructionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateFundingInstructionResponse proto.InternalMessageInfo
+
+func (m *MsgCreateFundingInstructionResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// MsgCancelFundingInstruction defines a message that cancels a recurring
+// funding instruction.
+type MsgCancelFundingInstruction struct {
+	// funder_address is the funder address of the instruction
+	FunderAddress string `protobuf:"bytes,1,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	// id is the identifier of the instruction
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgCancelFundingInstruction) Reset()         { *m = MsgCancelFundingInstruction{} }
+func (m *MsgCancelFundingInstruction) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelFundingInstruction) ProtoMessage()    {}
+func (*MsgCancelFundingInstruction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16767edf988ec07f, []int{38}
+}
+func (m *MsgCancelFundingInstruction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelFundingInstruction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelFundingInstruction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelFundingInstruction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelFundingInstruction.Merge(m, src)
+}
+func (m *MsgCancelFundingInstruction) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelFundingInstruction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelFundingInstruction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelFundingInstruction proto.InternalMessageInfo
+
+func (m *MsgCancelFundingInstruction) GetFunderAddress() string {
+	if m != nil {
+		return m.FunderAddress
+	}
+	return ""
+}
+
+func (m *MsgCancelFundingInstruction) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// MsgCancelFundingInstructionResponse defines the MsgCancelFundingInstruction
+// response type.
+type MsgCancelFundingInstructionResponse struct {
+}
+
+func (m *MsgCancelFundingInstructionResponse) Reset()         { *m = MsgCancelFundingInstructionResponse{} }
+func (m *MsgCancelFundingInstructionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelFundingInstructionResponse) ProtoMessage()    {}
+func (*MsgCancelFundingInstructionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16767edf988ec07f, []int{39}
+}
+func (m *MsgCancelFundingInstructionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelFundingInstructionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelFundingInstructionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelFundingInstructionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelFundingInstructionResponse.Merge(m, src)
+}
+func (m *MsgCancelFundingInstructionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelFundingInstructionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelFundingInstructionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelFundingInstructionResponse proto.InternalMessageInfo
+
+// MsgCreateEscrowGrant defines a message that funds a vesting grant held in
+// escrow by the vesting module account.
+type MsgCreateEscrowGrant struct {
+	// funder_address specifies the account that funds the grant
+	FunderAddress string `protobuf:"bytes,1,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	// recipient_address specifies the account that receives the coins as they
+	// vest and unlock
+	RecipientAddress string `protobuf:"bytes,2,opt,name=recipient_address,json=recipientAddress,proto3" json:"recipient_address,omitempty"`
+	// start_time defines the time at which the vesting and lockup schedules begin
+	StartTime time.Time `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// lockup_periods defines the unlocking schedule relative to the start_time
+	LockupPeriods github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods `protobuf:"bytes,4,rep,name=lockup_periods,json=lockupPeriods,proto3,castrepeated=github.com/cosmos/cosmos-sdk/x/auth/vesting/types.Periods" json:"lockup_periods"`
+	// vesting_periods defines the vesting schedule relative to the start_time
+	VestingPeriods github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods `protobuf:"bytes,5,rep,name=vesting_periods,json=vestingPeriods,proto3,castrepeated=github.com/cosmos/cosmos-sdk/x/auth/vesting/types.Periods" json:"vesting_periods"`
+}
+
+func (m *MsgCreateEscrowGrant) Reset()         { *m = MsgCreateEscrowGrant{} }
+func (m *MsgCreateEscrowGrant) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateEscrowGrant) ProtoMessage()    {}
+func (*MsgCreateEscrowGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16767edf988ec07f, []int{40}
+}
+func (m *MsgCreateEscrowGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateEscrowGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateEscrowGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateEscrowGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateEscrowGrant.Merge(m, src)
+}
+func (m *MsgCreateEscrowGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateEscrowGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateEscrowGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateEscrowGrant proto.InternalMessageInfo
+
+func (m *MsgCreateEscrowGrant) GetFunderAddress() string {
+	if m != nil {
+		return m.FunderAddress
+	}
+	return ""
+}
+
+func (m *MsgCreateEscrowGrant) GetRecipientAddress() string {
+	if m != nil {
+		return m.RecipientAddress
+	}
+	return ""
+}
+
+func (m *MsgCreateEscrowGrant) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *MsgCreateEscrowGrant) GetLockupPeriods() github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods {
+	if m != nil {
+		return m.LockupPeriods
+	}
+	return nil
+}
+
+func (m *MsgCreateEscrowGrant) GetVestingPeriods() github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods {
+	if m != nil {
+		return m.VestingPeriods
+	}
+	return nil
+}
+
+// MsgCreateEscrowGrantResponse defines the MsgCreateEscrowGrant response type.
+type MsgCreateEscrowGrantResponse struct {
+	// id is the identifier of the created grant
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgCreateEscrowGrantResponse) Reset()         { *m = MsgCreateEscrowGrantResponse{} }
+func (m *MsgCreateEscrowGrantResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateEscrowGrantResponse) ProtoMessage()    {}
+func (*MsgCreateEscrowGrantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16767edf988ec07f, []int{41}
+}
+func (m *MsgCreateEscrowGrantResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateEscrowGrantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateEscrowGrantResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateEscrowGrantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateEscrowGrantResponse.Merge(m, src)
+}
+func (m *MsgCreateEscrowGrantResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateEscrowGrantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateEscrowGrantResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateEscrowGrantResponse proto.InternalMessageInfo
+
+func (m *MsgCreateEscrowGrantResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// MsgClaimEscrowGrant defines a message that releases the vested and unlocked
+// coins of an escrow grant to its recipient.
+type MsgClaimEscrowGrant struct {
+	// recipient_address is the recipient address of the grant
+	RecipientAddress string `protobuf:"bytes,1,opt,name=recipient_address,json=recipientAddress,proto3" json:"recipient_address,omitempty"`
+	// id is the identifier of the grant
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgClaimEscrowGrant) Reset()         { *m = MsgClaimEscrowGrant{} }
+func (m *MsgClaimEscrowGrant) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimEscrowGrant) ProtoMessage()    {}
+func (*MsgClaimEscrowGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16767edf988ec07f, []int{42}
+}
+func (m *MsgClaimEscrowGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimEscrowGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimEscrowGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimEscrowGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimEscrowGrant.Merge(m, src)
+}
+func (m *MsgClaimEscrowGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimEscrowGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimEscrowGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimEscrowGrant proto.InternalMessageInfo
+
+func (m *MsgClaimEscrowGrant) GetRecipientAddress() string {
+	if m != nil {
+		return m.RecipientAddress
+	}
+	return ""
+}
+
+func (m *MsgClaimEscrowGrant) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// MsgClaimEscrowGrantResponse defines the MsgClaimEscrowGrant response type.
+type MsgClaimEscrowGrantResponse struct {
+	// coins is the amount of coins released to the recipient
+	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+}
+
+func (m *MsgClaimEscrowGrantResponse) Reset()         { *m = MsgClaimEscrowGrantResponse{} }
+func (m *MsgClaimEscrowGrantResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimEscrowGrantResponse) ProtoMessage()    {}
+func (*MsgClaimEscrowGrantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16767edf988ec07f, []int{43}
+}
+func (m *MsgClaimEscrowGrantResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimEscrowGrantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimEscrowGrantResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimEscrowGrantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimEscrowGrantResponse.Merge(m, src)
+}
+func (m *MsgClaimEscrowGrantResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimEscrowGrantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimEscrowGrantResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimEscrowGrantResponse proto.InternalMessageInfo
+
+func (m *MsgClaimEscrowGrantResponse) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
+// MsgClawbackEscrowGrant defines a message that claws back the unvested coins
+// of an escrow grant.
+type MsgClawbackEscrowGrant struct {
+	// funder_address is the funder address of the grant
+	FunderAddress string `protobuf:"bytes,1,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	// id is the identifier of the grant
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// dest_address specifies where the clawed-back tokens should be transferred
+	// to. If empty, the tokens will be transferred back to the funder.
+	DestAddress string `protobuf:"bytes,3,opt,name=dest_address,json=destAddress,proto3" json:"dest_address,omitempty"`
+}
+
+func (m *MsgClawbackEscrowGrant) Reset()         { *m = MsgClawbackEscrowGrant{} }
+func (m *MsgClawbackEscrowGrant) String() string { return proto.CompactTextString(m) }
+func (*MsgClawbackEscrowGrant) ProtoMessage()    {}
+func (*MsgClawbackEscrowGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16767edf988ec07f, []int{44}
+}
+func (m *MsgClawbackEscrowGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClawbackEscrowGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClawbackEscrowGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClawbackEscrowGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClawbackEscrowGrant.Merge(m, src)
+}
+func (m *MsgClawbackEscrowGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClawbackEscrowGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClawbackEscrowGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClawbackEscrowGrant proto.InternalMessageInfo
+
+func (m *MsgClawbackEscrowGrant) GetFunderAddress() string {
+	if m != nil {
+		return m.FunderAddress
+	}
+	return ""
+}
+
+func (m *MsgClawbackEscrowGrant) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MsgClawbackEscrowGrant) GetDestAddress() string {
+	if m != nil {
+		return m.DestAddress
+	}
+	return ""
+}
+
+// MsgClawbackEscrowGrantResponse defines the MsgClawbackEscrowGrant response
+// type.
+type MsgClawbackEscrowGrantResponse struct {
+	// coins is the amount of coins clawed back
+	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+}
+
+func (m *MsgClawbackEscrowGrantResponse) Reset()         { *m = MsgClawbackEscrowGrantResponse{} }
+func (m *MsgClawbackEscrowGrantResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClawbackEscrowGrantResponse) ProtoMessage()    {}
+func (*MsgClawbackEscrowGrantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16767edf988ec07f, []int{45}
+}
+func (m *MsgClawbackEscrowGrantResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClawbackEscrowGrantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClawbackEscrowGrantResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClawbackEscrowGrantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClawbackEscrowGrantResponse.Merge(m, src)
+}
+func (m *MsgClawbackEscrowGrantResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClawbackEscrowGrantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClawbackEscrowGrantResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClawbackEscrowGrantResponse proto.InternalMessageInfo
+
+func (m *MsgClawbackEscrowGrantResponse) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
+// MsgCreateFunderCommittee defines a message that creates a funder committee.
+type MsgCreateFunderCommittee struct {
+	// creator is the address of the account that creates the committee
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// members are the addresses of the members of the committee
+	Members []string `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	// threshold is the number of member approvals required to execute a proposal
+	Threshold uint32 `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// proposal_lifetime is the duration in seconds after which a proposal that
+	// has not been executed expires
+	ProposalLifetime int64 `protobuf:"varint,4,opt,name=proposal_lifetime,json=proposalLifetime,proto3" json:"proposal_lifetime,omitempty"`
+}
+
+func (m *MsgCreateFunderCommittee) Reset()         { *m = MsgCreateFunderCommittee{} }
+func (m *MsgCreateFunderCommittee) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateFunderCommittee) ProtoMessage()    {}
+func (*MsgCreateFunderCommittee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16767edf988ec07f, []int{46}
+}
+func (m *MsgCreateFunderCommittee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateFunderCommittee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateFunderCommittee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateFunderCommittee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateFunderCommittee.Merge(m, src)
+}
+func (m *MsgCreateFunderCommittee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateFunderCommittee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateFunderCommittee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateFunderCommittee proto.InternalMessageInfo
+
+func (m *MsgCreateFunderCommittee) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgCreateFunderCommittee) GetMembers() []string {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+func (m *MsgCreateFunderCommittee) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *MsgCreateFunderCommittee) GetProposalLifetime() int64 {
+	if m != nil {
+		return m.ProposalLifetime
+	}
+	return 0
+}
+
+// MsgCreateFunderCommitteeResponse defines the MsgCreateFunderCommittee
+// response type.
+type MsgCreateFunderCommitteeResponse struct {
+	// address is the address of the created committee
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *MsgCreateFunderCommitteeResponse) Reset()         { *m = MsgCreateFunderCommitteeResponse{} }
+func (m *MsgCreateFunderCommitteeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateFunderCommitteeResponse) ProtoMessage()    {}
+func (*MsgCreateFunderCommitteeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16767edf988ec07f, []int{47}
+}
+func (m *MsgCreateFunderCommitteeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateFunderCommitteeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateFunderCommitteeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateFunderCommitteeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateFunderCommitteeResponse.Merge(m, src)
+}
+func (m *MsgCreateFunderCommitteeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateFunderCommitteeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateFunderCommitteeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateFunderCommitteeResponse proto.InternalMessageInfo
+
+func (m *MsgCreateFunderCommitteeResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// MsgSubmitCommitteeProposal defines a message that proposes an action on a
+// vesting account funded by a funder committee.
+type MsgSubmitCommitteeProposal struct {
+	// proposer is the address of the member that submits the proposal
+	Proposer string `protobuf:"bytes,1,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// committee_address is the address of the funder committee
+	CommitteeAddress string `protobuf:"bytes,2,opt,name=committee_address,json=committeeAddress,proto3" json:"committee_address,omitempty"`
+	// action is the action to execute
+	Action CommitteeAction `protobuf:"varint,3,opt,name=action,proto3,enum=vesting.v1.CommitteeAction" json:"action,omitempty"`
+	// vesting_address is the address of the ClawbackVestingAccount
+	VestingAddress string `protobuf:"bytes,4,opt,name=vesting_address,json=vestingAddress,proto3" json:"vesting_address,omitempty"`
+	// dest_address is the address that receives the clawed back coins of a
+	// clawback
+	DestAddress string `protobuf:"bytes,5,opt,name=dest_address,json=destAddress,proto3" json:"dest_address,omitempty"`
+	// new_funder_address is the new funder of a funder update
+	NewFunderAddress string `protobuf:"bytes,6,opt,name=new_funder_address,json=newFunderAddress,proto3" json:"new_funder_address,omitempty"`
+}
+
+func (m *MsgSubmitCommitteeProposal) Reset()         { *m = MsgSubmitCommitteeProposal{} }
+func (m *MsgSubmitCommitteeProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitCommitteeProposal) ProtoMessage()    {}
+func (*MsgSubmitCommitteeProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16767edf988ec07f, []int{48}
+}
+func (m *MsgSubmitCommitteeProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitCommitteeProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitCommitteeProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitCommitteeProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitCommitteeProposal.Merge(m, src)
+}
+func (m *MsgSubmitCommitteeProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitCommitteeProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitCommitteeProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitCommitteeProposal proto.InternalMessageInfo
+
+func (m *MsgSubmitCommitteeProposal) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *MsgSubmitCommitteeProposal) GetCommitteeAddress() string {
+	if m != nil {
+		return m.CommitteeAddress
+	}
+	return ""
+}
+
+func (m *MsgSubmitCommitteeProposal) GetAction() CommitteeAction {
+	if m != nil {
+		return m.Action
+	}
+	return COMMITTEE_ACTION_UNSPECIFIED
+}
+
+func (m *MsgSubmitCommitteeProposal) GetVestingAddress() string {
+	if m != nil {
+		return m.VestingAddress
+	}
+	return ""
+}
+
+func (m *MsgSubmitCommitteeProposal) GetDestAddress() string {
+	if m != nil {
+		return m.DestAddress
+	}
+	return ""
+}
+
+func (m *MsgSubmitCommitteeProposal) GetNewFunderAddress() string {
+	if m != nil {
+		return m.NewFunderAddress
+	}
+	return ""
+}
+
+// MsgSubmitCommitteeProposalResponse defines the MsgSubmitCommitteeProposal
+// response type.
+type MsgSubmitCommitteeProposalResponse struct {
+	// id is the identifier of the proposal
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// executed is true if the proposal was executed
+	Executed bool `protobuf:"varint,2,opt,name=executed,proto3" json:"executed,omitempty"`
+}
+
+func (m *MsgSubmitCommitteeProposalResponse) Reset()         { *m = MsgSubmitCommitteeProposalResponse{} }
+func (m *MsgSubmitCommitteeProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitCommitteeProposalResponse) ProtoMessage()    {}
+func (*MsgSubmitCommitteeProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16767edf988ec07f, []int{49}
+}
+func (m *MsgSubmitCommitteeProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitCommitteeProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitCommitteeProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitCommitteeProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitCommitteeProposalResponse.Merge(m, src)
+}
+func (m *MsgSubmitCommitteeProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitCommitteeProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitCommitteeProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitCommitteeProposalResponse proto.InternalMessageInfo
+
+func (m *MsgSubmitCommitteeProposalResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MsgSubmitCommitteeProposalResponse) GetExecuted() bool {
+	if m != nil {
+		return m.Executed
+	}
+	return false
+}
+
+// MsgApproveCommitteeProposal defines a message that approves a funder
+// committee proposal.
+type MsgApproveCommitteeProposal struct {
+	// member is the address of the committee member that approves the proposal
+	Member string `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	// id is the identifier of the proposal
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgApproveCommitteeProposal) Reset()         { *m = MsgApproveCommitteeProposal{} }
+func (m *MsgApproveCommitteeProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveCommitteeProposal) ProtoMessage()    {}
+func (*MsgApproveCommitteeProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16767edf988ec07f, []int{50}
+}
+func (m *MsgApproveCommitteeProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveCommitteeProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveCommitteeProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgApproveCommitteeProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveCommitteeProposal.Merge(m, src)
+}
+func (m *MsgApproveCommitteeProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveCommitteeProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveCommitteeProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgApproveCommitteeProposal proto.InternalMessageInfo
+
+func (m *MsgApproveCommitteeProposal) GetMember() string {
+	if m != nil {
+		return m.Member
+	}
+	return ""
+}
+
+func (m *MsgApproveCommitteeProposal) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// MsgApproveCommitteeProposalResponse defines the MsgApproveCommitteeProposal
+// response type.
+type MsgApproveCommitteeProposalResponse struct {
+	// executed is true if the proposal was executed
+	Executed bool `protobuf:"varint,1,opt,name=executed,proto3" json:"executed,omitempty"`
+}
+
+func (m *MsgApproveCommitteeProposalResponse) Reset()         { *m = MsgApproveCommitteeProposalResponse{} }
+func (m *MsgApproveCommitteeProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveCommitteeProposalResponse) ProtoMessage()    {}
+func (*MsgApproveCommitteeProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16767edf988ec07f, []int{51}
+}
+func (m *MsgApproveCommitteeProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveCommitteeProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveCommitteeProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgApproveCommitteeProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveCommitteeProposalResponse.Merge(m, src)
+}
+func (m *MsgApproveCommitteeProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveCommitteeProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveCommitteeProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgApproveCommitteeProposalResponse proto.InternalMessageInfo
+
+func (m *MsgApproveCommitteeProposalResponse) GetExecuted() bool {
+	if m != nil {
+		return m.Executed
+	}
+	return false
+}
+
+// MsgRecoverVestingAccount defines a message that migrates a
+// ClawbackVestingAccount to a new address.
+type MsgRecoverVestingAccount struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// vesting_address is the address of the vesting account to recover
+	VestingAddress string `protobuf:"bytes,2,opt,name=vesting_address,json=vestingAddress,proto3" json:"vesting_address,omitempty"`
+	// new_address is the address of the existing account that becomes the new
+	// owner of the vesting account
+	NewAddress string `protobuf:"bytes,3,opt,name=new_address,json=newAddress,proto3" json:"new_address,omitempty"`
+}
+
+func (m *MsgRecoverVestingAccount) Reset()         { *m = MsgRecoverVestingAccount{} }
+func (m *MsgRecoverVestingAccount) String() string { return proto.CompactTextString(m) }
+func (*MsgRecoverVestingAccount) ProtoMessage()    {}
+func (*MsgRecoverVestingAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16767edf988ec07f, []int{52}
+}
+func (m *MsgRecoverVestingAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRecoverVestingAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRecoverVestingAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRecoverVestingAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRecoverVestingAccount.Merge(m, src)
+}
+func (m *MsgRecoverVestingAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRecoverVestingAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRecoverVestingAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRecoverVestingAccount proto.InternalMessageInfo
+
+func (m *MsgRecoverVestingAccount) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRecoverVestingAccount) GetVestingAddress() string {
+	if m != nil {
+		return m.VestingAddress
+	}
+	return ""
+}
+
+func (m *MsgRecoverVestingAccount) GetNewAddress() string {
+	if m != nil {
+		return m.NewAddress
+	}
+	return ""
+}
+
+// MsgRecoverVestingAccountResponse defines the MsgRecoverVestingAccount
+// response type.
+type MsgRecoverVestingAccountResponse struct {
+	// coins is the balance transferred to the new address
+	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+}
+
+func (m *MsgRecoverVestingAccountResponse) Reset()         { *m = MsgRecoverVestingAccountResponse{} }
+func (m *MsgRecoverVestingAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRecoverVestingAccountResponse) ProtoMessage()    {}
+func (*MsgRecoverVestingAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16767edf988ec07f, []int{53}
+}
+func (m *MsgRecoverVestingAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRecoverVestingAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRecoverVestingAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRecoverVestingAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRecoverVestingAccountResponse.Merge(m, src)
+}
+func (m *MsgRecoverVestingAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRecoverVestingAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRecoverVestingAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRecoverVestingAccountResponse proto.InternalMessageInfo
+
+func (m *MsgRecoverVestingAccountResponse) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
+// MsgMergeVestingAccounts defines a message that merges the schedule, balance
+// and delegations of a ClawbackVestingAccount into another one.
+type MsgMergeVestingAccounts struct {
+	// funder_address is the funder of both vesting accounts
+	FunderAddress string `protobuf:"bytes,1,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	// source_address is the address of the vesting account that is merged and
+	// converted into a normal account
+	SourceAddress string `protobuf:"bytes,2,opt,name=source_address,json=sourceAddress,proto3" json:"source_address,omitempty"`
+	// target_address is the address of the vesting account that receives the
+	// schedule, balance and delegations of the source account
+	TargetAddress string `protobuf:"bytes,3,opt,name=target_address,json=targetAddress,proto3" json:"target_address,omitempty"`
+}
+
+func (m *MsgMergeVestingAccounts) Reset()         { *m = MsgMergeVestingAccounts{} }
+func (m *MsgMergeVestingAccounts) String() string { return proto.CompactTextString(m) }
+func (*MsgMergeVestingAccounts) ProtoMessage()    {}
+func (*MsgMergeVestingAccounts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16767edf988ec07f, []int{54}
+}
+func (m *MsgMergeVestingAccounts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMergeVestingAccounts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMergeVestingAccounts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMergeVestingAccounts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMergeVestingAccounts.Merge(m, src)
+}
+func (m *MsgMergeVestingAccounts) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMergeVestingAccounts) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMergeVestingAccounts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMergeVestingAccounts proto.InternalMessageInfo
+
+func (m *MsgMergeVestingAccounts) GetFunderAddress() string {
+	if m != nil {
+		return m.FunderAddress
+	}
+	return ""
+}
+
+func (m *MsgMergeVestingAccounts) GetSourceAddress() string {
+	if m != nil {
+		return m.SourceAddress
+	}
+	return ""
+}
+
+func (m *MsgMergeVestingAccounts) GetTargetAddress() string {
+	if m != nil {
+		return m.TargetAddress
+	}
+	return ""
+}
+
+// MsgMergeVestingAccountsResponse defines the MsgMergeVestingAccounts response
+// type.
+type MsgMergeVestingAccountsResponse struct {
+	// coins is the balance transferred to the target account
+	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+}
+
+func (m *MsgMergeVestingAccountsResponse) Reset()         { *m = MsgMergeVestingAccountsResponse{} }
+func (m *MsgMergeVestingAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMergeVestingAccountsResponse) ProtoMessage()    {}
+func (*MsgMergeVestingAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16767edf988ec07f, []int{55}
+}
+func (m *MsgMergeVestingAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMergeVestingAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMergeVestingAccountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMergeVestingAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMergeVestingAccountsResponse.Merge(m, src)
+}
+func (m *MsgMergeVestingAccountsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMergeVestingAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMergeVestingAccountsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMergeVestingAccountsResponse proto.InternalMessageInfo
+
+func (m *MsgMergeVestingAccountsResponse) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MsgCreateClawbackVestingAccount)(nil), "vesting.v1.MsgCreateClawbackVestingAccount")
+	proto.RegisterType((*MsgCreateClawbackVestingAccountResponse)(nil), "vesting.v1.MsgCreateClawbackVestingAccountResponse")
+	proto.RegisterType((*MsgFundVestingAccount)(nil), "vesting.v1.MsgFundVestingAccount")
+	proto.RegisterType((*MsgFundVestingAccountResponse)(nil), "vesting.v1.MsgFundVestingAccountResponse")
+	proto.RegisterType((*MsgClawback)(nil), "vesting.v1.MsgClawback")
+	proto.RegisterType((*ClawbackDestination)(nil), "vesting.v1.ClawbackDestination")
+	proto.RegisterType((*MsgClawbackResponse)(nil), "vesting.v1.MsgClawbackResponse")
+	proto.RegisterType((*MsgUpdateVestingFunder)(nil), "vesting.v1.MsgUpdateVestingFunder")
+	proto.RegisterType((*MsgUpdateVestingFunderResponse)(nil), "vesting.v1.MsgUpdateVestingFunderResponse")
+	proto.RegisterType((*MsgConvertVestingAccount)(nil), "vesting.v1.MsgConvertVestingAccount")
+	proto.RegisterType((*MsgConvertVestingAccountResponse)(nil), "vesting.v1.MsgConvertVestingAccountResponse")
+	proto.RegisterType((*MsgMigrateVestingAccount)(nil), "vesting.v1.MsgMigrateVestingAccount")
+	proto.RegisterType((*MsgMigrateVestingAccountResponse)(nil), "vesting.v1.MsgMigrateVestingAccountResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "vesting.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "vesting.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSetVestingManager)(nil), "vesting.v1.MsgSetVestingManager")
+	proto.RegisterType((*MsgSetVestingManagerResponse)(nil), "vesting.v1.MsgSetVestingManagerResponse")
+	proto.RegisterType((*MsgBatchClawback)(nil), "vesting.v1.MsgBatchClawback")
+	proto.RegisterType((*ClawbackResult)(nil), "vesting.v1.ClawbackResult")
+	proto.RegisterType((*MsgBatchClawbackResponse)(nil), "vesting.v1.MsgBatchClawbackResponse")
+	proto.RegisterType((*MsgRenounceClawback)(nil), "vesting.v1.MsgRenounceClawback")
+	proto.RegisterType((*MsgRenounceClawbackResponse)(nil), "vesting.v1.MsgRenounceClawbackResponse")
+	proto.RegisterType((*MsgPauseVesting)(nil), "vesting.v1.MsgPauseVesting")
+	proto.RegisterType((*MsgPauseVestingResponse)(nil), "vesting.v1.MsgPauseVestingResponse")
+	proto.RegisterType((*MsgResumeVesting)(nil), "vesting.v1.MsgResumeVesting")
+	proto.RegisterType((*MsgResumeVestingResponse)(nil), "vesting.v1.MsgResumeVestingResponse")
+	proto.RegisterType((*MsgUnlockVesting)(nil), "vesting.v1.MsgUnlockVesting")
+	proto.RegisterType((*MsgUnlockVestingResponse)(nil), "vesting.v1.MsgUnlockVestingResponse")
+	proto.RegisterType((*MsgProposeScheduleAmendment)(nil), "vesting.v1.MsgProposeScheduleAmendment")
+	proto.RegisterType((*MsgProposeScheduleAmendmentResponse)(nil), "vesting.v1.MsgProposeScheduleAmendmentResponse")
+	proto.RegisterType((*MsgAcceptScheduleAmendment)(nil), "vesting.v1.MsgAcceptScheduleAmendment")
+	proto.RegisterType((*MsgAcceptScheduleAmendmentResponse)(nil), "vesting.v1.MsgAcceptScheduleAmendmentResponse")
+	proto.RegisterType((*MsgSelfLockup)(nil), "vesting.v1.MsgSelfLockup")
+	proto.RegisterType((*MsgSelfLockupResponse)(nil), "vesting.v1.MsgSelfLockupResponse")
+	proto.RegisterType((*MsgFundVestingAccountFromCommunityPool)(nil), "vesting.v1.MsgFundVestingAccountFromCommunityPool")
+	proto.RegisterType((*MsgFundVestingAccountFromCommunityPoolResponse)(nil), "vesting.v1.MsgFundVestingAccountFromCommunityPoolResponse")
+	proto.RegisterType((*MsgCreateFundingInstruction)(nil), "vesting.v1.MsgCreateFundingInstruction")
+	proto.RegisterType((*MsgCreateFundingInstructionResponse)(nil), "vesting.v1.MsgCreateFundingInstructionResponse")
+	proto.RegisterType((*MsgCancelFundingInstruction)(nil), "vesting.v1.MsgCancelFundingInstruction")
+	proto.RegisterType((*MsgCancelFundingInstructionResponse)(nil), "vesting.v1.MsgCancelFundingInstructionResponse")
+	proto.RegisterType((*MsgCreateEscrowGrant)(nil), "vesting.v1.MsgCreateEscrowGrant")
+	proto.RegisterType((*MsgCreateEscrowGrantResponse)(nil), "vesting.v1.MsgCreateEscrowGrantResponse")
+	proto.RegisterType((*MsgClaimEscrowGrant)(nil), "vesting.v1.MsgClaimEscrowGrant")
+	proto.RegisterType((*MsgClaimEscrowGrantResponse)(nil), "vesting.v1.MsgClaimEscrowGrantResponse")
+	proto.RegisterType((*MsgClawbackEscrowGrant)(nil), "vesting.v1.MsgClawbackEscrowGrant")
+	proto.RegisterType((*MsgClawbackEscrowGrantResponse)(nil), "vesting.v1.MsgClawbackEscrowGrantResponse")
+	proto.RegisterType((*MsgCreateFunderCommittee)(nil), "vesting.v1.MsgCreateFunderCommittee")
+	proto.RegisterType((*MsgCreateFunderCommitteeResponse)(nil), "vesting.v1.MsgCreateFunderCommitteeResponse")
+	proto.RegisterType((*MsgSubmitCommitteeProposal)(nil), "vesting.v1.MsgSubmitCommitteeProposal")
+	proto.RegisterType((*MsgSubmitCommitteeProposalResponse)(nil), "vesting.v1.MsgSubmitCommitteeProposalResponse")
+	proto.RegisterType((*MsgApproveCommitteeProposal)(nil), "vesting.v1.MsgApproveCommitteeProposal")
+	proto.RegisterType((*MsgApproveCommitteeProposalResponse)(nil), "vesting.v1.MsgApproveCommitteeProposalResponse")
+	proto.RegisterType((*MsgRecoverVestingAccount)(nil), "vesting.v1.MsgRecoverVestingAccount")
+	proto.RegisterType((*MsgRecoverVestingAccountResponse)(nil), "vesting.v1.MsgRecoverVestingAccountResponse")
+	proto.RegisterType((*MsgMergeVestingAccounts)(nil), "vesting.v1.MsgMergeVestingAccounts")
+	proto.RegisterType((*MsgMergeVestingAccountsResponse)(nil), "vesting.v1.MsgMergeVestingAccountsResponse")
+}
+
+func init() { proto.RegisterFile("vesting/v1/tx.proto", fileDescriptor_16767edf988ec07f) }
+
+var fileDescriptor_16767edf988ec07f = []byte{
+	// 2287 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x4f, 0x6f, 0x1c, 0x49,
+	0x15, 0x77, 0x7b, 0x26, 0x5e, 0xe7, 0x39, 0x76, 0xec, 0xb6, 0x1d, 0x4f, 0x3a, 0xce, 0x8c, 0xd3,
+	0x76, 0xd6, 0x4e, 0x6c, 0xa6, 0x63, 0x67, 0x41, 0x5a, 0x6b, 0x2f, 0xb6, 0xd9, 0x44, 0x8b, 0x76,
+	0x24, 0x6b, 0xc2, 0xee, 0x01, 0x90, 0x46, 0x3d, 0x3d, 0xe5, 0x76, 0xe3, 0xe9, 0xee, 0xd9, 0xee,
+	0x9e, 0xb1, 0x73, 0x43, 0x0b, 0x88, 0x15, 0xa7, 0x15, 0x68, 0x0f, 0x5c, 0x10, 0x08, 0x24, 0x24,
+	0x90, 0x10, 0x77, 0x04, 0x37, 0x50, 0xb8, 0xad, 0xc4, 0x05, 0x2e, 0xec, 0x2a, 0x41, 0xc0, 0x07,
+	0xe0, 0x03, 0xac, 0xea, 0x4f, 0xd7, 0xf4, 0x54, 0x57, 0xcf, 0x74, 0xa2, 0x38, 0xd9, 0x43, 0x4e,
+	0x9e, 0xaa, 0xf7, 0xab, 0x7a, 0x7f, 0xeb, 0xd5, 0xab, 0xd7, 0x86, 0xf9, 0x1e, 0x0a, 0x23, 0xc7,
+	0xb3, 0x8d, 0xde, 0xb6, 0x11, 0x9d, 0x55, 0x3b, 0x81, 0x1f, 0xf9, 0x2a, 0xb0, 0xc9, 0x6a, 0x6f,
+	0x5b, 0x2b, 0x5b, 0x7e, 0xe8, 0xfa, 0xa1, 0xd1, 0x34, 0x43, 0x64, 0xf4, 0xb6, 0x9b, 0x28, 0x32,
+	0xb7, 0x0d, 0xcb, 0x77, 0x3c, 0x8a, 0xd5, 0x96, 0x18, 0xdd, 0x0d, 0xc9, 0x1e, 0x6e, 0x68, 0x33,
+	0xc2, 0x1a, 0x23, 0xf4, 0x19, 0xd0, 0xb5, 0xf1, 0xde, 0x14, 0xb5, 0x60, 0xfb, 0xb6, 0x4f, 0x7e,
+	0x1a, 0xf8, 0x17, 0x9b, 0x5d, 0xb6, 0x7d, 0xdf, 0x6e, 0x23, 0xc3, 0xec, 0x38, 0x86, 0xe9, 0x79,
+	0x7e, 0x64, 0x46, 0x8e, 0xef, 0x85, 0x8c, 0x5a, 0x61, 0x54, 0x32, 0x6a, 0x76, 0x8f, 0x8c, 0xc8,
+	0x71, 0x51, 0x18, 0x99, 0x6e, 0x87, 0x01, 0x4a, 0x09, 0xa5, 0x6c, 0xe4, 0xa1, 0xd0, 0x61, 0x4b,
+	0xf5, 0x3f, 0x2a, 0x50, 0xa9, 0x85, 0xf6, 0x41, 0x80, 0xcc, 0x08, 0x1d, 0xb4, 0xcd, 0xd3, 0xa6,
+	0x69, 0x9d, 0xbc, 0x4f, 0xd1, 0x7b, 0x96, 0xe5, 0x77, 0xbd, 0x48, 0xbd, 0x09, 0x33, 0x47, 0x5d,
+	0xaf, 0x85, 0x82, 0x86, 0xd9, 0x6a, 0x05, 0x28, 0x0c, 0x4b, 0xca, 0x8a, 0xb2, 0x71, 0xb1, 0x3e,
+	0x4d, 0x67, 0xf7, 0xe8, 0xa4, 0xba, 0x0e, 0x97, 0x19, 0x1b, 0x8e, 0x1b, 0x27, 0xb8, 0x19, 0x36,
+	0x1d, 0x03, 0xab, 0x30, 0x8f, 0x3c, 0xb3, 0xd9, 0x46, 0x0d, 0xdb, 0xef, 0x35, 0x2c, 0xc6, 0xb4,
+	0x54, 0x58, 0x51, 0x36, 0x26, 0xeb, 0x73, 0x94, 0x74, 0xdf, 0xef, 0xc5, 0xd2, 0xec, 0x96, 0xfe,
+	0xf7, 0x8b, 0xca, 0xd8, 0x87, 0xff, 0xfd, 0xc3, 0x6d, 0x71, 0x7f, 0xfd, 0x16, 0xac, 0x8f, 0x10,
+	0xbe, 0x8e, 0xc2, 0x8e, 0xef, 0x85, 0x48, 0xff, 0x67, 0x01, 0x16, 0x6b, 0xa1, 0x7d, 0xaf, 0xeb,
+	0xb5, 0xce, 0x59, 0xbd, 0x03, 0x80, 0x30, 0x32, 0x83, 0xa8, 0x81, 0xbd, 0x40, 0xb4, 0x9a, 0xda,
+	0xd1, 0xaa, 0xd4, 0x45, 0xd5, 0xd8, 0x45, 0xd5, 0x6f, 0xc6, 0x2e, 0xda, 0x9f, 0x7c, 0xf4, 0xaf,
+	0xca, 0xd8, 0xc7, 0x9f, 0x55, 0x94, 0xfa, 0x45, 0xb2, 0x0e, 0x53, 0xd4, 0x8f, 0x14, 0x98, 0x69,
+	0xfb, 0xd6, 0x49, 0xb7, 0xd3, 0xe8, 0xa0, 0xc0, 0xf1, 0x5b, 0x61, 0xa9, 0xb8, 0x52, 0xd8, 0x98,
+	0xda, 0x29, 0x57, 0x69, 0x18, 0x55, 0xfb, 0x21, 0x49, 0xc2, 0xa8, 0x7a, 0x48, 0x60, 0xfb, 0x7b,
+	0x78, 0xb7, 0xdf, 0x7e, 0x56, 0x79, 0xd3, 0x76, 0xa2, 0xe3, 0x6e, 0xb3, 0x6a, 0xf9, 0xae, 0xc1,
+	0x02, 0x8f, 0xfe, 0xf9, 0x4a, 0xd8, 0x3a, 0x31, 0xce, 0x0c, 0xb3, 0x1b, 0x1d, 0xf3, 0x50, 0x8c,
+	0x1e, 0x76, 0x50, 0xc8, 0x76, 0x08, 0xeb, 0xd3, 0x94, 0x31, 0x1b, 0xaa, 0x3f, 0x56, 0xfa, 0x9a,
+	0xc7, 0xb2, 0x5c, 0x78, 0x51, 0xb2, 0xc4, 0xc6, 0x65, 0xe3, 0xdd, 0x79, 0x1c, 0x07, 0x82, 0xbf,
+	0xf4, 0x0a, 0x5c, 0x97, 0xba, 0x96, 0x3b, 0xff, 0xff, 0x0a, 0x4c, 0xe1, 0x40, 0x61, 0x21, 0xf2,
+	0x14, 0x2e, 0x37, 0xe9, 0x4e, 0xa2, 0xcb, 0xd9, 0x74, 0x0c, 0xbc, 0x01, 0x97, 0x5a, 0x28, 0xec,
+	0xa3, 0x0a, 0x04, 0x35, 0x85, 0xe7, 0x62, 0x88, 0x0a, 0xc5, 0x66, 0x37, 0xf0, 0x4a, 0x45, 0x12,
+	0xe5, 0xe4, 0xb7, 0xfa, 0x0e, 0x5d, 0xe6, 0x78, 0xf4, 0x34, 0x33, 0xab, 0x56, 0x12, 0xe6, 0xac,
+	0xc6, 0x22, 0x7f, 0xbd, 0x8f, 0xdb, 0x2f, 0x62, 0xb3, 0xd6, 0x07, 0x96, 0xca, 0xed, 0x72, 0x1f,
+	0xe6, 0x25, 0xeb, 0xd5, 0x12, 0xbc, 0x36, 0xa8, 0x76, 0x3c, 0x54, 0xaf, 0xc0, 0xc4, 0x29, 0x72,
+	0xec, 0xe3, 0x88, 0xe8, 0x59, 0xac, 0xb3, 0x91, 0xbe, 0x08, 0xf3, 0x09, 0xf3, 0x71, 0xb3, 0xfe,
+	0x4e, 0x81, 0x2b, 0xb5, 0xd0, 0x7e, 0xaf, 0xd3, 0x32, 0x23, 0xc4, 0x4c, 0x7f, 0x8f, 0x88, 0x90,
+	0xd7, 0xc2, 0x5b, 0xa0, 0x7a, 0xe8, 0xb4, 0x21, 0x40, 0xa9, 0x91, 0x67, 0x3d, 0x74, 0x7a, 0x6f,
+	0xd4, 0x11, 0x2c, 0xc8, 0x8e, 0xa0, 0xdc, 0x1a, 0x2b, 0x50, 0x96, 0x0b, 0xcb, 0xf5, 0x39, 0x80,
+	0x12, 0x56, 0xd3, 0xf7, 0x7a, 0x28, 0x88, 0x84, 0x2c, 0x21, 0xe1, 0xad, 0xc8, 0x78, 0xeb, 0x3a,
+	0xac, 0x64, 0x6d, 0xc2, 0x19, 0x3d, 0x52, 0x08, 0xa7, 0x9a, 0x63, 0x07, 0x7d, 0x61, 0x62, 0x4e,
+	0xcb, 0x70, 0x11, 0x1f, 0x08, 0x3f, 0x70, 0xa2, 0x87, 0x8c, 0x47, 0x7f, 0x22, 0x7f, 0x1a, 0x4a,
+	0x7b, 0xa0, 0x20, 0xf3, 0x40, 0x46, 0x32, 0x2e, 0x66, 0x25, 0xe3, 0x19, 0x6c, 0xda, 0xbe, 0x3c,
+	0x4c, 0x5d, 0xa9, 0x26, 0x5c, 0xdd, 0x0f, 0xe0, 0x32, 0xb7, 0xfc, 0xa1, 0x19, 0x98, 0x6e, 0x38,
+	0x42, 0xc9, 0x3b, 0x30, 0xd1, 0x21, 0x38, 0xa2, 0xdb, 0xd4, 0x8e, 0x9a, 0x3c, 0x12, 0x74, 0x07,
+	0x76, 0x0a, 0x18, 0x2e, 0x25, 0xd6, 0x55, 0x58, 0x12, 0x58, 0x72, 0x69, 0x7e, 0xad, 0xc0, 0x42,
+	0x2d, 0xb4, 0x1f, 0xa0, 0xd8, 0x3b, 0x35, 0xd3, 0x33, 0xed, 0xfc, 0x31, 0x9b, 0xdb, 0x03, 0xeb,
+	0x70, 0xd9, 0xa5, 0x5b, 0x8b, 0xe1, 0xca, 0xa6, 0x87, 0x86, 0x6b, 0x19, 0x96, 0x65, 0x52, 0x72,
+	0x35, 0xfe, 0xa2, 0xc0, 0x6c, 0x2d, 0xb4, 0xf7, 0xcd, 0xc8, 0x3a, 0xe6, 0x89, 0x6d, 0xb8, 0x59,
+	0x37, 0x61, 0x4e, 0xc8, 0x67, 0x08, 0xcb, 0x5e, 0xc0, 0x87, 0x6d, 0x30, 0xa3, 0xa1, 0x5c, 0x39,
+	0xad, 0x02, 0x53, 0x4d, 0x0c, 0x41, 0x47, 0x47, 0x7e, 0x10, 0xb1, 0x98, 0x01, 0x3c, 0xf5, 0x36,
+	0x99, 0xe1, 0x49, 0xef, 0x42, 0x3f, 0xe9, 0xa5, 0x3c, 0xf5, 0x27, 0x05, 0x66, 0x12, 0x99, 0xa5,
+	0xdb, 0x8e, 0x64, 0x79, 0x57, 0x91, 0xe6, 0xdd, 0x36, 0x4c, 0xe1, 0x88, 0x45, 0xad, 0x06, 0x09,
+	0xda, 0x71, 0x92, 0x3f, 0xaf, 0xc6, 0xb7, 0x12, 0xae, 0xd0, 0xf8, 0x95, 0x74, 0xe0, 0x3b, 0xde,
+	0xfe, 0x1d, 0x76, 0x21, 0x6d, 0x0c, 0xbd, 0x90, 0xe8, 0x0d, 0x84, 0x17, 0x84, 0x75, 0xa0, 0xfb,
+	0xef, 0x63, 0xe3, 0x2e, 0xc0, 0x05, 0x14, 0x04, 0x7e, 0xc0, 0x4c, 0x41, 0x07, 0xfa, 0xfb, 0x50,
+	0x12, 0xdd, 0x10, 0xfb, 0x48, 0xdd, 0x85, 0xd7, 0x02, 0xa2, 0x12, 0x56, 0xa0, 0x40, 0xea, 0x00,
+	0x49, 0x6e, 0xa7, 0x5a, 0xb3, 0x80, 0x8e, 0x17, 0xe8, 0xbf, 0x57, 0x48, 0xd2, 0xad, 0x23, 0xcf,
+	0xef, 0x7a, 0x16, 0x7a, 0x86, 0xbb, 0x2b, 0x5f, 0x94, 0xee, 0xc0, 0x62, 0xc0, 0x78, 0xc8, 0xea,
+	0xb1, 0xf9, 0x98, 0x98, 0x4c, 0x02, 0xd2, 0x80, 0xbd, 0x0e, 0xd7, 0x24, 0xf2, 0xf2, 0x78, 0x3d,
+	0x26, 0x49, 0xe0, 0xd0, 0xec, 0x86, 0x71, 0x9a, 0x78, 0x4e, 0x99, 0x2e, 0xe3, 0xec, 0x27, 0x39,
+	0x71, 0x21, 0x1c, 0x72, 0x66, 0xb0, 0xc1, 0xdd, 0xf3, 0x96, 0x42, 0x83, 0x92, 0xc8, 0x8a, 0x8b,
+	0xe1, 0x12, 0x31, 0xde, 0xf3, 0x70, 0xa1, 0x95, 0x4f, 0x8c, 0x4d, 0x98, 0x13, 0xc4, 0xe8, 0x1f,
+	0xdd, 0x41, 0x41, 0x50, 0x96, 0x28, 0x03, 0xec, 0xb8, 0x28, 0x3f, 0x2f, 0x10, 0xb7, 0x1d, 0x06,
+	0x7e, 0xc7, 0x0f, 0xd1, 0x03, 0xeb, 0x18, 0xb5, 0xba, 0x6d, 0xb4, 0xe7, 0x22, 0xaf, 0xe5, 0xa2,
+	0x73, 0xa8, 0x8e, 0x25, 0x85, 0x6d, 0xe1, 0x4b, 0x54, 0xd8, 0x16, 0x5f, 0x56, 0x61, 0xbb, 0x14,
+	0x3f, 0x72, 0xc4, 0x63, 0x75, 0x13, 0x56, 0x87, 0xf8, 0x87, 0xfb, 0xf1, 0xdb, 0xa0, 0xd5, 0x42,
+	0x7c, 0xf3, 0xa2, 0x4e, 0x94, 0xf6, 0x62, 0xde, 0xea, 0x65, 0x77, 0x41, 0xfa, 0xce, 0x5a, 0x03,
+	0x3d, 0x7b, 0x73, 0x2e, 0xc2, 0x5f, 0x15, 0x98, 0x26, 0x57, 0x56, 0xfb, 0xe8, 0x5d, 0x62, 0xe8,
+	0x21, 0x95, 0xa6, 0x24, 0x0c, 0xc6, 0x5f, 0x4e, 0x18, 0xec, 0xce, 0xc6, 0x96, 0x8f, 0x85, 0xd3,
+	0x97, 0x60, 0x71, 0x40, 0x0f, 0xae, 0xe1, 0xe7, 0x05, 0x78, 0x5d, 0xfa, 0xd2, 0xb8, 0x17, 0xf8,
+	0xee, 0x81, 0xef, 0xba, 0x5d, 0xcf, 0x89, 0x1e, 0x1e, 0xfa, 0x7e, 0xfb, 0x79, 0x55, 0x71, 0xaf,
+	0x1e, 0x93, 0xe7, 0x78, 0xe6, 0xd4, 0xd8, 0xf3, 0x89, 0x5c, 0x79, 0x07, 0xaa, 0xf9, 0x3c, 0xcc,
+	0x83, 0xe2, 0x23, 0x9a, 0x41, 0x69, 0x17, 0x02, 0x2f, 0x74, 0x3c, 0xfb, 0x1d, 0x2f, 0x8c, 0x82,
+	0xae, 0x45, 0x9e, 0x5b, 0x5f, 0xca, 0xfe, 0x82, 0x06, 0x93, 0x8e, 0x17, 0xa1, 0xa0, 0x67, 0xb6,
+	0x49, 0xdd, 0x56, 0xa8, 0xf3, 0x31, 0xae, 0x73, 0x88, 0xca, 0xa4, 0x6c, 0x2b, 0xd6, 0xe9, 0x40,
+	0x16, 0x44, 0x13, 0x2f, 0xe9, 0xc4, 0x66, 0xe6, 0xca, 0xaf, 0xc2, 0xea, 0x10, 0x4f, 0xf0, 0xb2,
+	0x6c, 0x06, 0xc6, 0x9d, 0x16, 0xf1, 0x42, 0xb1, 0x3e, 0xee, 0xb4, 0x74, 0x87, 0x3a, 0xd0, 0xf4,
+	0x2c, 0xd4, 0x7e, 0x76, 0x07, 0xd2, 0x5d, 0xc7, 0xe3, 0x5d, 0x77, 0xe7, 0xb3, 0xb3, 0x79, 0x16,
+	0xab, 0x64, 0xa2, 0x59, 0xe0, 0x9a, 0xbc, 0x1d, 0x5a, 0x81, 0x7f, 0x7a, 0x3f, 0x30, 0xf3, 0x5f,
+	0xc7, 0x9b, 0x30, 0x17, 0x20, 0xcb, 0xe9, 0x38, 0x28, 0xd5, 0xbb, 0x98, 0xe5, 0x84, 0x57, 0x39,
+	0xe6, 0x65, 0xde, 0xeb, 0x55, 0x58, 0x96, 0x79, 0x38, 0x33, 0x48, 0xbf, 0x1b, 0xf7, 0x60, 0x1c,
+	0x37, 0x19, 0x10, 0x52, 0x4f, 0x2b, 0x19, 0x9e, 0x16, 0x43, 0xf4, 0x0a, 0x16, 0x2c, 0xbd, 0x5e,
+	0xff, 0x9e, 0x02, 0xd7, 0x24, 0xcc, 0xb8, 0x6c, 0x26, 0xce, 0x10, 0x8e, 0x17, 0xbf, 0x6a, 0x9e,
+	0xeb, 0x8b, 0x8b, 0xee, 0xac, 0xff, 0x88, 0xf6, 0x96, 0xe2, 0x67, 0xc4, 0x33, 0x9c, 0x01, 0x41,
+	0xd9, 0x1c, 0x0f, 0x5a, 0xf9, 0x91, 0xfd, 0xbe, 0x02, 0x65, 0xb9, 0x24, 0x2f, 0xd2, 0x1e, 0xbf,
+	0xa1, 0x2d, 0xa3, 0x7e, 0x6e, 0x43, 0x01, 0xbe, 0x8e, 0x9c, 0x28, 0x42, 0x08, 0xd7, 0x59, 0x16,
+	0x26, 0xf8, 0x41, 0x5c, 0x67, 0xb1, 0x21, 0xa6, 0xb8, 0xc8, 0x6d, 0xa2, 0x20, 0x7e, 0x2d, 0xc4,
+	0x43, 0x5c, 0xa0, 0x44, 0xc7, 0x01, 0x0a, 0x8f, 0xfd, 0x76, 0x8b, 0xd8, 0x62, 0xba, 0xde, 0x9f,
+	0xc0, 0x61, 0xd5, 0x21, 0x25, 0xa7, 0xd9, 0x6e, 0xb4, 0x9d, 0x23, 0x44, 0x52, 0x03, 0xbd, 0x28,
+	0x66, 0x63, 0xc2, 0xbb, 0x6c, 0x7e, 0xf7, 0x12, 0xa9, 0x9e, 0x18, 0x4b, 0xfd, 0x2d, 0xda, 0x00,
+	0x93, 0x09, 0xca, 0x0d, 0x96, 0x59, 0x18, 0xea, 0xbf, 0x1a, 0x27, 0x85, 0xec, 0x83, 0x6e, 0xd3,
+	0x75, 0x22, 0xbe, 0xf0, 0x90, 0xb1, 0xc4, 0xf7, 0x16, 0x65, 0x8f, 0x62, 0x55, 0xf9, 0x18, 0xcb,
+	0x6c, 0xc5, 0x0b, 0xc4, 0xa4, 0xc7, 0x09, 0x71, 0x74, 0xdc, 0x85, 0x09, 0x93, 0xe4, 0x5c, 0xa2,
+	0xfb, 0xcc, 0xce, 0xb5, 0x81, 0x97, 0x39, 0x47, 0xd3, 0xb4, 0xcc, 0xa0, 0xb2, 0x3b, 0xba, 0x28,
+	0xbd, 0xa3, 0xc5, 0x58, 0xbb, 0x90, 0x6e, 0x9e, 0xc8, 0x5b, 0x9f, 0x13, 0xf2, 0xd6, 0xe7, 0xee,
+	0x34, 0x36, 0x31, 0x57, 0x55, 0x3f, 0x04, 0x3d, 0xdb, 0x48, 0x59, 0x29, 0x04, 0x1b, 0x0f, 0x9d,
+	0x21, 0xab, 0x1b, 0x21, 0x7a, 0x2e, 0x26, 0xeb, 0x7c, 0xac, 0xd7, 0xc9, 0x89, 0xdf, 0xeb, 0x74,
+	0x02, 0xbf, 0x87, 0xd2, 0x76, 0xbf, 0x02, 0x13, 0x34, 0x70, 0x98, 0xd5, 0xd9, 0x28, 0x95, 0x51,
+	0xa6, 0xb0, 0x9c, 0x8c, 0xa8, 0xef, 0xc1, 0xea, 0x90, 0x3d, 0xb9, 0x98, 0x49, 0xb1, 0x14, 0x41,
+	0xac, 0x9f, 0x28, 0xec, 0x19, 0x6d, 0xf9, 0x3d, 0x14, 0x9c, 0x4f, 0xa7, 0xb4, 0x02, 0x53, 0xd8,
+	0x13, 0x83, 0x79, 0x01, 0x3c, 0x74, 0x9a, 0xf5, 0xb4, 0xff, 0xa1, 0x02, 0x2b, 0x59, 0x42, 0xbd,
+	0xc8, 0x9c, 0xf0, 0x37, 0x85, 0x74, 0x3a, 0x6a, 0x28, 0xb0, 0x85, 0xd6, 0x6b, 0x98, 0x37, 0x49,
+	0xde, 0x84, 0x99, 0xd0, 0xef, 0x06, 0x96, 0x78, 0x60, 0xa6, 0xe9, 0x6c, 0x02, 0x16, 0x99, 0x81,
+	0x8d, 0xc4, 0xec, 0x39, 0x4d, 0x67, 0x63, 0x43, 0xbd, 0x29, 0xc9, 0x9f, 0x64, 0x6a, 0x90, 0x07,
+	0x99, 0x1a, 0xdc, 0x4f, 0xff, 0x01, 0xfd, 0x10, 0x29, 0xd3, 0xe5, 0x05, 0x9a, 0x74, 0xe7, 0x3f,
+	0x4b, 0x50, 0xa8, 0x85, 0xb6, 0xfa, 0x67, 0x05, 0x96, 0x87, 0x7e, 0x14, 0xdd, 0x4c, 0xe6, 0x8b,
+	0x11, 0x1f, 0x21, 0xb5, 0xbb, 0x4f, 0x01, 0xe6, 0x35, 0xe0, 0x5b, 0x1f, 0xfe, 0xfd, 0xdf, 0x3f,
+	0x1d, 0xff, 0x9a, 0xfa, 0x86, 0x81, 0x7a, 0x83, 0xdf, 0x8d, 0x8d, 0xe8, 0xcc, 0x20, 0xd9, 0x16,
+	0xf1, 0x6e, 0x5d, 0x83, 0x87, 0x3a, 0x93, 0xef, 0x13, 0x05, 0x54, 0xc9, 0xc7, 0xce, 0x1b, 0x82,
+	0x24, 0x69, 0x88, 0x76, 0x6b, 0x24, 0x84, 0x8b, 0xb8, 0x4d, 0x44, 0xdc, 0x54, 0x6f, 0x49, 0x45,
+	0xc4, 0x71, 0x90, 0x92, 0xeb, 0x04, 0x26, 0x79, 0x2b, 0x73, 0x49, 0x34, 0x0b, 0x23, 0x68, 0x95,
+	0x0c, 0x02, 0x67, 0x7c, 0x93, 0x30, 0xae, 0xa8, 0xd7, 0xe5, 0xb6, 0x89, 0x19, 0xfc, 0x4c, 0x81,
+	0x79, 0xd9, 0xd7, 0x29, 0x5d, 0xd8, 0x5f, 0x82, 0xd1, 0x6e, 0x8f, 0xc6, 0x70, 0x71, 0x76, 0x88,
+	0x38, 0x5b, 0xea, 0x6d, 0xa9, 0x38, 0x5d, 0xb2, 0x92, 0x5b, 0x82, 0x1e, 0x0f, 0xf5, 0x97, 0x0a,
+	0x2c, 0xca, 0x3f, 0x35, 0xad, 0x89, 0xda, 0xcb, 0x50, 0xda, 0x56, 0x1e, 0x14, 0x97, 0xf0, 0x0d,
+	0x22, 0x61, 0x55, 0xdd, 0x92, 0x1b, 0x8c, 0xae, 0x95, 0x38, 0x6b, 0x51, 0xfe, 0x8d, 0x4a, 0x14,
+	0x51, 0x8a, 0xd2, 0xb6, 0xf2, 0xa0, 0xf8, 0xe9, 0x3e, 0x84, 0x4b, 0x03, 0x9f, 0x88, 0xae, 0x49,
+	0x1d, 0x40, 0x89, 0xda, 0xea, 0x10, 0x22, 0xdf, 0xb1, 0x01, 0x73, 0xe9, 0xaf, 0x3c, 0x2b, 0xc2,
+	0xca, 0x14, 0x42, 0xdb, 0x18, 0x85, 0xe0, 0x0c, 0x1e, 0xc0, 0xb4, 0xf0, 0xfd, 0x45, 0x58, 0x3a,
+	0x40, 0xd5, 0xd6, 0x86, 0x51, 0xf9, 0xa6, 0xdf, 0x81, 0xd9, 0x54, 0xd3, 0x5f, 0x3c, 0x10, 0x22,
+	0x40, 0x5b, 0x1f, 0x01, 0x48, 0x5a, 0x79, 0xa0, 0x07, 0x2f, 0x5a, 0x39, 0x49, 0xd4, 0x56, 0x87,
+	0x10, 0x93, 0x46, 0x10, 0x1a, 0xea, 0x29, 0x59, 0x12, 0x54, 0x6d, 0x6d, 0x18, 0x35, 0xb9, 0xa9,
+	0xd0, 0x1e, 0x17, 0x1d, 0x9e, 0xa4, 0x6a, 0x6b, 0xc3, 0xa8, 0x7c, 0xd3, 0x08, 0x4a, 0x99, 0x7d,
+	0x6e, 0xd1, 0x80, 0x59, 0x40, 0xcd, 0xc8, 0x09, 0xe4, 0x5c, 0x3f, 0x80, 0xa5, 0xac, 0xb6, 0xec,
+	0xeb, 0xc2, 0x5e, 0x19, 0x38, 0xad, 0x9a, 0x0f, 0xc7, 0x59, 0x7e, 0x03, 0x20, 0xd1, 0x85, 0xbd,
+	0x9a, 0x8a, 0xe7, 0x98, 0xa4, 0xdd, 0xc8, 0x24, 0xf1, 0xbd, 0x3e, 0x51, 0x60, 0x35, 0x4f, 0xc3,
+	0x73, 0x67, 0xe4, 0xb5, 0x91, 0x5a, 0xa3, 0xed, 0x3e, 0xfd, 0x9a, 0xa4, 0x33, 0x33, 0x5b, 0x6e,
+	0xeb, 0xd2, 0xfb, 0x36, 0x0d, 0xd4, 0x8c, 0x9c, 0xc0, 0x01, 0xae, 0x59, 0x7d, 0xa2, 0x14, 0xd7,
+	0x0c, 0xa0, 0x66, 0xe4, 0x04, 0x26, 0x13, 0x59, 0xba, 0x15, 0xb4, 0x22, 0x95, 0x3d, 0x81, 0xd0,
+	0x36, 0x46, 0x21, 0x92, 0x39, 0x27, 0xd5, 0x59, 0x90, 0x5c, 0xc2, 0x03, 0x00, 0x6d, 0x7d, 0x04,
+	0x80, 0xef, 0x8e, 0xfa, 0xff, 0x87, 0x92, 0x64, 0xa0, 0x67, 0xdc, 0xf2, 0x49, 0x1e, 0xb7, 0x47,
+	0x63, 0x38, 0x9b, 0x13, 0x58, 0x94, 0x3f, 0x8f, 0xd7, 0x32, 0xbd, 0x9c, 0x40, 0x69, 0x5b, 0x79,
+	0x50, 0xc9, 0x53, 0x9d, 0xf5, 0x46, 0x15, 0x4f, 0x75, 0x06, 0x4e, 0xab, 0xe6, 0xc3, 0x25, 0x63,
+	0x2f, 0xf3, 0x7d, 0x26, 0xfa, 0x22, 0x0b, 0xa8, 0x19, 0x39, 0x81, 0x49, 0xab, 0xca, 0x5f, 0x5f,
+	0xe9, 0x44, 0x2e, 0x41, 0x69, 0x5b, 0x79, 0x50, 0x9c, 0xd9, 0x31, 0x2c, 0x48, 0x5f, 0x33, 0xe2,
+	0x45, 0x24, 0x03, 0x69, 0x9b, 0x39, 0x40, 0x31, 0xa7, 0xfd, 0xfd, 0x47, 0x8f, 0xcb, 0xca, 0xa7,
+	0x8f, 0xcb, 0xca, 0xe7, 0x8f, 0xcb, 0xca, 0xc7, 0x4f, 0xca, 0x63, 0x9f, 0x3e, 0x29, 0x8f, 0xfd,
+	0xe3, 0x49, 0x79, 0xec, 0x5b, 0xc9, 0x37, 0xc3, 0x60, 0xb1, 0x74, 0x36, 0xd8, 0xf3, 0x6b, 0x4e,
+	0x90, 0xe6, 0xe8, 0xdd, 0x2f, 0x06, 0x00, 0xe9, 0x4b, 0x32, 0xd4, 0x34, 0x2a, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// CreateClawbackVestingAccount creats a vesting account that is subject to clawback.
+	CreateClawbackVestingAccount(ctx context.Context, in *MsgCreateClawbackVestingAccount, opts ...grpc.CallOption) (*MsgCreateClawbackVestingAccountResponse, error)
+	// FundVestingAccount funds an existing ClawbackVestingAccount with tokens
+	// according to the vesting and lockup schedules.
+	FundVestingAccount(ctx context.Context, in *MsgFundVestingAccount, opts ...grpc.CallOption) (*MsgFundVestingAccountResponse, error)
+	// Clawback removes the unvested tokens from a ClawbackVestingAccount.
+	Clawback(ctx context.Context, in *MsgClawback, opts ...grpc.CallOption) (*MsgClawbackResponse, error)
+	// UpdateVestingFunder updates the funder address of an existing
+	// ClawbackVestingAccount.
+	UpdateVestingFunder(ctx context.Context, in *MsgUpdateVestingFunder, opts ...grpc.CallOption) (*MsgUpdateVestingFunderResponse, error)
+	// ConvertVestingAccount converts a ClawbackVestingAccount to an Eth account
+	ConvertVestingAccount(ctx context.Context, in *MsgConvertVestingAccount, opts ...grpc.CallOption) (*MsgConvertVestingAccountResponse, error)
+	// MigrateVestingAccount converts an x/auth/vesting account (continuous,
+	// delayed or periodic) into a ClawbackVestingAccount. It can only be
+	// executed by the governance module account.
+	MigrateVestingAccount(ctx context.Context, in *MsgMigrateVestingAccount, opts ...grpc.CallOption) (*MsgMigrateVestingAccountResponse, error)
+	// UpdateParams defines a governance operation for updating the x/vesting
+	// module parameters. The authority is hard-coded to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetVestingManager sets or clears the manager address of an existing
+	// ClawbackVestingAccount.
+	SetVestingManager(ctx context.Context, in *MsgSetVestingManager, opts ...grpc.CallOption) (*MsgSetVestingManagerResponse, error)
+	// BatchClawback defines a governance operation for clawing back the unvested
+	// tokens of multiple ClawbackVestingAccounts. The authority is hard-coded to
+	// the x/gov module account.
+	BatchClawback(ctx context.Context, in *MsgBatchClawback, opts ...grpc.CallOption) (*MsgBatchClawbackResponse, error)
+	// RenounceClawback permanently waives the clawback rights of the funder of
+	// an existing ClawbackVestingAccount.
+	RenounceClawback(ctx context.Context, in *MsgRenounceClawback, opts ...grpc.CallOption) (*MsgRenounceClawbackResponse, error)
+	// PauseVesting defines a governance operation for pausing the vesting of an
+	// existing ClawbackVestingAccount. The authority is hard-coded to the x/gov
+	// module account.
+	PauseVesting(ctx context.Context, in *MsgPauseVesting, opts ...grpc.CallOption) (*MsgPauseVestingResponse, error)
+	// ResumeVesting defines a governance operation for resuming the paused
+	// vesting of a ClawbackVestingAccount, shifting its future vesting events by
+	// the pause duration. The authority is hard-coded to the x/gov module
+	// account.
+	ResumeVesting(ctx context.Context, in *MsgResumeVesting, opts ...grpc.CallOption) (*MsgResumeVestingResponse, error)
+	// UnlockVesting defines a governance operation for unlocking all the lockup
+	// periods of ClawbackVestingAccounts. The authority is hard-coded to the
+	// x/gov module account.
+	UnlockVesting(ctx context.Context, in *MsgUnlockVesting, opts ...grpc.CallOption) (*MsgUnlockVestingResponse, error)
+	// ProposeScheduleAmendment defines a method for the funder of a
+	// ClawbackVestingAccount to propose new future lockup and vesting periods.
+	ProposeScheduleAmendment(ctx context.Context, in *MsgProposeScheduleAmendment, opts ...grpc.CallOption) (*MsgProposeScheduleAmendmentResponse, error)
+	// AcceptScheduleAmendment defines a method for a ClawbackVestingAccount to
+	// accept the schedule amendment proposed by its funder.
+	AcceptScheduleAmendment(ctx context.Context, in *MsgAcceptScheduleAmendment, opts ...grpc.CallOption) (*MsgAcceptScheduleAmendmentResponse, error)
+	// SelfLockup defines a method for any account to lock up a portion of its
+	// own balance under a lockup schedule, with no clawback rights.
+	SelfLockup(ctx context.Context, in *MsgSelfLockup, opts ...grpc.CallOption) (*MsgSelfLockupResponse, error)
+	// FundVestingAccountFromCommunityPool defines a governance operation for
+	// funding a ClawbackVestingAccount from the community pool, with governance
+	// as the funder. The authority is hard-coded to the x/gov module account.
+	FundVestingAccountFromCommunityPool(ctx context.Context, in *MsgFundVestingAccountFromCommunityPool, opts ...grpc.CallOption) (*MsgFundVestingAccountFromCommunityPoolResponse, error)
+	// CreateFundingInstruction defines a method for the funder of a
+	// ClawbackVestingAccount to register a recurring funding executed in
+	// EndBlock.
+	CreateFundingInstruction(ctx context.Context, in *MsgCreateFundingInstruction, opts ...grpc.CallOption) (*MsgCreateFundingInstructionResponse, error)
+	// CancelFundingInstruction defines a method for a funder to cancel one of
+	// its recurring funding instructions.
+	CancelFundingInstruction(ctx context.Context, in *MsgCancelFundingInstruction, opts ...grpc.CallOption) (*MsgCancelFundingInstructionResponse, error)
+	// CreateEscrowGrant defines a method for funding a vesting grant held in
+	// escrow by the vesting module account.
+	CreateEscrowGrant(ctx context.Context, in *MsgCreateEscrowGrant, opts ...grpc.CallOption) (*MsgCreateEscrowGrantResponse, error)
+	// ClaimEscrowGrant defines a method for the recipient of an escrow grant to
+	// claim its vested and unlocked coins.
+	ClaimEscrowGrant(ctx context.Context, in *MsgClaimEscrowGrant, opts ...grpc.CallOption) (*MsgClaimEscrowGrantResponse, error)
+	// ClawbackEscrowGrant defines a method for the funder of an escrow grant to
+	// claw back its unvested coins.
+	ClawbackEscrowGrant(ctx context.Context, in *MsgClawbackEscrowGrant, opts ...grpc.CallOption) (*MsgClawbackEscrowGrantResponse, error)
+	// CreateFunderCommittee defines a method for creating a funder committee.
+	CreateFunderCommittee(ctx context.Context, in *MsgCreateFunderCommittee, opts ...grpc.CallOption) (*MsgCreateFunderCommitteeResponse, error)
+	// SubmitCommitteeProposal defines a method for a member of a funder
+	// committee to propose an action on a vesting account funded by the
+	// committee.
+	SubmitCommitteeProposal(ctx context.Context, in *MsgSubmitCommitteeProposal, opts ...grpc.CallOption) (*MsgSubmitCommitteeProposalResponse, error)
+	// ApproveCommitteeProposal defines a method for a member of a funder
+	// committee to approve a proposal, which is executed once the threshold is
+	// reached.
+	ApproveCommitteeProposal(ctx context.Context, in *MsgApproveCommitteeProposal, opts ...grpc.CallOption) (*MsgApproveCommitteeProposalResponse, error)
+	// RecoverVestingAccount defines a governance operation for migrating the
+	// balance, delegations and schedule of a ClawbackVestingAccount to a new
+	// address. The authority is hard-coded to the x/gov module account.
+	RecoverVestingAccount(ctx context.Context, in *MsgRecoverVestingAccount, opts ...grpc.CallOption) (*MsgRecoverVestingAccountResponse, error)
+	// MergeVestingAccounts defines a method for merging two
+	// ClawbackVestingAccounts with the same funder, signed by both accounts and
+	// the funder.
+	MergeVestingAccounts(ctx context.Context, in *MsgMergeVestingAccounts, opts ...grpc.CallOption) (*MsgMergeVestingAccountsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateClawbackVestingAccount(ctx context.Context, in *MsgCreateClawbackVestingAccount, opts ...grpc.CallOption) (*MsgCreateClawbackVestingAccountResponse, error) {
+	out := new(MsgCreateClawbackVestingAccountResponse)
+	err := c.cc.Invoke(ctx, "/vesting.v1.Msg/CreateClawbackVestingAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FundVestingAccount(ctx context.Context, in *MsgFundVestingAccount, opts ...grpc.CallOption) (*MsgFundVestingAccountResponse, error) {
+	out := new(MsgFundVestingAccountResponse)
+	err := c.cc.Invoke(ctx, "/vesting.v1.Msg/FundVestingAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Clawback(ctx context.Context, in *MsgClawback, opts ...grpc.CallOption) (*MsgClawbackResponse, error) {
+	out := new(MsgClawbackResponse)
+	err := c.cc.Invoke(ctx, "/vesting.v1.Msg/Clawback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateVestingFunder(ctx context.Context, in *MsgUpdateVestingFunder, opts ...grpc.CallOption) (*MsgUpdateVestingFunderResponse, error) {
+	out := new(MsgUpdateVestingFunderResponse)
+	err := c.cc.Invoke(ctx, "/vesting.v1.Msg/UpdateVestingFunder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ConvertVestingAccount(ctx context.Context, in *MsgConvertVestingAccount, opts ...grpc.CallOption) (*MsgConvertVestingAccountResponse, error) {
+	out := new(MsgConvertVestingAccountResponse)
+	err := c.cc.Invoke(ctx, "/vesting.v1.Msg/ConvertVestingAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MigrateVestingAccount(ctx context.Context, in *MsgMigrateVestingAccount, opts ...grpc.CallOption) (*MsgMigrateVestingAccountResponse, error) {
+	out := new(MsgMigrateVestingAccountResponse)
+	err := c.cc.Invoke(ctx, "/vesting.v1.Msg/MigrateVestingAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/vesting.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetVestingManager(ctx context.Context, in *MsgSetVestingManager, opts ...grpc.CallOption) (*MsgSetVestingManagerResponse, error) {
+	out := new(MsgSetVestingManagerResponse)
+	err := c.cc.Invoke(ctx, "/vesting.v1.Msg/SetVestingManager", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BatchClawback(ctx context.Context, in *MsgBatchClawback, opts ...grpc.CallOption) (*MsgBatchClawbackResponse, error) {
+	out := new(MsgBatchClawbackResponse)
+	err := c.cc.Invoke(ctx, "/vesting.v1.Msg/BatchClawback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RenounceClawback(ctx context.Context, in *MsgRenounceClawback, opts ...grpc.CallOption) (*MsgRenounceClawbackResponse, error) {
+	out := new(MsgRenounceClawbackResponse)
+	err := c.cc.Invoke(ctx, "/vesting.v1.Msg/RenounceClawback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PauseVesting(ctx context.Context, in *MsgPauseVesting, opts ...grpc.CallOption) (*MsgPauseVestingResponse, error) {
+	out := new(MsgPauseVestingResponse)
+	err := c.cc.Invoke(ctx, "/vesting.v1.Msg/PauseVesting", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResumeVesting(ctx context.Context, in *MsgResumeVesting, opts ...grpc.CallOption) (*MsgResumeVestingResponse, error) {
+	out := new(MsgResumeVestingResponse)
+	err := c.cc.Invoke(ctx, "/vesting.v1.Msg/ResumeVesting", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnlockVesting(ctx context.Context, in *MsgUnlockVesting, opts ...grpc.CallOption) (*MsgUnlockVestingResponse, error) {
+	out := new(MsgUnlockVestingResponse)
+	err := c.cc.Invoke(ctx, "/vesting.v1.Msg/UnlockVesting", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ProposeScheduleAmendment(ctx context.Context, in *MsgProposeScheduleAmendment, opts ...grpc.CallOption) (*MsgProposeScheduleAmendmentResponse, error) {
+	out := new(MsgProposeScheduleAmendmentResponse)
+	err := c.cc.Invoke(ctx, "/vesting.v1.Msg/ProposeScheduleAmendment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptScheduleAmendment(ctx context.Context, in *MsgAcceptScheduleAmendment, opts ...grpc.CallOption) (*MsgAcceptScheduleAmendmentResponse, error) {
+	out := new(MsgAcceptScheduleAmendmentResponse)
+	err := c.cc.Invoke(ctx, "/vesting.v1.Msg/AcceptScheduleAmendment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SelfLockup(ctx context.Context, in *MsgSelfLockup, opts ...grpc.CallOption) (*MsgSelfLockupResponse, error) {
+	out := new(MsgSelfLockupResponse)
+	err := c.cc.Invoke(ctx, "/vesting.v1.Msg/SelfLockup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FundVestingAccountFromCommunityPool(ctx context.Context, in *MsgFundVestingAccountFromCommunityPool, opts ...grpc.CallOption) (*MsgFundVestingAccountFromCommunityPoolResponse, error) {
+	out := new(MsgFundVestingAccountFromCommunityPoolResponse)
+	err := c.cc.Invoke(ctx, "/vesting.v1.Msg/FundVestingAccountFromCommunityPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateFundingInstruction(ctx context.Context, in *MsgCreateFundingInstruction, opts ...grpc.CallOption) (*MsgCreateFundingInstructionResponse, error) {
+	out := new(MsgCreateFundingInstructionResponse)
+	err := c.cc.Invoke(ctx, "/vesting.v1.Msg/CreateFundingInstruction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelFundingInstruction(ctx context.Context, in *MsgCancelFundingInstruction, opts ...grpc.CallOption) (*MsgCancelFundingInstructionResponse, error) {
+	out := new(MsgCancelFundingInstructionResponse)
+	err := c.cc.Invoke(ctx, "/vesting.v1.Msg/CancelFundingInstruction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateEscrowGrant(ctx context.Context, in *MsgCreateEscrowGrant, opts ...grpc.CallOption) (*MsgCreateEscrowGrantResponse, error) {
+	out := new(MsgCreateEscrowGrantResponse)
+	err := c.cc.Invoke(ctx, "/vesting.v1.Msg/CreateEscrowGrant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimEscrowGrant(ctx context.Context, in *MsgClaimEscrowGrant, opts ...grpc.CallOption) (*MsgClaimEscrowGrantResponse, error) {
+	out := new(MsgClaimEscrowGrantResponse)
+	err := c.cc.Invoke(ctx, "/vesting.v1.Msg/ClaimEscrowGrant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClawbackEscrowGrant(ctx context.Context, in *MsgClawbackEscrowGrant, opts ...grpc.CallOption) (*MsgClawbackEscrowGrantResponse, error) {
+	out := new(MsgClawbackEscrowGrantResponse)
+	err := c.cc.Invoke(ctx, "/vesting.v1.Msg/ClawbackEscrowGrant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateFunderCommittee(ctx context.Context, in *MsgCreateFunderCommittee, opts ...grpc.CallOption) (*MsgCreateFunderCommitteeResponse, error) {
+	out := new(MsgCreateFunderCommitteeResponse)
+	err := c.cc.Invoke(ctx, "/vesting.v1.Msg/CreateFunderCommittee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitCommitteeProposal(ctx context.Context, in *MsgSubmitCommitteeProposal, opts ...grpc.CallOption) (*MsgSubmitCommitteeProposalResponse, error) {
+	out := new(MsgSubmitCommitteeProposalResponse)
+	err := c.cc.Invoke(ctx, "/vesting.v1.Msg/SubmitCommitteeProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ApproveCommitteeProposal(ctx context.Context, in *MsgApproveCommitteeProposal, opts ...grpc.CallOption) (*MsgApproveCommitteeProposalResponse, error) {
+	out := new(MsgApproveCommitteeProposalResponse)
+	err := c.cc.Invoke(ctx, "/vesting.v1.Msg/ApproveCommitteeProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RecoverVestingAccount(ctx context.Context, in *MsgRecoverVestingAccount, opts ...grpc.CallOption) (*MsgRecoverVestingAccountResponse, error) {
+	out := new(MsgRecoverVestingAccountResponse)
+	err := c.cc.Invoke(ctx, "/vesting.v1.Msg/RecoverVestingAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MergeVestingAccounts(ctx context.Context, in *MsgMergeVestingAccounts, opts ...grpc.CallOption) (*MsgMergeVestingAccountsResponse, error) {
+	out := new(MsgMergeVestingAccountsResponse)
+	err := c.cc.Invoke(ctx, "/vesting.v1.Msg/MergeVestingAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// CreateClawbackVestingAccount creats a vesting account that is subject to clawback.
+	CreateClawbackVestingAccount(context.Context, *MsgCreateClawbackVestingAccount) (*MsgCreateClawbackVestingAccountResponse, error)
+	// FundVestingAccount funds an existing ClawbackVestingAccount with tokens
+	// according to the vesting and lockup schedules.
+	FundVestingAccount(context.Context, *MsgFundVestingAccount) (*MsgFundVestingAccountResponse, error)
+	// Clawback removes the unvested tokens from a ClawbackVestingAccount.
+	Clawback(context.Context, *MsgClawback) (*MsgClawbackResponse, error)
+	// UpdateVestingFunder updates the funder address of an existing
+	// ClawbackVestingAccount.
+	UpdateVestingFunder(context.Context, *MsgUpdateVestingFunder) (*MsgUpdateVestingFunderResponse, error)
+	// ConvertVestingAccount converts a ClawbackVestingAccount to an Eth account
+	ConvertVestingAccount(context.Context, *MsgConvertVestingAccount) (*MsgConvertVestingAccountResponse, error)
+	// MigrateVestingAccount converts an x/auth/vesting account (continuous,
+	// delayed or periodic) into a ClawbackVestingAccount. It can only be
+	// executed by the governance module account.
+	MigrateVestingAccount(context.Context, *MsgMigrateVestingAccount) (*MsgMigrateVestingAccountResponse, error)
+	// UpdateParams defines a governance operation for updating the x/vesting
+	// module parameters. The authority is hard-coded to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetVestingManager sets or clears the manager address of an existing
+	// ClawbackVestingAccount.
+	SetVestingManager(context.Context, *MsgSetVestingManager) (*MsgSetVestingManagerResponse, error)
+	// BatchClawback defines a governance operation for clawing back the unvested
+	// tokens of multiple ClawbackVestingAccounts. The authority is hard-coded to
+	// the x/gov module account.
+	BatchClawback(context.Context, *MsgBatchClawback) (*MsgBatchClawbackResponse, error)
+	// RenounceClawback permanently waives the clawback rights of the funder of
+	// an existing ClawbackVestingAccount.
+	RenounceClawback(context.Context, *MsgRenounceClawback) (*MsgRenounceClawbackResponse, error)
+	// PauseVesting defines a governance operation for pausing the vesting of an
+	// existing ClawbackVestingAccount. The authority is hard-coded to the x/gov
+	// module account.
+	PauseVesting(context.Context, *MsgPauseVesting) (*MsgPauseVestingResponse, error)
+	// ResumeVesting defines a governance operation for resuming the paused
+	// vesting of a ClawbackVestingAccount, shifting its future vesting events by
+	// the pause duration. The authority is hard-coded to the x/gov module
+	// account.
+	ResumeVesting(context.Context, *MsgResumeVesting) (*MsgResumeVestingResponse, error)
+	// UnlockVesting defines a governance operation for unlocking all the lockup
+	// periods of ClawbackVestingAccounts. The authority is hard-coded to the
+	// x/gov module account.
+	UnlockVesting(context.Context, *MsgUnlockVesting) (*MsgUnlockVestingResponse, error)
+	// ProposeScheduleAmendment defines a method for the funder of a
+	// ClawbackVestingAccount to propose new future lockup and vesting periods.
+	ProposeScheduleAmendment(context.Context, *MsgProposeScheduleAmendment) (*MsgProposeScheduleAmendmentResponse, error)
+	// AcceptScheduleAmendment defines a method for a ClawbackVestingAccount to
+	// accept the schedule amendment proposed by its funder.
+	AcceptScheduleAmendment(context.Context, *MsgAcceptScheduleAmendment) (*MsgAcceptScheduleAmendmentResponse, error)
+	// SelfLockup defines a method for any account to lock up a portion of its
+	// own balance under a lockup schedule, with no clawback rights.
+	SelfLockup(context.Context, *MsgSelfLockup) (*MsgSelfLockupResponse, error)
+	// FundVestingAccountFromCommunityPool defines a governance operation for
+	// funding a ClawbackVestingAccount from the community pool, with governance
+	// as the funder. The authority is hard-coded to the x/gov module account.
+	FundVestingAccountFromCommunityPool(context.Context, *MsgFundVestingAccountFromCommunityPool) (*MsgFundVestingAccountFromCommunityPoolResponse, error)
+	// CreateFundingInstruction defines a method for the funder of a
+	// ClawbackVestingAccount to register a recurring funding executed in
+	// EndBlock.
+	CreateFundingInstruction(context.Context, *MsgCreateFundingInstruction) (*MsgCreateFundingInstructionResponse, error)
+	// CancelFundingInstruction defines a method for a funder to cancel one of
+	// its recurring funding instructions.
+	CancelFundingInstruction(context.Context, *MsgCancelFundingInstruction) (*MsgCancelFundingInstructionResponse, error)
+	// CreateEscrowGrant defines a method for funding a vesting grant held in
+	// escrow by the vesting module account.
+	CreateEscrowGrant(context.Context, *MsgCreateEscrowGrant) (*MsgCreateEscrowGrantResponse, error)
+	// ClaimEscrowGrant defines a method for the recipient of an escrow grant to
+	// claim its vested and unlocked coins.
+	ClaimEscrowGrant(context.Context, *MsgClaimEscrowGrant) (*MsgClaimEscrowGrantResponse, error)
+	// ClawbackEscrowGrant defines a method for the funder of an escrow grant to
+	// claw back its unvested coins.
+	ClawbackEscrowGrant(context.Context, *MsgClawbackEscrowGrant) (*MsgClawbackEscrowGrantResponse, error)
+	// CreateFunderCommittee defines a method for creating a funder committee.
+	CreateFunderCommittee(context.Context, *MsgCreateFunderCommittee) (*MsgCreateFunderCommitteeResponse, error)
+	// SubmitCommitteeProposal defines a method for a member of a funder
+	// committee to propose an action on a vesting account funded by the
+	// committee.
+	SubmitCommitteeProposal(context.Context, *MsgSubmitCommitteeProposal) (*MsgSubmitCommitteeProposalResponse, error)
+	// ApproveCommitteeProposal defines a method for a member of a funder
+	// committee to approve a proposal, which is executed once the threshold is
+	// reached.
+	ApproveCommitteeProposal(context.Context, *MsgApproveCommitteeProposal) (*MsgApproveCommitteeProposalResponse, error)
+	// RecoverVestingAccount defines a governance operation for migrating the
+	// balance, delegations and schedule of a ClawbackVestingAccount to a new
+	// address. The authority is hard-coded to the x/gov module account.
+	RecoverVestingAccount(context.Context, *MsgRecoverVestingAccount) (*MsgRecoverVestingAccountResponse, error)
+	// MergeVestingAccounts defines a method for merging two
+	// ClawbackVestingAccounts with the same funder, signed by both accounts and
+	// the funder.
+	MergeVestingAccounts(context.Context, *MsgMergeVestingAccounts) (*MsgMergeVestingAccountsResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) CreateClawbackVestingAccount(ctx context.Context, req *MsgCreateClawbackVestingAccount) (*MsgCreateClawbackVestingAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateClawbackVestingAccount not implemented")
+}
+func (*UnimplementedMsgServer) FundVestingAccount(ctx context.Context, req *MsgFundVestingAccount) (*MsgFundVestingAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundVestingAccount not implemented")
+}
+func (*UnimplementedMsgServer) Clawback(ctx context.Context, req *MsgClawback) (*MsgClawbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Clawback not implemented")
+}
+func (*UnimplementedMsgServer) UpdateVestingFunder(ctx context.Context, req *MsgUpdateVestingFunder) (*MsgUpdateVestingFunderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVestingFunder not implemented")
+}
+func (*UnimplementedMsgServer) ConvertVestingAccount(ctx context.Context, req *MsgConvertVestingAccount) (*MsgConvertVestingAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertVestingAccount not implemented")
+}
+func (*UnimplementedMsgServer) MigrateVestingAccount(ctx context.Context, req *MsgMigrateVestingAccount) (*MsgMigrateVestingAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateVestingAccount not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) SetVestingManager(ctx context.Context, req *MsgSetVestingManager) (*MsgSetVestingManagerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetVestingManager not implemented")
+}
+func (*UnimplementedMsgServer) BatchClawback(ctx context.Context, req *MsgBatchClawback) (*MsgBatchClawbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchClawback not implemented")
+}
+func (*UnimplementedMsgServer) RenounceClawback(ctx context.Context, req *MsgRenounceClawback) (*MsgRenounceClawbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenounceClawback not implemented")
+}
+func (*UnimplementedMsgServer) PauseVesting(ctx context.Context, req *MsgPauseVesting) (*MsgPauseVestingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseVesting not implemented")
+}
+func (*UnimplementedMsgServer) ResumeVesting(ctx context.Context, req *MsgResumeVesting) (*MsgResumeVestingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeVesting not implemented")
+}
+func (*UnimplementedMsgServer) UnlockVesting(ctx context.Context, req *MsgUnlockVesting) (*MsgUnlockVestingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockVesting not implemented")
+}
+func (*UnimplementedMsgServer) ProposeScheduleAmendment(ctx context.Context, req *MsgProposeScheduleAmendment) (*MsgProposeScheduleAmendmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeScheduleAmendment not implemented")
+}
+func (*UnimplementedMsgServer) AcceptScheduleAmendment(ctx context.Context, req *MsgAcceptScheduleAmendment) (*MsgAcceptScheduleAmendmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptScheduleAmendment not implemented")
+}
+func (*UnimplementedMsgServer) SelfLockup(ctx context.Context, req *MsgSelfLockup) (*MsgSelfLockupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SelfLockup not implemented")
+}
+func (*UnimplementedMsgServer) FundVestingAccountFromCommunityPool(ctx context.Context, req *MsgFundVestingAccountFromCommunityPool) (*MsgFundVestingAccountFromCommunityPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundVestingAccountFromCommunityPool not implemented")
+}
+func (*UnimplementedMsgServer) CreateFundingInstruction(ctx context.Context, req *MsgCreateFundingInstruction) (*MsgCreateFundingInstructionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFundingInstruction not implemented")
+}
+func (*UnimplementedMsgServer) CancelFundingInstruction(ctx context.Context, req *MsgCancelFundingInstruction) (*MsgCancelFundingInstructionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelFundingInstruction not implemented")
+}
+func (*UnimplementedMsgServer) CreateEscrowGrant(ctx context.Context, req *MsgCreateEscrowGrant) (*MsgCreateEscrowGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEscrowGrant not implemented")
+}
+func (*UnimplementedMsgServer) ClaimEscrowGrant(ctx context.Context, req *MsgClaimEscrowGrant) (*MsgClaimEscrowGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimEscrowGrant not implemented")
+}
+func (*UnimplementedMsgServer) ClawbackEscrowGrant(ctx context.Context, req *MsgClawbackEscrowGrant) (*MsgClawbackEscrowGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClawbackEscrowGrant not implemented")
+}
+func (*UnimplementedMsgServer) CreateFunderCommittee(ctx context.Context, req *MsgCreateFunderCommittee) (*MsgCreateFunderCommitteeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFunderCommittee not implemented")
+}
+func (*UnimplementedMsgServer) SubmitCommitteeProposal(ctx context.Context, req *MsgSubmitCommitteeProposal) (*MsgSubmitCommitteeProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitCommitteeProposal not implemented")
+}
+func (*UnimplementedMsgServer) ApproveCommitteeProposal(ctx context.Context, req *MsgApproveCommitteeProposal) (*MsgApproveCommitteeProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveCommitteeProposal not implemented")
+}
+func (*UnimplementedMsgServer) RecoverVestingAccount(ctx context.Context, req *MsgRecoverVestingAccount) (*MsgRecoverVestingAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoverVestingAccount not implemented")
+}
+func (*UnimplementedMsgServer) MergeVestingAccounts(ctx context.Context, req *MsgMergeVestingAccounts) (*MsgMergeVestingAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeVestingAccounts not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_CreateClawbackVestingAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateClawbackVestingAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateClawbackVestingAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vesting.v1.Msg/CreateClawbackVestingAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateClawbackVestingAccount(ctx, req.(*MsgCreateClawbackVestingAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FundVestingAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundVestingAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundVestingAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vesting.v1.Msg/FundVestingAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundVestingAccount(ctx, req.(*MsgFundVestingAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Clawback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClawback)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Clawback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vesting.v1.Msg/Clawback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Clawback(ctx, req.(*MsgClawback))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateVestingFunder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateVestingFunder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateVestingFunder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vesting.v1.Msg/UpdateVestingFunder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateVestingFunder(ctx, req.(*MsgUpdateVestingFunder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ConvertVestingAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertVestingAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertVestingAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vesting.v1.Msg/ConvertVestingAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertVestingAccount(ctx, req.(*MsgConvertVestingAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MigrateVestingAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateVestingAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateVestingAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vesting.v1.Msg/MigrateVestingAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateVestingAccount(ctx, req.(*MsgMigrateVestingAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vesting.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetVestingManager_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetVestingManager)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetVestingManager(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vesting.v1.Msg/SetVestingManager",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetVestingManager(ctx, req.(*MsgSetVestingManager))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BatchClawback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchClawback)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BatchClawback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vesting.v1.Msg/BatchClawback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchClawback(ctx, req.(*MsgBatchClawback))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RenounceClawback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRenounceClawback)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RenounceClawback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vesting.v1.Msg/RenounceClawback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RenounceClawback(ctx, req.(*MsgRenounceClawback))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PauseVesting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPauseVesting)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PauseVesting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vesting.v1.Msg/PauseVesting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PauseVesting(ctx, req.(*MsgPauseVesting))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResumeVesting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResumeVesting)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResumeVesting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vesting.v1.Msg/ResumeVesting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResumeVesting(ctx, req.(*MsgResumeVesting))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnlockVesting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnlockVesting)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnlockVesting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vesting.v1.Msg/UnlockVesting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnlockVesting(ctx, req.(*MsgUnlockVesting))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ProposeScheduleAmendment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposeScheduleAmendment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProposeScheduleAmendment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vesting.v1.Msg/ProposeScheduleAmendment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProposeScheduleAmendment(ctx, req.(*MsgProposeScheduleAmendment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptScheduleAmendment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptScheduleAmendment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptScheduleAmendment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vesting.v1.Msg/AcceptScheduleAmendment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptScheduleAmendment(ctx, req.(*MsgAcceptScheduleAmendment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SelfLockup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSelfLockup)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SelfLockup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vesting.v1.Msg/SelfLockup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SelfLockup(ctx, req.(*MsgSelfLockup))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FundVestingAccountFromCommunityPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundVestingAccountFromCommunityPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundVestingAccountFromCommunityPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vesting.v1.Msg/FundVestingAccountFromCommunityPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundVestingAccountFromCommunityPool(ctx, req.(*MsgFundVestingAccountFromCommunityPool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateFundingInstruction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateFundingInstruction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateFundingInstruction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vesting.v1.Msg/CreateFundingInstruction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateFundingInstruction(ctx, req.(*MsgCreateFundingInstruction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelFundingInstruction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelFundingInstruction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelFundingInstruction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vesting.v1.Msg/CancelFundingInstruction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelFundingInstruction(ctx, req.(*MsgCancelFundingInstruction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateEscrowGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateEscrowGrant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateEscrowGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vesting.v1.Msg/CreateEscrowGrant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateEscrowGrant(ctx, req.(*MsgCreateEscrowGrant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimEscrowGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimEscrowGrant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimEscrowGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vesting.v1.Msg/ClaimEscrowGrant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimEscrowGrant(ctx, req.(*MsgClaimEscrowGrant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClawbackEscrowGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClawbackEscrowGrant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClawbackEscrowGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vesting.v1.Msg/ClawbackEscrowGrant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClawbackEscrowGrant(ctx, req.(*MsgClawbackEscrowGrant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateFunderCommittee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateFunderCommittee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateFunderCommittee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vesting.v1.Msg/CreateFunderCommittee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateFunderCommittee(ctx, req.(*MsgCreateFunderCommittee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitCommitteeProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitCommitteeProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitCommitteeProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vesting.v1.Msg/SubmitCommitteeProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitCommitteeProposal(ctx, req.(*MsgSubmitCommitteeProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ApproveCommitteeProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveCommitteeProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveCommitteeProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vesting.v1.Msg/ApproveCommitteeProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveCommitteeProposal(ctx, req.(*MsgApproveCommitteeProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RecoverVestingAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRecoverVestingAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RecoverVestingAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vesting.v1.Msg/RecoverVestingAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RecoverVestingAccount(ctx, req.(*MsgRecoverVestingAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MergeVestingAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMergeVestingAccounts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MergeVestingAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vesting.v1.Msg/MergeVestingAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MergeVestingAccounts(ctx, req.(*MsgMergeVestingAccounts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "vesting.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateClawbackVestingAccount",
+			Handler:    _Msg_CreateClawbackVestingAccount_Handler,
+		},
+		{
+			MethodName: "FundVestingAccount",
+			Handler:    _Msg_FundVestingAccount_Handler,
+		},
+		{
+			MethodName: "Clawback",
+			Handler:    _Msg_Clawback_Handler,
+		},
+		{
+			MethodName: "UpdateVestingFunder",
+			Handler:    _Msg_UpdateVestingFunder_Handler,
+		},
+		{
+			MethodName: "ConvertVestingAccount",
+			Handler:    _Msg_ConvertVestingAccount_Handler,
+		},
+		{
+			MethodName: "MigrateVestingAccount",
+			Handler:    _Msg_MigrateVestingAccount_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "SetVestingManager",
+			Handler:    _Msg_SetVestingManager_Handler,
+		},
+		{
+			MethodName: "BatchClawback",
+			Handler:    _Msg_BatchClawback_Handler,
+		},
+		{
+			MethodName: "RenounceClawback",
+			Handler:    _Msg_RenounceClawback_Handler,
+		},
+		{
+			MethodName: "PauseVesting",
+			Handler:    _Msg_PauseVesting_Handler,
+		},
+		{
+			MethodName: "ResumeVesting",
+			Handler:    _Msg_ResumeVesting_Handler,
+		},
+		{
+			MethodName: "UnlockVesting",
+			Handler:    _Msg_UnlockVesting_Handler,
+		},
+		{
+			MethodName: "ProposeScheduleAmendment",
+			Handler:    _Msg_ProposeScheduleAmendment_Handler,
+		},
+		{
+			MethodName: "AcceptScheduleAmendment",
+			Handler:    _Msg_AcceptScheduleAmendment_Handler,
+		},
+		{
+			MethodName: "SelfLockup",
+			Handler:    _Msg_SelfLockup_Handler,
+		},
+		{
+			MethodName: "FundVestingAccountFromCommunityPool",
+			Handler:    _Msg_FundVestingAccountFromCommunityPool_Handler,
+		},
+		{
+			MethodName: "CreateFundingInstruction",
+			Handler:    _Msg_CreateFundingInstruction_Handler,
+		},
+		{
+			MethodName: "CancelFundingInstruction",
+			Handler:    _Msg_CancelFundingInstruction_Handler,
+		},
+		{
+			MethodName: "CreateEscrowGrant",
+			Handler:    _Msg_CreateEscrowGrant_Handler,
+		},
+		{
+			MethodName: "ClaimEscrowGrant",
+			Handler:    _Msg_ClaimEscrowGrant_Handler,
+		},
+		{
+			MethodName: "ClawbackEscrowGrant",
+			Handler:    _Msg_ClawbackEscrowGrant_Handler,
+		},
+		{
+			MethodName: "CreateFunderCommittee",
+			Handler:    _Msg_CreateFunderCommittee_Handler,
+		},
+		{
+			MethodName: "SubmitCommitteeProposal",
+			Handler:    _Msg_SubmitCommitteeProposal_Handler,
+		},
+		{
+			MethodName: "ApproveCommitteeProposal",
+			Handler:    _Msg_ApproveCommitteeProposal_Handler,
+		},
+		{
+			MethodName: "RecoverVestingAccount",
+			Handler:    _Msg_RecoverVestingAccount_Handler,
+		},
+		{
+			MethodName: "MergeVestingAccounts",
+			Handler:    _Msg_MergeVestingAccounts_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "vesting/v1/tx.proto",
+}
+
+func (m *MsgCreateClawbackVestingAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateClawbackVestingAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateClawbackVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EnableGovClawback {
+		i--
+		if m.EnableGovClawback {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.VestingAddress) > 0 {
+		i -= len(m.VestingAddress)
+		copy(dAtA[i:], m.VestingAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VestingAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateClawbackVestingAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateClawbackVestingAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateClawbackVestingAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundVestingAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundVestingAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VestingPeriods) > 0 {
+		for iNdEx := len(m.VestingPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.LockupPeriods) > 0 {
+		for iNdEx := len(m.LockupPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockupPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTx(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.VestingAddress) > 0 {
+		i -= len(m.VestingAddress)
+		copy(dAtA[i:], m.VestingAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VestingAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundVestingAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundVestingAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundVestingAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClawback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClawback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClawback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Destinations) > 0 {
+		for iNdEx := len(m.Destinations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Destinations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Burn {
+		i--
+		if m.Burn {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.DestAddress) > 0 {
+		i -= len(m.DestAddress)
+		copy(dAtA[i:], m.DestAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DestAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AccountAddress) > 0 {
+		i -= len(m.AccountAddress)
+		copy(dAtA[i:], m.AccountAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AccountAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClawbackDestination) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClawbackDestination) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClawbackDestination) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Weight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClawbackResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClawbackResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClawbackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateVestingFunder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateVestingFunder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateVestingFunder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VestingAddress) > 0 {
+		i -= len(m.VestingAddress)
+		copy(dAtA[i:], m.VestingAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VestingAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NewFunderAddress) > 0 {
+		i -= len(m.NewFunderAddress)
+		copy(dAtA[i:], m.NewFunderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewFunderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateVestingFunderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateVestingFunderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateVestingFunderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertVestingAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertVestingAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VestingAddress) > 0 {
+		i -= len(m.VestingAddress)
+		copy(dAtA[i:], m.VestingAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VestingAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertVestingAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertVestingAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertVestingAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateVestingAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateVestingAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EnableGovClawback {
+		i--
+		if m.EnableGovClawback {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VestingAddress) > 0 {
+		i -= len(m.VestingAddress)
+		copy(dAtA[i:], m.VestingAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VestingAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateVestingAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateVestingAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateVestingAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetVestingManager) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetVestingManager) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetVestingManager) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ManagerAddress) > 0 {
+		i -= len(m.ManagerAddress)
+		copy(dAtA[i:], m.ManagerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ManagerAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VestingAddress) > 0 {
+		i -= len(m.VestingAddress)
+		copy(dAtA[i:], m.VestingAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VestingAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetVestingManagerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetVestingManagerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetVestingManagerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchClawback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchClawback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchClawback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Burn {
+		i--
+		if m.Burn {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BestEffort {
+		i--
+		if m.BestEffort {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.DestAddress) > 0 {
+		i -= len(m.DestAddress)
+		copy(dAtA[i:], m.DestAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DestAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AccountAddresses) > 0 {
+		for iNdEx := len(m.AccountAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AccountAddresses[iNdEx])
+			copy(dAtA[i:], m.AccountAddresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AccountAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClawbackResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClawbackResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClawbackResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClawedBack) > 0 {
+		for iNdEx := len(m.ClawedBack) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClawedBack[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AccountAddress) > 0 {
+		i -= len(m.AccountAddress)
+		copy(dAtA[i:], m.AccountAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AccountAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchClawbackResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchClawbackResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchClawbackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRenounceClawback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRenounceClawback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRenounceClawback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RenounceGovClawback {
+		i--
+		if m.RenounceGovClawback {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.VestingAddress) > 0 {
+		i -= len(m.VestingAddress)
+		copy(dAtA[i:], m.VestingAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VestingAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRenounceClawbackResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRenounceClawbackResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRenounceClawbackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPauseVesting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseVesting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseVesting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VestingAddress) > 0 {
+		i -= len(m.VestingAddress)
+		copy(dAtA[i:], m.VestingAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VestingAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPauseVestingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseVestingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseVestingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResumeVesting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResumeVesting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResumeVesting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VestingAddress) > 0 {
+		i -= len(m.VestingAddress)
+		copy(dAtA[i:], m.VestingAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VestingAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResumeVestingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResumeVestingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResumeVestingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnlockVesting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnlockVesting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnlockVesting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VestingAddresses) > 0 {
+		for iNdEx := len(m.VestingAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.VestingAddresses[iNdEx])
+			copy(dAtA[i:], m.VestingAddresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.VestingAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnlockVestingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnlockVestingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnlockVestingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposeScheduleAmendment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeScheduleAmendment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeScheduleAmendment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VestingPeriods) > 0 {
+		for iNdEx := len(m.VestingPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.LockupPeriods) > 0 {
+		for iNdEx := len(m.LockupPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockupPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.VestingAddress) > 0 {
+		i -= len(m.VestingAddress)
+		copy(dAtA[i:], m.VestingAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VestingAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposeScheduleAmendmentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeScheduleAmendmentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeScheduleAmendmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptScheduleAmendment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptScheduleAmendment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptScheduleAmendment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VestingAddress) > 0 {
+		i -= len(m.VestingAddress)
+		copy(dAtA[i:], m.VestingAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VestingAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptScheduleAmendmentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptScheduleAmendmentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptScheduleAmendmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSelfLockup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSelfLockup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSelfLockup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LockupPeriods) > 0 {
+		for iNdEx := len(m.LockupPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockupPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSelfLockupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSelfLockupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSelfLockupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundVestingAccountFromCommunityPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundVestingAccountFromCommunityPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundVestingAccountFromCommunityPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VestingPeriods) > 0 {
+		for iNdEx := len(m.VestingPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.LockupPeriods) > 0 {
+		for iNdEx := len(m.LockupPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockupPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.VestingAddress) > 0 {
+		i -= len(m.VestingAddress)
+		copy(dAtA[i:], m.VestingAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VestingAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundVestingAccountFromCommunityPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundVestingAccountFromCommunityPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundVestingAccountFromCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateFundingInstruction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateFundingInstruction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateFundingInstruction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LockupPeriods) > 0 {
+		for iNdEx := len(m.LockupPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockupPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Count != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Interval != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x20
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintTx(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.VestingAddress) > 0 {
+		i -= len(m.VestingAddress)
+		copy(dAtA[i:], m.VestingAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VestingAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateFundingInstructionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateFundingInstructionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateFundingInstructionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelFundingInstruction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelFundingInstruction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelFundingInstruction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelFundingInstructionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelFundingInstructionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelFundingInstructionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateEscrowGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateEscrowGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateEscrowGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VestingPeriods) > 0 {
+		for iNdEx := len(m.VestingPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.LockupPeriods) > 0 {
+		for iNdEx := len(m.LockupPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockupPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintTx(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.RecipientAddress) > 0 {
+		i -= len(m.RecipientAddress)
+		copy(dAtA[i:], m.RecipientAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecipientAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateEscrowGrantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateEscrowGrantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateEscrowGrantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimEscrowGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimEscrowGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimEscrowGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RecipientAddress) > 0 {
+		i -= len(m.RecipientAddress)
+		copy(dAtA[i:], m.RecipientAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecipientAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimEscrowGrantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimEscrowGrantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimEscrowGrantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClawbackEscrowGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClawbackEscrowGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClawbackEscrowGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DestAddress) > 0 {
+		i -= len(m.DestAddress)
+		copy(dAtA[i:], m.DestAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DestAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClawbackEscrowGrantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClawbackEscrowGrantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClawbackEscrowGrantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateFunderCommittee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateFunderCommittee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateFunderCommittee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalLifetime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalLifetime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Members[iNdEx])
+			copy(dAtA[i:], m.Members[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Members[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateFunderCommitteeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateFunderCommitteeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateFunderCommitteeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitCommitteeProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitCommitteeProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitCommitteeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewFunderAddress) > 0 {
+		i -= len(m.NewFunderAddress)
+		copy(dAtA[i:], m.NewFunderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewFunderAddress)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.DestAddress) > 0 {
+		i -= len(m.DestAddress)
+		copy(dAtA[i:], m.DestAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DestAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.VestingAddress) > 0 {
+		i -= len(m.VestingAddress)
+		copy(dAtA[i:], m.VestingAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VestingAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Action != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CommitteeAddress) > 0 {
+		i -= len(m.CommitteeAddress)
+		copy(dAtA[i:], m.CommitteeAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CommitteeAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitCommitteeProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitCommitteeProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitCommitteeProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Executed {
+		i--
+		if m.Executed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveCommitteeProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveCommitteeProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveCommitteeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Member) > 0 {
+		i -= len(m.Member)
+		copy(dAtA[i:], m.Member)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Member)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveCommitteeProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveCommitteeProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveCommitteeProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Executed {
+		i--
+		if m.Executed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRecoverVestingAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRecoverVestingAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRecoverVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewAddress) > 0 {
+		i -= len(m.NewAddress)
+		copy(dAtA[i:], m.NewAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VestingAddress) > 0 {
+		i -= len(m.VestingAddress)
+		copy(dAtA[i:], m.VestingAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VestingAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRecoverVestingAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRecoverVestingAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRecoverVestingAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMergeVestingAccounts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMergeVestingAccounts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMergeVestingAccounts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TargetAddress) > 0 {
+		i -= len(m.TargetAddress)
+		copy(dAtA[i:], m.TargetAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TargetAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SourceAddress) > 0 {
+		i -= len(m.SourceAddress)
+		copy(dAtA[i:], m.SourceAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SourceAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMergeVestingAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMergeVestingAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMergeVestingAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateClawbackVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VestingAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EnableGovClawback {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgCreateClawbackVestingAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFundVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VestingAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.LockupPeriods) > 0 {
+		for _, e := range m.LockupPeriods {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.VestingPeriods) > 0 {
+		for _, e := range m.VestingPeriods {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFundVestingAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClawback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AccountAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DestAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Burn {
+		n += 2
+	}
+	if len(m.Destinations) > 0 {
+		for _, e := range m.Destinations {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ClawbackDestination) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovTx(uint64(m.Weight))
+	}
+	return n
+}
+
+func (m *MsgClawbackResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateVestingFunder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewFunderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VestingAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateVestingFunderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConvertVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VestingAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConvertVestingAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMigrateVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VestingAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EnableGovClawback {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgMigrateVestingAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetVestingManager) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VestingAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ManagerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetVestingManagerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBatchClawback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.AccountAddresses) > 0 {
+		for _, s := range m.AccountAddresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.DestAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BestEffort {
+		n += 2
+	}
+	if m.Burn {
+		n += 2
+	}
+	return n
+}
+
+func (m *ClawbackResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AccountAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ClawedBack) > 0 {
+		for _, e := range m.ClawedBack {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBatchClawbackResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRenounceClawback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VestingAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RenounceGovClawback {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgRenounceClawbackResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPauseVesting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VestingAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPauseVestingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgResumeVesting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VestingAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgResumeVestingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnlockVesting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.VestingAddresses) > 0 {
+		for _, s := range m.VestingAddresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUnlockVestingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgProposeScheduleAmendment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VestingAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.LockupPeriods) > 0 {
+		for _, e := range m.LockupPeriods {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.VestingPeriods) > 0 {
+		for _, e := range m.VestingPeriods {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgProposeScheduleAmendmentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAcceptScheduleAmendment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VestingAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcceptScheduleAmendmentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSelfLockup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.LockupPeriods) > 0 {
+		for _, e := range m.LockupPeriods {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSelfLockupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFundVestingAccountFromCommunityPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VestingAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.LockupPeriods) > 0 {
+		for _, e := range m.LockupPeriods {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.VestingPeriods) > 0 {
+		for _, e := range m.VestingPeriods {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFundVestingAccountFromCommunityPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateFundingInstruction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VestingAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	if m.Interval != 0 {
+		n += 1 + sovTx(uint64(m.Interval))
+	}
+	if m.Count != 0 {
+		n += 1 + sovTx(uint64(m.Count))
+	}
+	if len(m.LockupPeriods) > 0 {
+		for _, e := range m.LockupPeriods {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreateFundingInstructionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelFundingInstruction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelFundingInstructionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateEscrowGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RecipientAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.LockupPeriods) > 0 {
+		for _, e := range m.LockupPeriods {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.VestingPeriods) > 0 {
+		for _, e := range m.VestingPeriods {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreateEscrowGrantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgClaimEscrowGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecipientAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgClaimEscrowGrantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgClawbackEscrowGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.DestAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClawbackEscrowGrantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreateFunderCommittee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Members) > 0 {
+		for _, s := range m.Members {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovTx(uint64(m.Threshold))
+	}
+	if m.ProposalLifetime != 0 {
+		n += 1 + sovTx(uint64(m.ProposalLifetime))
+	}
+	return n
+}
+
+func (m *MsgCreateFunderCommitteeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitCommitteeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CommitteeAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Action != 0 {
+		n += 1 + sovTx(uint64(m.Action))
+	}
+	l = len(m.VestingAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DestAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewFunderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitCommitteeProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if m.Executed {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgApproveCommitteeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Member)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgApproveCommitteeProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Executed {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgRecoverVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VestingAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRecoverVestingAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgMergeVestingAccounts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SourceAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TargetAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMergeVestingAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateClawbackVestingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateClawbackVestingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateClawbackVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableGovClawback", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableGovClawback = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateClawbackVestingAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateClawbackVestingAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateClawbackVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundVestingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundVestingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockupPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockupPeriods = append(m.LockupPeriods, types.Period{})
+			if err := m.LockupPeriods[len(m.LockupPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingPeriods = append(m.VestingPeriods, types.Period{})
+			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundVestingAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundVestingAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClawback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClawback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClawback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burn", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Burn = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destinations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destinations = append(m.Destinations, ClawbackDestination{})
+			if err := m.Destinations[len(m.Destinations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClawbackDestination) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClawbackDestination: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClawbackDestination: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClawbackResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClawbackResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClawbackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateVestingFunder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateVestingFunder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateVestingFunder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewFunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewFunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateVestingFunderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateVestingFunderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateVestingFunderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertVestingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertVestingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertVestingAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertVestingAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMigrateVestingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateVestingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableGovClawback", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableGovClawback = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMigrateVestingAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateVestingAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetVestingManager) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetVestingManager: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetVestingManager: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ManagerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetVestingManagerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetVestingManagerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetVestingManagerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBatchClawback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBatchClawback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBatchClawback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountAddresses = append(m.AccountAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestEffort", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BestEffort = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burn", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Burn = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClawbackResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClawbackResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClawbackResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawedBack", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClawedBack = append(m.ClawedBack, types1.Coin{})
+			if err := m.ClawedBack[len(m.ClawedBack)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBatchClawbackResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBatchClawbackResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBatchClawbackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, ClawbackResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRenounceClawback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRenounceClawback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRenounceClawback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenounceGovClawback", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RenounceGovClawback = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRenounceClawbackResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRenounceClawbackResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRenounceClawbackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPauseVesting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPauseVesting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPauseVesting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPauseVestingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPauseVestingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPauseVestingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResumeVesting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResumeVesting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResumeVesting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResumeVestingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResumeVestingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResumeVestingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnlockVesting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnlockVesting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnlockVesting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingAddresses = append(m.VestingAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnlockVestingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnlockVestingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnlockVestingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProposeScheduleAmendment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposeScheduleAmendment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposeScheduleAmendment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockupPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockupPeriods = append(m.LockupPeriods, types.Period{})
+			if err := m.LockupPeriods[len(m.LockupPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingPeriods = append(m.VestingPeriods, types.Period{})
+			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProposeScheduleAmendmentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposeScheduleAmendmentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposeScheduleAmendmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptScheduleAmendment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptScheduleAmendment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptScheduleAmendment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptScheduleAmendmentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptScheduleAmendmentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptScheduleAmendmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSelfLockup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSelfLockup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSelfLockup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockupPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockupPeriods = append(m.LockupPeriods, types.Period{})
+			if err := m.LockupPeriods[len(m.LockupPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSelfLockupResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSelfLockupResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSelfLockupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundVestingAccountFromCommunityPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundVestingAccountFromCommunityPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundVestingAccountFromCommunityPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockupPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockupPeriods = append(m.LockupPeriods, types.Period{})
+			if err := m.LockupPeriods[len(m.LockupPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingPeriods = append(m.VestingPeriods, types.Period{})
+			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundVestingAccountFromCommunityPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundVestingAccountFromCommunityPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundVestingAccountFromCommunityPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateFundingInstruction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateFundingInstruction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateFundingInstruction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockupPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockupPeriods = append(m.LockupPeriods, types.Period{})
+			if err := m.LockupPeriods[len(m.LockupPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateFundingInstructionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateFundingInstructionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateFundingInstructionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelFundingInstruction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelFundingInstruction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelFundingInstruction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelFundingInstructionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelFundingInstructionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelFundingInstructionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateEscrowGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateEscrowGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateEscrowGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockupPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockupPeriods = append(m.LockupPeriods, types.Period{})
+			if err := m.LockupPeriods[len(m.LockupPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingPeriods = append(m.VestingPeriods, types.Period{})
+			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateEscrowGrantResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateEscrowGrantResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateEscrowGrantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimEscrowGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimEscrowGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimEscrowGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimEscrowGrantResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimEscrowGrantResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimEscrowGrantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types1.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClawbackEscrowGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
@@ -1404,15 +12096,232 @@ func (m *MsgCreateClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateClawbackVestingAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClawbackEscrowGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClawbackEscrowGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClawbackEscrowGrantResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClawbackEscrowGrantResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClawbackEscrowGrantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types1.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateFunderCommittee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateFunderCommittee: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateClawbackVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateFunderCommittee: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1440,11 +12349,11 @@ func (m *MsgCreateClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1472,13 +12381,13 @@ func (m *MsgCreateClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VestingAddress = string(dAtA[iNdEx:postIndex])
+			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableGovClawback", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
-			var v int
+			m.Threshold = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1488,12 +12397,30 @@ func (m *MsgCreateClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalLifetime", wireType)
+			}
+			m.ProposalLifetime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalLifetime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EnableGovClawback = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1515,7 +12442,7 @@ func (m *MsgCreateClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateClawbackVestingAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateFunderCommitteeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1538,12 +12465,44 @@ func (m *MsgCreateClawbackVestingAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateClawbackVestingAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateFunderCommitteeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateClawbackVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateFunderCommitteeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1565,7 +12524,7 @@ func (m *MsgCreateClawbackVestingAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFundVestingAccount) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitCommitteeProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1588,15 +12547,15 @@ func (m *MsgFundVestingAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFundVestingAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitCommitteeProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFundVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitCommitteeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1624,9 +12583,60 @@ func (m *MsgFundVestingAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			m.Proposer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommitteeAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= CommitteeAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddress", wireType)
 			}
@@ -1658,11 +12668,11 @@ func (m *MsgFundVestingAccount) Unmarshal(dAtA []byte) error {
 			}
 			m.VestingAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DestAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1672,30 +12682,200 @@ func (m *MsgFundVestingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewFunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewFunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitCommitteeProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitCommitteeProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitCommitteeProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Executed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgApproveCommitteeProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgApproveCommitteeProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgApproveCommitteeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockupPeriods", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1705,31 +12885,29 @@ func (m *MsgFundVestingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LockupPeriods = append(m.LockupPeriods, types.Period{})
-			if err := m.LockupPeriods[len(m.LockupPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Member = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1739,26 +12917,11 @@ func (m *MsgFundVestingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.VestingPeriods = append(m.VestingPeriods, types.Period{})
-			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1780,7 +12943,7 @@ func (m *MsgFundVestingAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFundVestingAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgApproveCommitteeProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1803,12 +12966,32 @@ func (m *MsgFundVestingAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFundVestingAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgApproveCommitteeProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFundVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgApproveCommitteeProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Executed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1830,7 +13013,7 @@ func (m *MsgFundVestingAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClawback) Unmarshal(dAtA []byte) error {
+func (m *MsgRecoverVestingAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1853,15 +13036,15 @@ func (m *MsgClawback) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClawback: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRecoverVestingAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClawback: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRecoverVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1889,11 +13072,11 @@ func (m *MsgClawback) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1921,11 +13104,11 @@ func (m *MsgClawback) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccountAddress = string(dAtA[iNdEx:postIndex])
+			m.VestingAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1953,7 +13136,7 @@ func (m *MsgClawback) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DestAddress = string(dAtA[iNdEx:postIndex])
+			m.NewAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1976,7 +13159,7 @@ func (m *MsgClawback) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClawbackResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRecoverVestingAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1999,12 +13182,46 @@ func (m *MsgClawbackResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClawbackResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRecoverVestingAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClawbackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRecoverVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types1.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2026,7 +13243,7 @@ func (m *MsgClawbackResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateVestingFunder) Unmarshal(dAtA []byte) error {
+func (m *MsgMergeVestingAccounts) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2049,10 +13266,10 @@ func (m *MsgUpdateVestingFunder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateVestingFunder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMergeVestingAccounts: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateVestingFunder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMergeVestingAccounts: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2089,7 +13306,7 @@ func (m *MsgUpdateVestingFunder) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewFunderAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2117,11 +13334,11 @@ func (m *MsgUpdateVestingFunder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewFunderAddress = string(dAtA[iNdEx:postIndex])
+			m.SourceAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2149,7 +13366,7 @@ func (m *MsgUpdateVestingFunder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VestingAddress = string(dAtA[iNdEx:postIndex])
+			m.TargetAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2172,57 +13389,7 @@ func (m *MsgUpdateVestingFunder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateVestingFunderResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateVestingFunderResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateVestingFunderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgConvertVestingAccount) Unmarshal(dAtA []byte) error {
+func (m *MsgMergeVestingAccountsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2245,17 +13412,17 @@ func (m *MsgConvertVestingAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertVestingAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMergeVestingAccountsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMergeVestingAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2265,74 +13432,26 @@ func (m *MsgConvertVestingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VestingAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
+			m.Coins = append(m.Coins, types1.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgConvertVestingAccountResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertVestingAccountResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
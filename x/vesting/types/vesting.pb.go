@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	github_com_cosmos_cosmos_sdk_x_auth_vesting_types "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	types "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -29,6 +31,44 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// CommitteeAction defines the action of a funder committee proposal.
+type CommitteeAction int32
+
+const (
+	// COMMITTEE_ACTION_UNSPECIFIED defines an invalid action.
+	COMMITTEE_ACTION_UNSPECIFIED CommitteeAction = 0
+	// COMMITTEE_ACTION_CLAWBACK claws back the unvested coins of the vesting
+	// account to the destination address.
+	COMMITTEE_ACTION_CLAWBACK CommitteeAction = 1
+	// COMMITTEE_ACTION_UPDATE_FUNDER updates the funder of the vesting account.
+	COMMITTEE_ACTION_UPDATE_FUNDER CommitteeAction = 2
+	// COMMITTEE_ACTION_ACCELERATE vests all the unvested coins of the vesting
+	// account immediately.
+	COMMITTEE_ACTION_ACCELERATE CommitteeAction = 3
+)
+
+var CommitteeAction_name = map[int32]string{
+	0: "COMMITTEE_ACTION_UNSPECIFIED",
+	1: "COMMITTEE_ACTION_CLAWBACK",
+	2: "COMMITTEE_ACTION_UPDATE_FUNDER",
+	3: "COMMITTEE_ACTION_ACCELERATE",
+}
+
+var CommitteeAction_value = map[string]int32{
+	"COMMITTEE_ACTION_UNSPECIFIED":   0,
+	"COMMITTEE_ACTION_CLAWBACK":      1,
+	"COMMITTEE_ACTION_UPDATE_FUNDER": 2,
+	"COMMITTEE_ACTION_ACCELERATE":    3,
+}
+
+func (x CommitteeAction) String() string {
+	return proto.EnumName(CommitteeAction_name, int32(x))
+}
+
+func (CommitteeAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c495fbb3e74201c8, []int{0}
+}
+
 // ClawbackVestingAccount implements the VestingAccount interface. It provides
 // an account that can hold contributions subject to "lockup" (like a
 // PeriodicVestingAccount), or vesting which is subject to clawback
@@ -45,6 +85,12 @@ type ClawbackVestingAccount struct {
 	LockupPeriods github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods `protobuf:"bytes,4,rep,name=lockup_periods,json=lockupPeriods,proto3,castrepeated=github.com/cosmos/cosmos-sdk/x/auth/vesting/types.Periods" json:"lockup_periods"`
 	// vesting_periods defines the vesting schedule relative to the start_time
 	VestingPeriods github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods `protobuf:"bytes,5,rep,name=vesting_periods,json=vestingPeriods,proto3,castrepeated=github.com/cosmos/cosmos-sdk/x/auth/vesting/types.Periods" json:"vesting_periods"`
+	// manager_address specifies an optional account which can fund and perform
+	// clawback on behalf of the funder
+	ManagerAddress string `protobuf:"bytes,6,opt,name=manager_address,json=managerAddress,proto3" json:"manager_address,omitempty"`
+	// vesting_paused_at is the unix time at which the vesting of the account was
+	// paused by governance, or zero if the vesting is not paused.
+	VestingPausedAt int64 `protobuf:"varint,7,opt,name=vesting_paused_at,json=vestingPausedAt,proto3" json:"vesting_paused_at,omitempty"`
 }
 
 func (m *ClawbackVestingAccount) Reset()      { *m = ClawbackVestingAccount{} }
@@ -79,35 +125,34 @@ func (m *ClawbackVestingAccount) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ClawbackVestingAccount proto.InternalMessageInfo
 
-// ClawbackProposal is a gov Content type to clawback funds
-// from a vesting account that has this functionality enabled.
-type ClawbackProposal struct {
-	// title of the proposal
-	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	// description of the proposal
-	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	// address is the vesting account address
-	// to clawback the funds from
-	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
-	// destination_address is the address that will receive
-	// the clawbacked funds from the given vesting account. When
-	// empty, proposal will return the coins to the vesting
-	// account funder.
-	DestinationAddress string `protobuf:"bytes,4,opt,name=destination_address,json=destinationAddress,proto3" json:"destination_address,omitempty"`
+// ScheduleAmendment defines an amendment of the future lockup and vesting
+// schedules of a ClawbackVestingAccount proposed by its funder. The periods
+// are relative to the time at which the amendment is accepted by the vesting
+// account and cover the coins that are still locked up and unvested at that
+// time. Empty periods keep the corresponding schedule unchanged.
+type ScheduleAmendment struct {
+	// funder_address is the address of the funder that proposed the amendment
+	FunderAddress string `protobuf:"bytes,1,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	// vesting_address is the address of the ClawbackVestingAccount
+	VestingAddress string `protobuf:"bytes,2,opt,name=vesting_address,json=vestingAddress,proto3" json:"vesting_address,omitempty"`
+	// lockup_periods defines the future unlocking schedule
+	LockupPeriods github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods `protobuf:"bytes,3,rep,name=lockup_periods,json=lockupPeriods,proto3,castrepeated=github.com/cosmos/cosmos-sdk/x/auth/vesting/types.Periods" json:"lockup_periods"`
+	// vesting_periods defines the future vesting schedule
+	VestingPeriods github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods `protobuf:"bytes,4,rep,name=vesting_periods,json=vestingPeriods,proto3,castrepeated=github.com/cosmos/cosmos-sdk/x/auth/vesting/types.Periods" json:"vesting_periods"`
 }
 
-func (m *ClawbackProposal) Reset()         { *m = ClawbackProposal{} }
-func (m *ClawbackProposal) String() string { return proto.CompactTextString(m) }
-func (*ClawbackProposal) ProtoMessage()    {}
-func (*ClawbackProposal) Descriptor() ([]byte, []int) {
+func (m *ScheduleAmendment) Reset()         { *m = ScheduleAmendment{} }
+func (m *ScheduleAmendment) String() string { return proto.CompactTextString(m) }
+func (*ScheduleAmendment) ProtoMessage()    {}
+func (*ScheduleAmendment) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c495fbb3e74201c8, []int{1}
 }
-func (m *ClawbackProposal) XXX_Unmarshal(b []byte) error {
+func (m *ScheduleAmendment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ClawbackProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ScheduleAmendment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ClawbackProposal.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ScheduleAmendment.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -117,290 +162,2548 @@ func (m *ClawbackProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *ClawbackProposal) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ClawbackProposal.Merge(m, src)
+func (m *ScheduleAmendment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleAmendment.Merge(m, src)
 }
-func (m *ClawbackProposal) XXX_Size() int {
+func (m *ScheduleAmendment) XXX_Size() int {
 	return m.Size()
 }
-func (m *ClawbackProposal) XXX_DiscardUnknown() {
-	xxx_messageInfo_ClawbackProposal.DiscardUnknown(m)
+func (m *ScheduleAmendment) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleAmendment.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ClawbackProposal proto.InternalMessageInfo
+var xxx_messageInfo_ScheduleAmendment proto.InternalMessageInfo
 
-func (m *ClawbackProposal) GetTitle() string {
+func (m *ScheduleAmendment) GetFunderAddress() string {
 	if m != nil {
-		return m.Title
+		return m.FunderAddress
 	}
 	return ""
 }
 
-func (m *ClawbackProposal) GetDescription() string {
+func (m *ScheduleAmendment) GetVestingAddress() string {
 	if m != nil {
-		return m.Description
+		return m.VestingAddress
 	}
 	return ""
 }
 
-func (m *ClawbackProposal) GetAddress() string {
+func (m *ScheduleAmendment) GetLockupPeriods() github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods {
 	if m != nil {
-		return m.Address
+		return m.LockupPeriods
 	}
-	return ""
+	return nil
 }
 
-func (m *ClawbackProposal) GetDestinationAddress() string {
+func (m *ScheduleAmendment) GetVestingPeriods() github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods {
 	if m != nil {
-		return m.DestinationAddress
+		return m.VestingPeriods
 	}
-	return ""
+	return nil
 }
 
-func init() {
-	proto.RegisterType((*ClawbackVestingAccount)(nil), "vesting.v1.ClawbackVestingAccount")
-	proto.RegisterType((*ClawbackProposal)(nil), "vesting.v1.ClawbackProposal")
+// FundingInstruction defines a standing instruction of a funder to fund a
+// ClawbackVestingAccount at regular intervals. Each grant is locked up
+// according to the lockup_periods template, relative to its due time, and
+// vests immediately.
+type FundingInstruction struct {
+	// id is the unique identifier of the instruction
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// funder_address is the address of the account that funds the grants
+	FunderAddress string `protobuf:"bytes,2,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	// vesting_address is the address of the ClawbackVestingAccount
+	VestingAddress string `protobuf:"bytes,3,opt,name=vesting_address,json=vestingAddress,proto3" json:"vesting_address,omitempty"`
+	// lockup_periods defines the unlocking schedule of each grant relative to
+	// its due time. The amount of each grant is the total of the periods.
+	LockupPeriods github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods `protobuf:"bytes,4,rep,name=lockup_periods,json=lockupPeriods,proto3,castrepeated=github.com/cosmos/cosmos-sdk/x/auth/vesting/types.Periods" json:"lockup_periods"`
+	// interval is the duration in seconds between two grants
+	Interval int64 `protobuf:"varint,5,opt,name=interval,proto3" json:"interval,omitempty"`
+	// remaining_count is the number of grants that remain to be executed
+	RemainingCount uint64 `protobuf:"varint,6,opt,name=remaining_count,json=remainingCount,proto3" json:"remaining_count,omitempty"`
+	// next_time is the unix time at which the next grant is due
+	NextTime int64 `protobuf:"varint,7,opt,name=next_time,json=nextTime,proto3" json:"next_time,omitempty"`
 }
 
-func init() { proto.RegisterFile("vesting/v1/vesting.proto", fileDescriptor_c495fbb3e74201c8) }
+func (m *FundingInstruction) Reset()         { *m = FundingInstruction{} }
+func (m *FundingInstruction) String() string { return proto.CompactTextString(m) }
+func (*FundingInstruction) ProtoMessage()    {}
+func (*FundingInstruction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c495fbb3e74201c8, []int{2}
+}
+func (m *FundingInstruction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FundingInstruction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FundingInstruction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FundingInstruction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundingInstruction.Merge(m, src)
+}
+func (m *FundingInstruction) XXX_Size() int {
+	return m.Size()
+}
+func (m *FundingInstruction) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundingInstruction.DiscardUnknown(m)
+}
 
-var fileDescriptor_c495fbb3e74201c8 = []byte{
-	// 479 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x53, 0xbd, 0x6e, 0xdb, 0x30,
-	0x10, 0x16, 0x6b, 0xa5, 0x8d, 0x69, 0xc4, 0x2d, 0x58, 0xa3, 0x10, 0x3c, 0x48, 0x46, 0xd0, 0x02,
-	0x46, 0x81, 0x8a, 0x48, 0x3a, 0x35, 0x9b, 0x95, 0x17, 0x08, 0x84, 0xa2, 0x43, 0x17, 0x81, 0x94,
-	0x18, 0x45, 0xb0, 0x6c, 0x0a, 0x22, 0xe5, 0xa6, 0x6f, 0x10, 0x64, 0xca, 0x58, 0xa0, 0x8b, 0xe7,
-	0x3e, 0x49, 0x46, 0x8f, 0x9d, 0x92, 0xc2, 0x5e, 0xfa, 0x18, 0x85, 0xf8, 0x93, 0x38, 0x2d, 0xba,
-	0x66, 0xd2, 0x7d, 0xdf, 0x1d, 0x8f, 0xdf, 0xdd, 0x47, 0x41, 0x6f, 0xc1, 0x84, 0x2c, 0xe6, 0x39,
-	0x5e, 0x1c, 0x60, 0x13, 0x86, 0x55, 0xcd, 0x25, 0x47, 0xd0, 0xc2, 0xc5, 0xc1, 0xf0, 0x75, 0xca,
-	0xc5, 0x8c, 0x0b, 0x7c, 0x5f, 0x4c, 0x99, 0x24, 0x7f, 0x9d, 0x18, 0x0e, 0x72, 0x9e, 0x73, 0x15,
-	0xe2, 0x36, 0x32, 0x6c, 0x90, 0x73, 0x9e, 0x97, 0x0c, 0x2b, 0x44, 0x9b, 0x53, 0x2c, 0x8b, 0x19,
-	0x13, 0x92, 0xcc, 0x2a, 0x5d, 0xb0, 0x7f, 0xe9, 0xc2, 0x57, 0xc7, 0x25, 0xf9, 0x42, 0x49, 0x3a,
-	0xfd, 0xa4, 0x1b, 0x4e, 0xd2, 0x94, 0x37, 0x73, 0x89, 0x28, 0x1c, 0x50, 0x22, 0x58, 0x62, 0xee,
-	0x49, 0x88, 0xe6, 0x3d, 0x30, 0x02, 0xe3, 0xde, 0xe1, 0xdb, 0x50, 0xcb, 0x0a, 0xef, 0x95, 0x2a,
-	0x59, 0x61, 0x44, 0x04, 0x7b, 0xd8, 0x29, 0x72, 0x57, 0x37, 0x01, 0x88, 0x11, 0xfd, 0x27, 0x83,
-	0xde, 0xc0, 0xfe, 0x69, 0x33, 0xcf, 0x58, 0x9d, 0x90, 0x2c, 0xab, 0x99, 0x10, 0xde, 0x93, 0x11,
-	0x18, 0x77, 0xe3, 0x3d, 0xcd, 0x4e, 0x34, 0x89, 0x8e, 0x21, 0x14, 0x92, 0xd4, 0x32, 0x69, 0xe5,
-	0x7b, 0x1d, 0x25, 0x60, 0x18, 0xea, 0xd9, 0x42, 0x3b, 0x5b, 0xf8, 0xd1, 0xce, 0x16, 0xed, 0x5e,
-	0xdf, 0x04, 0xce, 0xd5, 0x6d, 0x00, 0xe2, 0xae, 0x3a, 0xd7, 0x66, 0xd0, 0x05, 0x80, 0xfd, 0x92,
-	0xa7, 0xd3, 0xa6, 0x4a, 0x2a, 0x56, 0x17, 0x3c, 0x13, 0x9e, 0x3b, 0xea, 0x8c, 0x7b, 0x87, 0xfe,
-	0xff, 0x46, 0x39, 0x51, 0x65, 0xd1, 0xa4, 0xed, 0xf6, 0xe3, 0x36, 0xf8, 0x90, 0x17, 0xf2, 0xac,
-	0xa1, 0x61, 0xca, 0x67, 0xd8, 0x78, 0xa2, 0x3f, 0xef, 0x44, 0x36, 0xc5, 0xe7, 0x98, 0x34, 0xf2,
-	0xec, 0xce, 0x25, 0xf9, 0xb5, 0x62, 0xc2, 0x74, 0x10, 0xf1, 0x9e, 0xbe, 0xd8, 0x40, 0x74, 0x09,
-	0xe0, 0x73, 0xbb, 0x56, 0xab, 0x65, 0xe7, 0xb1, 0xb4, 0xf4, 0x0d, 0x6d, 0xf0, 0xd1, 0xee, 0xc5,
-	0x32, 0x70, 0xbe, 0x2d, 0x03, 0x67, 0xff, 0x3b, 0x80, 0x2f, 0xec, 0x63, 0x38, 0xa9, 0x79, 0xc5,
-	0x05, 0x29, 0xd1, 0x00, 0xee, 0xc8, 0x42, 0x96, 0x4c, 0xf9, 0xde, 0x8d, 0x35, 0x40, 0x23, 0xd8,
-	0xcb, 0x98, 0x48, 0xeb, 0xa2, 0x92, 0x05, 0x9f, 0x1b, 0xd7, 0xb6, 0x29, 0xe4, 0xc1, 0x67, 0xd6,
-	0xd3, 0x8e, 0xca, 0x5a, 0x88, 0x30, 0x7c, 0x99, 0x29, 0x09, 0xa4, 0x2d, 0xbc, 0x73, 0xde, 0x55,
-	0x55, 0x68, 0x2b, 0x65, 0xec, 0x3f, 0x72, 0x7f, 0x2f, 0x03, 0x27, 0x8a, 0xae, 0xd7, 0x3e, 0x58,
-	0xad, 0x7d, 0xf0, 0x6b, 0xed, 0x83, 0xab, 0x8d, 0xef, 0xac, 0x36, 0xbe, 0xf3, 0x73, 0xe3, 0x3b,
-	0x9f, 0xc7, 0x5b, 0xcb, 0x60, 0x8b, 0xed, 0x7f, 0xe5, 0xfc, 0xe1, 0x0e, 0xe8, 0x53, 0xf5, 0x58,
-	0xde, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0x80, 0x99, 0xc7, 0x5a, 0x7a, 0x03, 0x00, 0x00,
+var xxx_messageInfo_FundingInstruction proto.InternalMessageInfo
+
+func (m *FundingInstruction) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (m *ClawbackVestingAccount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FundingInstruction) GetFunderAddress() string {
+	if m != nil {
+		return m.FunderAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ClawbackVestingAccount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *FundingInstruction) GetVestingAddress() string {
+	if m != nil {
+		return m.VestingAddress
+	}
+	return ""
 }
 
-func (m *ClawbackVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.VestingPeriods) > 0 {
-		for iNdEx := len(m.VestingPeriods) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.VestingPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintVesting(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
+func (m *FundingInstruction) GetLockupPeriods() github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods {
+	if m != nil {
+		return m.LockupPeriods
 	}
-	if len(m.LockupPeriods) > 0 {
-		for iNdEx := len(m.LockupPeriods) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LockupPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintVesting(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
+	return nil
+}
+
+func (m *FundingInstruction) GetInterval() int64 {
+	if m != nil {
+		return m.Interval
 	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
-	if err1 != nil {
-		return 0, err1
+	return 0
+}
+
+func (m *FundingInstruction) GetRemainingCount() uint64 {
+	if m != nil {
+		return m.RemainingCount
 	}
-	i -= n1
-	i = encodeVarintVesting(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x1a
-	if len(m.FunderAddress) > 0 {
-		i -= len(m.FunderAddress)
-		copy(dAtA[i:], m.FunderAddress)
-		i = encodeVarintVesting(dAtA, i, uint64(len(m.FunderAddress)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *FundingInstruction) GetNextTime() int64 {
+	if m != nil {
+		return m.NextTime
 	}
-	if m.BaseVestingAccount != nil {
-		{
-			size, err := m.BaseVestingAccount.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintVesting(dAtA, i, uint64(size))
+	return 0
+}
+
+// EscrowGrant defines a vesting grant whose coins are held in escrow by the
+// vesting module account, instead of the balance of the recipient, and
+// released to the recipient as they vest and unlock.
+type EscrowGrant struct {
+	// id is the unique identifier of the grant
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// funder_address is the address of the account that funded the grant
+	FunderAddress string `protobuf:"bytes,2,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	// recipient_address is the address of the account that receives the coins
+	RecipientAddress string `protobuf:"bytes,3,opt,name=recipient_address,json=recipientAddress,proto3" json:"recipient_address,omitempty"`
+	// start_time is the unix time of the start of the schedules
+	StartTime int64 `protobuf:"varint,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// lockup_periods defines the unlocking schedule relative to the start_time
+	LockupPeriods github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods `protobuf:"bytes,5,rep,name=lockup_periods,json=lockupPeriods,proto3,castrepeated=github.com/cosmos/cosmos-sdk/x/auth/vesting/types.Periods" json:"lockup_periods"`
+	// vesting_periods defines the vesting schedule relative to the start_time
+	VestingPeriods github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods `protobuf:"bytes,6,rep,name=vesting_periods,json=vestingPeriods,proto3,castrepeated=github.com/cosmos/cosmos-sdk/x/auth/vesting/types.Periods" json:"vesting_periods"`
+	// original_amount is the amount of coins funded
+	OriginalAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=original_amount,json=originalAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"original_amount"`
+	// claimed is the amount of coins already released to the recipient
+	Claimed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=claimed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"claimed"`
+	// clawback_time is the unix time at which the unvested coins were clawed
+	// back, after which no more coins vest. Zero if the grant was not clawed
+	// back.
+	ClawbackTime int64 `protobuf:"varint,9,opt,name=clawback_time,json=clawbackTime,proto3" json:"clawback_time,omitempty"`
+}
+
+func (m *EscrowGrant) Reset()         { *m = EscrowGrant{} }
+func (m *EscrowGrant) String() string { return proto.CompactTextString(m) }
+func (*EscrowGrant) ProtoMessage()    {}
+func (*EscrowGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c495fbb3e74201c8, []int{3}
+}
+func (m *EscrowGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EscrowGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EscrowGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EscrowGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EscrowGrant.Merge(m, src)
+}
+func (m *EscrowGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *EscrowGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_EscrowGrant.DiscardUnknown(m)
 }
 
-func (m *ClawbackProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EscrowGrant proto.InternalMessageInfo
+
+func (m *EscrowGrant) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ClawbackProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EscrowGrant) GetFunderAddress() string {
+	if m != nil {
+		return m.FunderAddress
+	}
+	return ""
 }
 
-func (m *ClawbackProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DestinationAddress) > 0 {
-		i -= len(m.DestinationAddress)
-		copy(dAtA[i:], m.DestinationAddress)
-		i = encodeVarintVesting(dAtA, i, uint64(len(m.DestinationAddress)))
-		i--
-		dAtA[i] = 0x22
+func (m *EscrowGrant) GetRecipientAddress() string {
+	if m != nil {
+		return m.RecipientAddress
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintVesting(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *EscrowGrant) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintVesting(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *EscrowGrant) GetLockupPeriods() github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods {
+	if m != nil {
+		return m.LockupPeriods
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintVesting(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *EscrowGrant) GetVestingPeriods() github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods {
+	if m != nil {
+		return m.VestingPeriods
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func encodeVarintVesting(dAtA []byte, offset int, v uint64) int {
-	offset -= sovVesting(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EscrowGrant) GetOriginalAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.OriginalAmount
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *ClawbackVestingAccount) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *EscrowGrant) GetClaimed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Claimed
 	}
-	var l int
-	_ = l
-	if m.BaseVestingAccount != nil {
-		l = m.BaseVestingAccount.Size()
-		n += 1 + l + sovVesting(uint64(l))
+	return nil
+}
+
+func (m *EscrowGrant) GetClawbackTime() int64 {
+	if m != nil {
+		return m.ClawbackTime
 	}
-	l = len(m.FunderAddress)
-	if l > 0 {
-		n += 1 + l + sovVesting(uint64(l))
+	return 0
+}
+
+// FunderCommittee defines a committee of members that acts as the funder of
+// clawback vesting accounts through proposals approved by a threshold of its
+// members. The committee address has no private key, so it cannot sign
+// messages such as MsgFundVestingAccount or MsgSetVestingManager.
+type FunderCommittee struct {
+	// address is the address of the committee, derived from the vesting module
+	// address, which is set as the funder of the vesting accounts
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// members are the addresses of the members of the committee
+	Members []string `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	// threshold is the number of member approvals required to execute a proposal
+	Threshold uint32 `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// proposal_lifetime is the duration in seconds after which a proposal that
+	// has not been executed expires
+	ProposalLifetime int64 `protobuf:"varint,4,opt,name=proposal_lifetime,json=proposalLifetime,proto3" json:"proposal_lifetime,omitempty"`
+}
+
+func (m *FunderCommittee) Reset()         { *m = FunderCommittee{} }
+func (m *FunderCommittee) String() string { return proto.CompactTextString(m) }
+func (*FunderCommittee) ProtoMessage()    {}
+func (*FunderCommittee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c495fbb3e74201c8, []int{4}
+}
+func (m *FunderCommittee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FunderCommittee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FunderCommittee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
-	n += 1 + l + sovVesting(uint64(l))
-	if len(m.LockupPeriods) > 0 {
-		for _, e := range m.LockupPeriods {
-			l = e.Size()
-			n += 1 + l + sovVesting(uint64(l))
+}
+func (m *FunderCommittee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FunderCommittee.Merge(m, src)
+}
+func (m *FunderCommittee) XXX_Size() int {
+	return m.Size()
+}
+func (m *FunderCommittee) XXX_DiscardUnknown() {
+	xxx_messageInfo_FunderCommittee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FunderCommittee proto.InternalMessageInfo
+
+func (m *FunderCommittee) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *FunderCommittee) GetMembers() []string {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+func (m *FunderCommittee) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *FunderCommittee) GetProposalLifetime() int64 {
+	if m != nil {
+		return m.ProposalLifetime
+	}
+	return 0
+}
+
+// CommitteeProposal defines an action on a vesting account funded by a funder
+// committee, executed once approved by the threshold of its members.
+type CommitteeProposal struct {
+	// id is the unique identifier of the proposal
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// committee_address is the address of the funder committee
+	CommitteeAddress string `protobuf:"bytes,2,opt,name=committee_address,json=committeeAddress,proto3" json:"committee_address,omitempty"`
+	// proposer is the address of the member that submitted the proposal
+	Proposer string `protobuf:"bytes,3,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// action is the action to execute
+	Action CommitteeAction `protobuf:"varint,4,opt,name=action,proto3,enum=vesting.v1.CommitteeAction" json:"action,omitempty"`
+	// vesting_address is the address of the ClawbackVestingAccount
+	VestingAddress string `protobuf:"bytes,5,opt,name=vesting_address,json=vestingAddress,proto3" json:"vesting_address,omitempty"`
+	// dest_address is the address that receives the clawed back coins of a
+	// clawback
+	DestAddress string `protobuf:"bytes,6,opt,name=dest_address,json=destAddress,proto3" json:"dest_address,omitempty"`
+	// new_funder_address is the new funder of a funder update
+	NewFunderAddress string `protobuf:"bytes,7,opt,name=new_funder_address,json=newFunderAddress,proto3" json:"new_funder_address,omitempty"`
+	// approvals are the addresses of the members that approved the proposal
+	Approvals []string `protobuf:"bytes,8,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	// expiry_time is the unix time at which the proposal expires
+	ExpiryTime int64 `protobuf:"varint,9,opt,name=expiry_time,json=expiryTime,proto3" json:"expiry_time,omitempty"`
+}
+
+func (m *CommitteeProposal) Reset()         { *m = CommitteeProposal{} }
+func (m *CommitteeProposal) String() string { return proto.CompactTextString(m) }
+func (*CommitteeProposal) ProtoMessage()    {}
+func (*CommitteeProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c495fbb3e74201c8, []int{5}
+}
+func (m *CommitteeProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitteeProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitteeProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommitteeProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitteeProposal.Merge(m, src)
+}
+func (m *CommitteeProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommitteeProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitteeProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitteeProposal proto.InternalMessageInfo
+
+func (m *CommitteeProposal) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *CommitteeProposal) GetCommitteeAddress() string {
+	if m != nil {
+		return m.CommitteeAddress
+	}
+	return ""
+}
+
+func (m *CommitteeProposal) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *CommitteeProposal) GetAction() CommitteeAction {
+	if m != nil {
+		return m.Action
+	}
+	return COMMITTEE_ACTION_UNSPECIFIED
+}
+
+func (m *CommitteeProposal) GetVestingAddress() string {
+	if m != nil {
+		return m.VestingAddress
+	}
+	return ""
+}
+
+func (m *CommitteeProposal) GetDestAddress() string {
+	if m != nil {
+		return m.DestAddress
+	}
+	return ""
+}
+
+func (m *CommitteeProposal) GetNewFunderAddress() string {
+	if m != nil {
+		return m.NewFunderAddress
+	}
+	return ""
+}
+
+func (m *CommitteeProposal) GetApprovals() []string {
+	if m != nil {
+		return m.Approvals
+	}
+	return nil
+}
+
+func (m *CommitteeProposal) GetExpiryTime() int64 {
+	if m != nil {
+		return m.ExpiryTime
+	}
+	return 0
+}
+
+// ClawbackProposal is a gov Content type to clawback funds
+// from a vesting account that has this functionality enabled.
+type ClawbackProposal struct {
+	// title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// description of the proposal
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// address is the vesting account address
+	// to clawback the funds from
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	// destination_address is the address that will receive
+	// the clawbacked funds from the given vesting account. When
+	// empty, proposal will transfer the coins to the community
+	// pool.
+	DestinationAddress string `protobuf:"bytes,4,opt,name=destination_address,json=destinationAddress,proto3" json:"destination_address,omitempty"`
+}
+
+func (m *ClawbackProposal) Reset()         { *m = ClawbackProposal{} }
+func (m *ClawbackProposal) String() string { return proto.CompactTextString(m) }
+func (*ClawbackProposal) ProtoMessage()    {}
+func (*ClawbackProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c495fbb3e74201c8, []int{6}
+}
+func (m *ClawbackProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClawbackProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClawbackProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
+	}
+}
+func (m *ClawbackProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClawbackProposal.Merge(m, src)
+}
+func (m *ClawbackProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClawbackProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClawbackProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClawbackProposal proto.InternalMessageInfo
+
+func (m *ClawbackProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *ClawbackProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *ClawbackProposal) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ClawbackProposal) GetDestinationAddress() string {
+	if m != nil {
+		return m.DestinationAddress
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("vesting.v1.CommitteeAction", CommitteeAction_name, CommitteeAction_value)
+	proto.RegisterType((*ClawbackVestingAccount)(nil), "vesting.v1.ClawbackVestingAccount")
+	proto.RegisterType((*ScheduleAmendment)(nil), "vesting.v1.ScheduleAmendment")
+	proto.RegisterType((*FundingInstruction)(nil), "vesting.v1.FundingInstruction")
+	proto.RegisterType((*EscrowGrant)(nil), "vesting.v1.EscrowGrant")
+	proto.RegisterType((*FunderCommittee)(nil), "vesting.v1.FunderCommittee")
+	proto.RegisterType((*CommitteeProposal)(nil), "vesting.v1.CommitteeProposal")
+	proto.RegisterType((*ClawbackProposal)(nil), "vesting.v1.ClawbackProposal")
+}
+
+func init() { proto.RegisterFile("vesting/v1/vesting.proto", fileDescriptor_c495fbb3e74201c8) }
+
+var fileDescriptor_c495fbb3e74201c8 = []byte{
+	// 1077 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x4f, 0x4f, 0xe3, 0x46,
+	0x14, 0x8f, 0xf3, 0x07, 0x92, 0xc7, 0x12, 0xc2, 0x14, 0x55, 0xd9, 0xc0, 0x26, 0x69, 0xda, 0x6a,
+	0x11, 0xdb, 0xc6, 0x85, 0x3d, 0x75, 0x6f, 0x8e, 0x09, 0x15, 0x2a, 0xcb, 0x22, 0x6f, 0xb6, 0x95,
+	0x7a, 0xb1, 0x26, 0xf6, 0x10, 0x46, 0xd8, 0x1e, 0xcb, 0x9e, 0x04, 0xf6, 0x1b, 0xa0, 0x9e, 0x38,
+	0x56, 0xaa, 0x2a, 0x21, 0xf5, 0xb6, 0x9f, 0x64, 0x8f, 0x1c, 0xab, 0x1e, 0x96, 0x16, 0x2e, 0xfd,
+	0x0e, 0xbd, 0x54, 0x1e, 0x7b, 0x4c, 0x02, 0xe9, 0x6a, 0x55, 0x55, 0xcb, 0x9e, 0xe2, 0xf9, 0xbd,
+	0x97, 0x37, 0xbf, 0xf7, 0x7e, 0xef, 0x3d, 0x1b, 0xaa, 0x23, 0x12, 0x72, 0xea, 0x0d, 0xd4, 0xd1,
+	0xba, 0x9a, 0x3c, 0xb6, 0xfd, 0x80, 0x71, 0x86, 0x40, 0x1e, 0x47, 0xeb, 0xb5, 0xba, 0xc5, 0x42,
+	0x97, 0x85, 0x6a, 0x1f, 0x87, 0x44, 0x1d, 0xad, 0xf7, 0x09, 0xc7, 0xeb, 0xaa, 0xc5, 0xa8, 0x17,
+	0xfb, 0xd6, 0x3e, 0x4b, 0xec, 0xd7, 0xc1, 0x62, 0x97, 0x89, 0x88, 0xb5, 0xa5, 0x01, 0x1b, 0x30,
+	0xf1, 0xa8, 0x46, 0x4f, 0x09, 0xda, 0x18, 0x30, 0x36, 0x70, 0x88, 0x2a, 0x4e, 0xfd, 0xe1, 0xbe,
+	0xca, 0xa9, 0x4b, 0x42, 0x8e, 0x5d, 0x3f, 0x76, 0x68, 0x5d, 0xe4, 0xe1, 0x63, 0xdd, 0xc1, 0x47,
+	0x7d, 0x6c, 0x1d, 0x7e, 0x17, 0x07, 0xd4, 0x2c, 0x8b, 0x0d, 0x3d, 0x8e, 0xfa, 0xb0, 0x14, 0x51,
+	0x32, 0x93, 0x7b, 0x4c, 0x1c, 0xe3, 0x55, 0xa5, 0xa9, 0xac, 0xce, 0x6d, 0xac, 0xb5, 0x63, 0x5a,
+	0xed, 0xeb, 0x4c, 0x04, 0xad, 0x76, 0x07, 0x87, 0x64, 0x32, 0x52, 0x27, 0x7f, 0xfe, 0xa6, 0xa1,
+	0x18, 0xa8, 0x7f, 0xcb, 0x82, 0x3e, 0x87, 0xf2, 0xfe, 0xd0, 0xb3, 0x49, 0x60, 0x62, 0xdb, 0x0e,
+	0x48, 0x18, 0x56, 0xb3, 0x4d, 0x65, 0xb5, 0x64, 0xcc, 0xc7, 0xa8, 0x16, 0x83, 0x48, 0x07, 0x08,
+	0x39, 0x0e, 0xb8, 0x19, 0xd1, 0xaf, 0xe6, 0x04, 0x81, 0x5a, 0x3b, 0xce, 0xad, 0x2d, 0x73, 0x6b,
+	0xf7, 0x64, 0x6e, 0x9d, 0xe2, 0xeb, 0x37, 0x8d, 0xcc, 0xe9, 0x45, 0x43, 0x31, 0x4a, 0xe2, 0x7f,
+	0x91, 0x05, 0x9d, 0x28, 0x50, 0x76, 0x98, 0x75, 0x38, 0xf4, 0x4d, 0x9f, 0x04, 0x94, 0xd9, 0x61,
+	0x35, 0xdf, 0xcc, 0xad, 0xce, 0x6d, 0xd4, 0xff, 0x2d, 0x95, 0x3d, 0xe1, 0xd6, 0xd1, 0xa2, 0x68,
+	0xaf, 0x2e, 0x1a, 0x5f, 0x0f, 0x28, 0x3f, 0x18, 0xf6, 0xdb, 0x16, 0x73, 0xd5, 0x44, 0x93, 0xf8,
+	0xe7, 0xcb, 0xd0, 0x3e, 0x54, 0x8f, 0x55, 0x3c, 0xe4, 0x07, 0xa9, 0x4a, 0xfc, 0xa5, 0x4f, 0xc2,
+	0x24, 0x42, 0x68, 0xcc, 0xc7, 0x17, 0x27, 0x47, 0xf4, 0xa3, 0x02, 0x0b, 0xb2, 0xac, 0x92, 0x4b,
+	0xe1, 0x7d, 0x71, 0x29, 0x27, 0xb0, 0x24, 0xf3, 0x10, 0x16, 0x5c, 0xec, 0xe1, 0xc1, 0x98, 0x08,
+	0x33, 0x42, 0x84, 0x72, 0x02, 0x4b, 0x15, 0xd6, 0x60, 0x31, 0x25, 0x8d, 0x87, 0x21, 0xb1, 0x4d,
+	0xcc, 0xab, 0xb3, 0x4d, 0x65, 0x35, 0x67, 0xc8, 0x6c, 0xf6, 0x04, 0xae, 0xf1, 0x27, 0xc5, 0x93,
+	0xb3, 0x46, 0xe6, 0xa7, 0xb3, 0x46, 0xa6, 0xf5, 0x77, 0x16, 0x16, 0x9f, 0x5b, 0x07, 0xc4, 0x1e,
+	0x3a, 0x44, 0x73, 0x89, 0x67, 0xbb, 0x64, 0xaa, 0xf0, 0xca, 0x34, 0xe1, 0x1f, 0x5e, 0xd7, 0x69,
+	0xb2, 0x41, 0x64, 0x12, 0xd2, 0x71, 0x8a, 0xb8, 0xb9, 0x0f, 0x48, 0xdc, 0xfc, 0x1d, 0x89, 0xdb,
+	0xfa, 0x33, 0x0b, 0x68, 0x6b, 0xe8, 0xd9, 0xd4, 0x1b, 0x6c, 0x7b, 0x21, 0x0f, 0x86, 0x16, 0xa7,
+	0xcc, 0x43, 0x65, 0xc8, 0x52, 0x5b, 0x94, 0x3c, 0x6f, 0x64, 0xa9, 0xfd, 0xae, 0x73, 0x38, 0x45,
+	0x8e, 0xdc, 0xbb, 0xca, 0x71, 0x57, 0xb3, 0x56, 0x83, 0x22, 0xf5, 0x38, 0x09, 0x46, 0xd8, 0xa9,
+	0x16, 0x44, 0xb3, 0xa6, 0xe7, 0x28, 0x9f, 0x80, 0xb8, 0x98, 0x7a, 0x51, 0x46, 0xf1, 0x76, 0x9b,
+	0x11, 0x35, 0x29, 0xa7, 0xb0, 0x1e, 0xa1, 0x68, 0x19, 0x4a, 0x1e, 0x39, 0x4e, 0xf6, 0x4f, 0xdc,
+	0xf2, 0xc5, 0x08, 0x88, 0x16, 0x4b, 0xeb, 0x55, 0x01, 0xe6, 0xba, 0xa1, 0x15, 0xb0, 0xa3, 0x6f,
+	0x02, 0xec, 0xf1, 0xff, 0x5a, 0xdc, 0x47, 0xb0, 0x18, 0x10, 0x8b, 0xfa, 0x94, 0x78, 0xfc, 0x46,
+	0x79, 0x2b, 0xa9, 0x41, 0x3a, 0x3f, 0x98, 0xd8, 0x88, 0x79, 0xc1, 0xe8, 0xed, 0xbb, 0xae, 0xf0,
+	0x01, 0x8d, 0xc3, 0xcc, 0x5d, 0xed, 0x3a, 0x0e, 0x0b, 0x2c, 0xa0, 0x03, 0xea, 0x61, 0xc7, 0xc4,
+	0xae, 0x10, 0x7c, 0x56, 0x70, 0xb9, 0x2f, 0xb9, 0x44, 0x2f, 0xa9, 0x94, 0x88, 0xce, 0xa8, 0xd7,
+	0xf9, 0x2a, 0xa1, 0xb1, 0xfa, 0x56, 0x1a, 0xf1, 0xbd, 0xd1, 0x1f, 0x42, 0xa3, 0x2c, 0xef, 0xd0,
+	0xc4, 0x15, 0x88, 0xc0, 0xac, 0xe5, 0x60, 0xea, 0x12, 0xbb, 0x5a, 0xfc, 0xff, 0x6f, 0x93, 0xb1,
+	0xd1, 0xa7, 0x30, 0x6f, 0x25, 0xaf, 0xf2, 0xb8, 0x2d, 0x4a, 0xa2, 0x2d, 0xee, 0x49, 0x50, 0x34,
+	0xeb, 0xa9, 0x02, 0x0b, 0x5b, 0xa2, 0xef, 0x74, 0xe6, 0xba, 0x94, 0x73, 0x42, 0x50, 0x15, 0x66,
+	0x27, 0xb7, 0xb0, 0x3c, 0x46, 0x16, 0x97, 0xb8, 0x7d, 0x12, 0x44, 0x3d, 0x9b, 0x8b, 0x2c, 0xc9,
+	0x11, 0xad, 0x40, 0x89, 0x1f, 0x04, 0x24, 0x3c, 0x60, 0x8e, 0x2d, 0xba, 0x74, 0xde, 0xb8, 0x06,
+	0xa2, 0x5e, 0xf6, 0x03, 0xe6, 0xb3, 0x10, 0x3b, 0xa6, 0x43, 0xf7, 0xc9, 0x58, 0x97, 0x56, 0xa4,
+	0x61, 0x27, 0xc1, 0x5b, 0xbf, 0x67, 0x61, 0x31, 0x25, 0xb3, 0x97, 0x58, 0x6f, 0x4d, 0xd1, 0x23,
+	0x58, 0xb4, 0xa4, 0xd3, 0x8d, 0x41, 0xaa, 0xa4, 0x06, 0x39, 0x1e, 0x35, 0x28, 0xc6, 0xd7, 0x90,
+	0x20, 0x19, 0xa1, 0xf4, 0x8c, 0x1e, 0xc3, 0x0c, 0x16, 0x5b, 0x50, 0x10, 0x2a, 0x6f, 0x2c, 0x8f,
+	0xf5, 0x5f, 0x3b, 0xe5, 0xa1, 0x09, 0x17, 0x23, 0x71, 0x9d, 0xb6, 0xf9, 0x0a, 0x53, 0x37, 0xdf,
+	0x27, 0x70, 0xcf, 0x26, 0x21, 0xbf, 0xf1, 0x2a, 0x9d, 0x8b, 0x30, 0xe9, 0xf2, 0x05, 0x20, 0x8f,
+	0x1c, 0x99, 0x37, 0x76, 0xc2, 0x6c, 0x9c, 0x8a, 0x47, 0x8e, 0xb6, 0x26, 0xd6, 0xc2, 0x0a, 0x94,
+	0xb0, 0xef, 0x07, 0x6c, 0x84, 0x9d, 0x50, 0xb4, 0x4f, 0xc9, 0xb8, 0x06, 0x50, 0x03, 0xe6, 0xc8,
+	0xb1, 0x4f, 0x83, 0x97, 0xe3, 0x8a, 0x43, 0x0c, 0x09, 0xbd, 0x7f, 0x56, 0xa0, 0x22, 0x3f, 0xf0,
+	0xd2, 0xda, 0x2e, 0x41, 0x81, 0x53, 0xee, 0x90, 0x44, 0xee, 0xf8, 0x80, 0x9a, 0x10, 0xd1, 0xb4,
+	0x02, 0xea, 0x8b, 0xea, 0x64, 0x53, 0xe6, 0x12, 0x1a, 0x6f, 0x94, 0xdc, 0x64, 0xa3, 0xa8, 0xf0,
+	0x91, 0x2d, 0x0a, 0x81, 0x23, 0xc7, 0x34, 0xa9, 0xbc, 0xf0, 0x42, 0x63, 0xa6, 0x24, 0xad, 0x27,
+	0xf9, 0xbf, 0xce, 0x1a, 0x99, 0xb5, 0x5f, 0x14, 0x58, 0xb8, 0x51, 0x72, 0xd4, 0x84, 0x15, 0xfd,
+	0xd9, 0xd3, 0xa7, 0xdb, 0xbd, 0x5e, 0xb7, 0x6b, 0x6a, 0x7a, 0x6f, 0xfb, 0xd9, 0xae, 0xf9, 0x62,
+	0xf7, 0xf9, 0x5e, 0x57, 0xdf, 0xde, 0xda, 0xee, 0x6e, 0x56, 0x32, 0xe8, 0x01, 0xdc, 0xbf, 0xe5,
+	0xa1, 0xef, 0x68, 0xdf, 0x77, 0x34, 0xfd, 0xdb, 0x8a, 0x82, 0x5a, 0x50, 0xbf, 0x1d, 0x60, 0x6f,
+	0x53, 0xeb, 0x75, 0xcd, 0xad, 0x17, 0xbb, 0x9b, 0x5d, 0xa3, 0x92, 0x45, 0x0d, 0x58, 0xbe, 0xe5,
+	0xa3, 0xe9, 0x7a, 0x77, 0xa7, 0x6b, 0x68, 0xbd, 0x6e, 0x25, 0x57, 0xcb, 0x9f, 0xfc, 0x5a, 0xcf,
+	0x74, 0x3a, 0xaf, 0x2f, 0xeb, 0xca, 0xf9, 0x65, 0x5d, 0xf9, 0xe3, 0xb2, 0xae, 0x9c, 0x5e, 0xd5,
+	0x33, 0xe7, 0x57, 0xf5, 0xcc, 0x6f, 0x57, 0xf5, 0xcc, 0x0f, 0xe3, 0xf3, 0x49, 0x46, 0xe3, 0xdf,
+	0xe7, 0xc7, 0x93, 0xbb, 0xa8, 0x3f, 0x23, 0x3e, 0x50, 0x1f, 0xff, 0x33, 0x00, 0x29, 0xb5, 0x04,
+	0x4b, 0x0e, 0x0c, 0x00, 0x00,
+}
+
+func (m *ClawbackVestingAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClawbackVestingAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClawbackVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VestingPausedAt != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.VestingPausedAt))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ManagerAddress) > 0 {
+		i -= len(m.ManagerAddress)
+		copy(dAtA[i:], m.ManagerAddress)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.ManagerAddress)))
+		i--
+		dAtA[i] = 0x32
 	}
 	if len(m.VestingPeriods) > 0 {
-		for _, e := range m.VestingPeriods {
-			l = e.Size()
-			n += 1 + l + sovVesting(uint64(l))
+		for iNdEx := len(m.VestingPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.LockupPeriods) > 0 {
+		for iNdEx := len(m.LockupPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockupPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintVesting(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BaseVestingAccount != nil {
+		{
+			size, err := m.BaseVestingAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintVesting(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduleAmendment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleAmendment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleAmendment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VestingPeriods) > 0 {
+		for iNdEx := len(m.VestingPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.LockupPeriods) > 0 {
+		for iNdEx := len(m.LockupPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockupPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.VestingAddress) > 0 {
+		i -= len(m.VestingAddress)
+		copy(dAtA[i:], m.VestingAddress)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.VestingAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FundingInstruction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FundingInstruction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FundingInstruction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextTime != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.NextTime))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.RemainingCount != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.RemainingCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Interval != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.LockupPeriods) > 0 {
+		for iNdEx := len(m.LockupPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockupPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.VestingAddress) > 0 {
+		i -= len(m.VestingAddress)
+		copy(dAtA[i:], m.VestingAddress)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.VestingAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EscrowGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EscrowGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EscrowGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ClawbackTime != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.ClawbackTime))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Claimed) > 0 {
+		for iNdEx := len(m.Claimed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claimed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.OriginalAmount) > 0 {
+		for iNdEx := len(m.OriginalAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OriginalAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.VestingPeriods) > 0 {
+		for iNdEx := len(m.VestingPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.LockupPeriods) > 0 {
+		for iNdEx := len(m.LockupPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockupPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.RecipientAddress) > 0 {
+		i -= len(m.RecipientAddress)
+		copy(dAtA[i:], m.RecipientAddress)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.RecipientAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FunderCommittee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FunderCommittee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FunderCommittee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalLifetime != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.ProposalLifetime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Members[iNdEx])
+			copy(dAtA[i:], m.Members[iNdEx])
+			i = encodeVarintVesting(dAtA, i, uint64(len(m.Members[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommitteeProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitteeProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitteeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryTime != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.ExpiryTime))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Approvals) > 0 {
+		for iNdEx := len(m.Approvals) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Approvals[iNdEx])
+			copy(dAtA[i:], m.Approvals[iNdEx])
+			i = encodeVarintVesting(dAtA, i, uint64(len(m.Approvals[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.NewFunderAddress) > 0 {
+		i -= len(m.NewFunderAddress)
+		copy(dAtA[i:], m.NewFunderAddress)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.NewFunderAddress)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.DestAddress) > 0 {
+		i -= len(m.DestAddress)
+		copy(dAtA[i:], m.DestAddress)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.DestAddress)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.VestingAddress) > 0 {
+		i -= len(m.VestingAddress)
+		copy(dAtA[i:], m.VestingAddress)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.VestingAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Action != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CommitteeAddress) > 0 {
+		i -= len(m.CommitteeAddress)
+		copy(dAtA[i:], m.CommitteeAddress)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.CommitteeAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClawbackProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClawbackProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClawbackProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DestinationAddress) > 0 {
+		i -= len(m.DestinationAddress)
+		copy(dAtA[i:], m.DestinationAddress)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.DestinationAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintVesting(dAtA []byte, offset int, v uint64) int {
+	offset -= sovVesting(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ClawbackVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseVestingAccount != nil {
+		l = m.BaseVestingAccount.Size()
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovVesting(uint64(l))
+	if len(m.LockupPeriods) > 0 {
+		for _, e := range m.LockupPeriods {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	if len(m.VestingPeriods) > 0 {
+		for _, e := range m.VestingPeriods {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	l = len(m.ManagerAddress)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	if m.VestingPausedAt != 0 {
+		n += 1 + sovVesting(uint64(m.VestingPausedAt))
+	}
+	return n
+}
+
+func (m *ScheduleAmendment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = len(m.VestingAddress)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	if len(m.LockupPeriods) > 0 {
+		for _, e := range m.LockupPeriods {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	if len(m.VestingPeriods) > 0 {
+		for _, e := range m.VestingPeriods {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FundingInstruction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovVesting(uint64(m.Id))
+	}
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = len(m.VestingAddress)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	if len(m.LockupPeriods) > 0 {
+		for _, e := range m.LockupPeriods {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	if m.Interval != 0 {
+		n += 1 + sovVesting(uint64(m.Interval))
+	}
+	if m.RemainingCount != 0 {
+		n += 1 + sovVesting(uint64(m.RemainingCount))
+	}
+	if m.NextTime != 0 {
+		n += 1 + sovVesting(uint64(m.NextTime))
+	}
+	return n
+}
+
+func (m *EscrowGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovVesting(uint64(m.Id))
+	}
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = len(m.RecipientAddress)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovVesting(uint64(m.StartTime))
+	}
+	if len(m.LockupPeriods) > 0 {
+		for _, e := range m.LockupPeriods {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	if len(m.VestingPeriods) > 0 {
+		for _, e := range m.VestingPeriods {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	if len(m.OriginalAmount) > 0 {
+		for _, e := range m.OriginalAmount {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	if len(m.Claimed) > 0 {
+		for _, e := range m.Claimed {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	if m.ClawbackTime != 0 {
+		n += 1 + sovVesting(uint64(m.ClawbackTime))
+	}
+	return n
+}
+
+func (m *FunderCommittee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	if len(m.Members) > 0 {
+		for _, s := range m.Members {
+			l = len(s)
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovVesting(uint64(m.Threshold))
+	}
+	if m.ProposalLifetime != 0 {
+		n += 1 + sovVesting(uint64(m.ProposalLifetime))
+	}
+	return n
+}
+
+func (m *CommitteeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovVesting(uint64(m.Id))
+	}
+	l = len(m.CommitteeAddress)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	if m.Action != 0 {
+		n += 1 + sovVesting(uint64(m.Action))
+	}
+	l = len(m.VestingAddress)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = len(m.DestAddress)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = len(m.NewFunderAddress)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	if len(m.Approvals) > 0 {
+		for _, s := range m.Approvals {
+			l = len(s)
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	if m.ExpiryTime != 0 {
+		n += 1 + sovVesting(uint64(m.ExpiryTime))
+	}
+	return n
+}
+
+func (m *ClawbackProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = len(m.DestinationAddress)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	return n
+}
+
+func sovVesting(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozVesting(x uint64) (n int) {
+	return sovVesting(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ClawbackVestingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClawbackVestingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClawbackVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseVestingAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseVestingAccount == nil {
+				m.BaseVestingAccount = &types.BaseVestingAccount{}
+			}
+			if err := m.BaseVestingAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockupPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockupPeriods = append(m.LockupPeriods, types.Period{})
+			if err := m.LockupPeriods[len(m.LockupPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingPeriods = append(m.VestingPeriods, types.Period{})
+			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ManagerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPausedAt", wireType)
+			}
+			m.VestingPausedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VestingPausedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleAmendment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleAmendment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleAmendment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockupPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockupPeriods = append(m.LockupPeriods, types.Period{})
+			if err := m.LockupPeriods[len(m.LockupPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingPeriods = append(m.VestingPeriods, types.Period{})
+			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FundingInstruction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FundingInstruction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FundingInstruction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockupPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockupPeriods = append(m.LockupPeriods, types.Period{})
+			if err := m.LockupPeriods[len(m.LockupPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingCount", wireType)
+			}
+			m.RemainingCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextTime", wireType)
+			}
+			m.NextTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EscrowGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EscrowGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EscrowGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockupPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockupPeriods = append(m.LockupPeriods, types.Period{})
+			if err := m.LockupPeriods[len(m.LockupPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingPeriods = append(m.VestingPeriods, types.Period{})
+			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalAmount = append(m.OriginalAmount, types1.Coin{})
+			if err := m.OriginalAmount[len(m.OriginalAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimed = append(m.Claimed, types1.Coin{})
+			if err := m.Claimed[len(m.Claimed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawbackTime", wireType)
+			}
+			m.ClawbackTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClawbackTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FunderCommittee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FunderCommittee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FunderCommittee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalLifetime", wireType)
+			}
+			m.ProposalLifetime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalLifetime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *ClawbackProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovVesting(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovVesting(uint64(l))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovVesting(uint64(l))
-	}
-	l = len(m.DestinationAddress)
-	if l > 0 {
-		n += 1 + l + sovVesting(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovVesting(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozVesting(x uint64) (n int) {
-	return sovVesting(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *ClawbackVestingAccount) Unmarshal(dAtA []byte) error {
+func (m *CommitteeProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -423,17 +2726,36 @@ func (m *ClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClawbackVestingAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommitteeProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClawbackVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommitteeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseVestingAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVesting
@@ -443,31 +2765,78 @@ func (m *ClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthVesting
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthVesting
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BaseVestingAccount == nil {
-				m.BaseVestingAccount = &types.BaseVestingAccount{}
+			m.CommitteeAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
 			}
-			if err := m.BaseVestingAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
 			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= CommitteeAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -495,13 +2864,13 @@ func (m *ClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			m.VestingAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DestAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVesting
@@ -511,30 +2880,29 @@ func (m *ClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthVesting
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthVesting
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DestAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockupPeriods", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewFunderAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVesting
@@ -544,31 +2912,29 @@ func (m *ClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthVesting
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthVesting
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LockupPeriods = append(m.LockupPeriods, types.Period{})
-			if err := m.LockupPeriods[len(m.LockupPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NewFunderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVesting
@@ -578,26 +2944,43 @@ func (m *ClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthVesting
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthVesting
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VestingPeriods = append(m.VestingPeriods, types.Period{})
-			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Approvals = append(m.Approvals, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+			}
+			m.ExpiryTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVesting(dAtA[iNdEx:])